@@ -28,6 +28,14 @@ type DatabaseStruct struct {
 	PostgreSQL PostgreSQLStruct `koanf:"postgresql"`
 	Redis      RedisStruct      `koanf:"redis"`
 }
+
+// PubSubStruct selects the broker connecting the pubsub of several replicas.
+// Broker is "redpanda", or empty for a single process-local instance.
+type PubSubStruct struct {
+	Broker  string   `koanf:"broker"`
+	Brokers []string `koanf:"brokers"`
+	Topic   string   `koanf:"topic"`
+}
 type ServiceStruct struct {
 	Environment string `koanf:"environment"`
 	Port        string `koanf:"port"`
@@ -39,10 +47,47 @@ type S3Struct struct {
 	SecretKey string `koanf:"secretKey"`
 	UseSSL    bool   `koanf:"useSSL"`
 }
+
+// SigningKeyStruct is a PEM encoded Ed25519 or RSA key tokens are signed
+// with. Retired keys only need the public key to keep verifying tokens.
+type SigningKeyStruct struct {
+	ID             string `koanf:"id"`
+	PrivateKeyFile string `koanf:"private_key_file"`
+	PublicKeyFile  string `koanf:"public_key_file"`
+}
+
+// AuthStruct configures token signing. The first key signs, every key
+// verifies. Without keys an ephemeral one is generated outside production.
+// TOTPKey is the base64 encoded 32 byte key TOTP secrets are encrypted with.
+// TrustedProxies are the IPs or CIDR ranges of the proxies whose
+// x-forwarded-for header gives the client address.
+type AuthStruct struct {
+	Issuer         string             `koanf:"issuer"`
+	Keys           []SigningKeyStruct `koanf:"keys"`
+	TOTPKey        string             `koanf:"totp_key"`
+	TrustedProxies []string           `koanf:"trusted_proxies"`
+}
+
+// MailStruct selects how emails are delivered. Driver is "smtp", "file" to
+// write .eml files into Dir, or "memory" to drop them. BaseURL is the web
+// app the links in emails point to.
+type MailStruct struct {
+	Driver   string `koanf:"driver"`
+	From     string `koanf:"from"`
+	BaseURL  string `koanf:"base_url"`
+	Host     string `koanf:"host"`
+	Port     int    `koanf:"port"`
+	Username string `koanf:"username"`
+	Password string `koanf:"password"`
+	Dir      string `koanf:"dir"`
+}
 type Config struct {
 	Database DatabaseStruct  `koanf:"database"`
 	Service  ServiceStruct   `koanf:"service"`
 	S3       S3Struct        `koanf:"s3"`
+	PubSub   PubSubStruct    `koanf:"pubsub"`
+	Auth     AuthStruct      `koanf:"auth"`
+	Mail     MailStruct      `koanf:"mail"`
 	reactive ReactiveService `koanf:"reactive"`
 }
 
@@ -19,20 +19,33 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DirectMessageService_SendMessage_FullMethodName        = "/protoservice.dm.DirectMessageService/SendMessage"
-	DirectMessageService_GetMessages_FullMethodName        = "/protoservice.dm.DirectMessageService/GetMessages"
-	DirectMessageService_GetMessage_FullMethodName         = "/protoservice.dm.DirectMessageService/GetMessage"
-	DirectMessageService_EditMessage_FullMethodName        = "/protoservice.dm.DirectMessageService/EditMessage"
-	DirectMessageService_DeleteMessage_FullMethodName      = "/protoservice.dm.DirectMessageService/DeleteMessage"
-	DirectMessageService_PinMessage_FullMethodName         = "/protoservice.dm.DirectMessageService/PinMessage"
-	DirectMessageService_UnpinMessage_FullMethodName       = "/protoservice.dm.DirectMessageService/UnpinMessage"
-	DirectMessageService_GetPinnedMessages_FullMethodName  = "/protoservice.dm.DirectMessageService/GetPinnedMessages"
-	DirectMessageService_AddReaction_FullMethodName        = "/protoservice.dm.DirectMessageService/AddReaction"
-	DirectMessageService_RemoveReaction_FullMethodName     = "/protoservice.dm.DirectMessageService/RemoveReaction"
-	DirectMessageService_GetReactions_FullMethodName       = "/protoservice.dm.DirectMessageService/GetReactions"
-	DirectMessageService_SendTyping_FullMethodName         = "/protoservice.dm.DirectMessageService/SendTyping"
-	DirectMessageService_BulkDeleteMessages_FullMethodName = "/protoservice.dm.DirectMessageService/BulkDeleteMessages"
-	DirectMessageService_SearchMessages_FullMethodName     = "/protoservice.dm.DirectMessageService/SearchMessages"
+	DirectMessageService_SendMessage_FullMethodName              = "/protoservice.dm.DirectMessageService/SendMessage"
+	DirectMessageService_GetMessages_FullMethodName              = "/protoservice.dm.DirectMessageService/GetMessages"
+	DirectMessageService_GetMessage_FullMethodName               = "/protoservice.dm.DirectMessageService/GetMessage"
+	DirectMessageService_EditMessage_FullMethodName              = "/protoservice.dm.DirectMessageService/EditMessage"
+	DirectMessageService_DeleteMessage_FullMethodName            = "/protoservice.dm.DirectMessageService/DeleteMessage"
+	DirectMessageService_PinMessage_FullMethodName               = "/protoservice.dm.DirectMessageService/PinMessage"
+	DirectMessageService_UnpinMessage_FullMethodName             = "/protoservice.dm.DirectMessageService/UnpinMessage"
+	DirectMessageService_GetPinnedMessages_FullMethodName        = "/protoservice.dm.DirectMessageService/GetPinnedMessages"
+	DirectMessageService_AddReaction_FullMethodName              = "/protoservice.dm.DirectMessageService/AddReaction"
+	DirectMessageService_RemoveReaction_FullMethodName           = "/protoservice.dm.DirectMessageService/RemoveReaction"
+	DirectMessageService_GetReactions_FullMethodName             = "/protoservice.dm.DirectMessageService/GetReactions"
+	DirectMessageService_SendTyping_FullMethodName               = "/protoservice.dm.DirectMessageService/SendTyping"
+	DirectMessageService_BulkDeleteMessages_FullMethodName       = "/protoservice.dm.DirectMessageService/BulkDeleteMessages"
+	DirectMessageService_SearchMessages_FullMethodName           = "/protoservice.dm.DirectMessageService/SearchMessages"
+	DirectMessageService_ListMessageRequests_FullMethodName      = "/protoservice.dm.DirectMessageService/ListMessageRequests"
+	DirectMessageService_AcceptMessageRequest_FullMethodName     = "/protoservice.dm.DirectMessageService/AcceptMessageRequest"
+	DirectMessageService_IgnoreMessageRequest_FullMethodName     = "/protoservice.dm.DirectMessageService/IgnoreMessageRequest"
+	DirectMessageService_CreateGroupDM_FullMethodName            = "/protoservice.dm.DirectMessageService/CreateGroupDM"
+	DirectMessageService_GetDMChannels_FullMethodName            = "/protoservice.dm.DirectMessageService/GetDMChannels"
+	DirectMessageService_UpdateGroupDM_FullMethodName            = "/protoservice.dm.DirectMessageService/UpdateGroupDM"
+	DirectMessageService_AddGroupDMRecipient_FullMethodName      = "/protoservice.dm.DirectMessageService/AddGroupDMRecipient"
+	DirectMessageService_RemoveGroupDMRecipient_FullMethodName   = "/protoservice.dm.DirectMessageService/RemoveGroupDMRecipient"
+	DirectMessageService_TransferGroupDMOwnership_FullMethodName = "/protoservice.dm.DirectMessageService/TransferGroupDMOwnership"
+	DirectMessageService_LeaveGroupDM_FullMethodName             = "/protoservice.dm.DirectMessageService/LeaveGroupDM"
+	DirectMessageService_SendGroupDMMessage_FullMethodName       = "/protoservice.dm.DirectMessageService/SendGroupDMMessage"
+	DirectMessageService_GetGroupDMMessages_FullMethodName       = "/protoservice.dm.DirectMessageService/GetGroupDMMessages"
+	DirectMessageService_AckDMChannel_FullMethodName             = "/protoservice.dm.DirectMessageService/AckDMChannel"
 )
 
 // DirectMessageServiceClient is the client API for DirectMessageService service.
@@ -57,6 +70,21 @@ type DirectMessageServiceClient interface {
 	// Bulk Operations
 	BulkDeleteMessages(ctx context.Context, in *BulkDeleteMessagesRequest, opts ...grpc.CallOption) (*BulkDeleteMessagesResponse, error)
 	SearchMessages(ctx context.Context, in *SearchMessagesRequest, opts ...grpc.CallOption) (*SearchMessagesResponse, error)
+	// Message Requests
+	ListMessageRequests(ctx context.Context, in *ListMessageRequestsRequest, opts ...grpc.CallOption) (*ListMessageRequestsResponse, error)
+	AcceptMessageRequest(ctx context.Context, in *AcceptMessageRequestRequest, opts ...grpc.CallOption) (*AcceptMessageRequestResponse, error)
+	IgnoreMessageRequest(ctx context.Context, in *IgnoreMessageRequestRequest, opts ...grpc.CallOption) (*IgnoreMessageRequestResponse, error)
+	// Group DMs
+	CreateGroupDM(ctx context.Context, in *CreateGroupDMRequest, opts ...grpc.CallOption) (*CreateGroupDMResponse, error)
+	GetDMChannels(ctx context.Context, in *GetDMChannelsRequest, opts ...grpc.CallOption) (*GetDMChannelsResponse, error)
+	UpdateGroupDM(ctx context.Context, in *UpdateGroupDMRequest, opts ...grpc.CallOption) (*UpdateGroupDMResponse, error)
+	AddGroupDMRecipient(ctx context.Context, in *AddGroupDMRecipientRequest, opts ...grpc.CallOption) (*AddGroupDMRecipientResponse, error)
+	RemoveGroupDMRecipient(ctx context.Context, in *RemoveGroupDMRecipientRequest, opts ...grpc.CallOption) (*RemoveGroupDMRecipientResponse, error)
+	TransferGroupDMOwnership(ctx context.Context, in *TransferGroupDMOwnershipRequest, opts ...grpc.CallOption) (*TransferGroupDMOwnershipResponse, error)
+	LeaveGroupDM(ctx context.Context, in *LeaveGroupDMRequest, opts ...grpc.CallOption) (*LeaveGroupDMResponse, error)
+	SendGroupDMMessage(ctx context.Context, in *SendGroupDMMessageRequest, opts ...grpc.CallOption) (*SendGroupDMMessageResponse, error)
+	GetGroupDMMessages(ctx context.Context, in *GetGroupDMMessagesRequest, opts ...grpc.CallOption) (*GetGroupDMMessagesResponse, error)
+	AckDMChannel(ctx context.Context, in *AckDMChannelRequest, opts ...grpc.CallOption) (*AckDMChannelResponse, error)
 }
 
 type directMessageServiceClient struct {
@@ -219,6 +247,136 @@ func (c *directMessageServiceClient) SearchMessages(ctx context.Context, in *Sea
 	return out, nil
 }
 
+func (c *directMessageServiceClient) ListMessageRequests(ctx context.Context, in *ListMessageRequestsRequest, opts ...grpc.CallOption) (*ListMessageRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMessageRequestsResponse)
+	err := c.cc.Invoke(ctx, DirectMessageService_ListMessageRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *directMessageServiceClient) AcceptMessageRequest(ctx context.Context, in *AcceptMessageRequestRequest, opts ...grpc.CallOption) (*AcceptMessageRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptMessageRequestResponse)
+	err := c.cc.Invoke(ctx, DirectMessageService_AcceptMessageRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *directMessageServiceClient) IgnoreMessageRequest(ctx context.Context, in *IgnoreMessageRequestRequest, opts ...grpc.CallOption) (*IgnoreMessageRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IgnoreMessageRequestResponse)
+	err := c.cc.Invoke(ctx, DirectMessageService_IgnoreMessageRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *directMessageServiceClient) CreateGroupDM(ctx context.Context, in *CreateGroupDMRequest, opts ...grpc.CallOption) (*CreateGroupDMResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGroupDMResponse)
+	err := c.cc.Invoke(ctx, DirectMessageService_CreateGroupDM_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *directMessageServiceClient) GetDMChannels(ctx context.Context, in *GetDMChannelsRequest, opts ...grpc.CallOption) (*GetDMChannelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDMChannelsResponse)
+	err := c.cc.Invoke(ctx, DirectMessageService_GetDMChannels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *directMessageServiceClient) UpdateGroupDM(ctx context.Context, in *UpdateGroupDMRequest, opts ...grpc.CallOption) (*UpdateGroupDMResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateGroupDMResponse)
+	err := c.cc.Invoke(ctx, DirectMessageService_UpdateGroupDM_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *directMessageServiceClient) AddGroupDMRecipient(ctx context.Context, in *AddGroupDMRecipientRequest, opts ...grpc.CallOption) (*AddGroupDMRecipientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddGroupDMRecipientResponse)
+	err := c.cc.Invoke(ctx, DirectMessageService_AddGroupDMRecipient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *directMessageServiceClient) RemoveGroupDMRecipient(ctx context.Context, in *RemoveGroupDMRecipientRequest, opts ...grpc.CallOption) (*RemoveGroupDMRecipientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveGroupDMRecipientResponse)
+	err := c.cc.Invoke(ctx, DirectMessageService_RemoveGroupDMRecipient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *directMessageServiceClient) TransferGroupDMOwnership(ctx context.Context, in *TransferGroupDMOwnershipRequest, opts ...grpc.CallOption) (*TransferGroupDMOwnershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferGroupDMOwnershipResponse)
+	err := c.cc.Invoke(ctx, DirectMessageService_TransferGroupDMOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *directMessageServiceClient) LeaveGroupDM(ctx context.Context, in *LeaveGroupDMRequest, opts ...grpc.CallOption) (*LeaveGroupDMResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveGroupDMResponse)
+	err := c.cc.Invoke(ctx, DirectMessageService_LeaveGroupDM_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *directMessageServiceClient) SendGroupDMMessage(ctx context.Context, in *SendGroupDMMessageRequest, opts ...grpc.CallOption) (*SendGroupDMMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendGroupDMMessageResponse)
+	err := c.cc.Invoke(ctx, DirectMessageService_SendGroupDMMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *directMessageServiceClient) GetGroupDMMessages(ctx context.Context, in *GetGroupDMMessagesRequest, opts ...grpc.CallOption) (*GetGroupDMMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupDMMessagesResponse)
+	err := c.cc.Invoke(ctx, DirectMessageService_GetGroupDMMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *directMessageServiceClient) AckDMChannel(ctx context.Context, in *AckDMChannelRequest, opts ...grpc.CallOption) (*AckDMChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AckDMChannelResponse)
+	err := c.cc.Invoke(ctx, DirectMessageService_AckDMChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DirectMessageServiceServer is the server API for DirectMessageService service.
 // All implementations must embed UnimplementedDirectMessageServiceServer
 // for forward compatibility.
@@ -241,6 +399,21 @@ type DirectMessageServiceServer interface {
 	// Bulk Operations
 	BulkDeleteMessages(context.Context, *BulkDeleteMessagesRequest) (*BulkDeleteMessagesResponse, error)
 	SearchMessages(context.Context, *SearchMessagesRequest) (*SearchMessagesResponse, error)
+	// Message Requests
+	ListMessageRequests(context.Context, *ListMessageRequestsRequest) (*ListMessageRequestsResponse, error)
+	AcceptMessageRequest(context.Context, *AcceptMessageRequestRequest) (*AcceptMessageRequestResponse, error)
+	IgnoreMessageRequest(context.Context, *IgnoreMessageRequestRequest) (*IgnoreMessageRequestResponse, error)
+	// Group DMs
+	CreateGroupDM(context.Context, *CreateGroupDMRequest) (*CreateGroupDMResponse, error)
+	GetDMChannels(context.Context, *GetDMChannelsRequest) (*GetDMChannelsResponse, error)
+	UpdateGroupDM(context.Context, *UpdateGroupDMRequest) (*UpdateGroupDMResponse, error)
+	AddGroupDMRecipient(context.Context, *AddGroupDMRecipientRequest) (*AddGroupDMRecipientResponse, error)
+	RemoveGroupDMRecipient(context.Context, *RemoveGroupDMRecipientRequest) (*RemoveGroupDMRecipientResponse, error)
+	TransferGroupDMOwnership(context.Context, *TransferGroupDMOwnershipRequest) (*TransferGroupDMOwnershipResponse, error)
+	LeaveGroupDM(context.Context, *LeaveGroupDMRequest) (*LeaveGroupDMResponse, error)
+	SendGroupDMMessage(context.Context, *SendGroupDMMessageRequest) (*SendGroupDMMessageResponse, error)
+	GetGroupDMMessages(context.Context, *GetGroupDMMessagesRequest) (*GetGroupDMMessagesResponse, error)
+	AckDMChannel(context.Context, *AckDMChannelRequest) (*AckDMChannelResponse, error)
 	mustEmbedUnimplementedDirectMessageServiceServer()
 }
 
@@ -293,6 +466,45 @@ func (UnimplementedDirectMessageServiceServer) BulkDeleteMessages(context.Contex
 func (UnimplementedDirectMessageServiceServer) SearchMessages(context.Context, *SearchMessagesRequest) (*SearchMessagesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchMessages not implemented")
 }
+func (UnimplementedDirectMessageServiceServer) ListMessageRequests(context.Context, *ListMessageRequestsRequest) (*ListMessageRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMessageRequests not implemented")
+}
+func (UnimplementedDirectMessageServiceServer) AcceptMessageRequest(context.Context, *AcceptMessageRequestRequest) (*AcceptMessageRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptMessageRequest not implemented")
+}
+func (UnimplementedDirectMessageServiceServer) IgnoreMessageRequest(context.Context, *IgnoreMessageRequestRequest) (*IgnoreMessageRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IgnoreMessageRequest not implemented")
+}
+func (UnimplementedDirectMessageServiceServer) CreateGroupDM(context.Context, *CreateGroupDMRequest) (*CreateGroupDMResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroupDM not implemented")
+}
+func (UnimplementedDirectMessageServiceServer) GetDMChannels(context.Context, *GetDMChannelsRequest) (*GetDMChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDMChannels not implemented")
+}
+func (UnimplementedDirectMessageServiceServer) UpdateGroupDM(context.Context, *UpdateGroupDMRequest) (*UpdateGroupDMResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupDM not implemented")
+}
+func (UnimplementedDirectMessageServiceServer) AddGroupDMRecipient(context.Context, *AddGroupDMRecipientRequest) (*AddGroupDMRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddGroupDMRecipient not implemented")
+}
+func (UnimplementedDirectMessageServiceServer) RemoveGroupDMRecipient(context.Context, *RemoveGroupDMRecipientRequest) (*RemoveGroupDMRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGroupDMRecipient not implemented")
+}
+func (UnimplementedDirectMessageServiceServer) TransferGroupDMOwnership(context.Context, *TransferGroupDMOwnershipRequest) (*TransferGroupDMOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferGroupDMOwnership not implemented")
+}
+func (UnimplementedDirectMessageServiceServer) LeaveGroupDM(context.Context, *LeaveGroupDMRequest) (*LeaveGroupDMResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroupDM not implemented")
+}
+func (UnimplementedDirectMessageServiceServer) SendGroupDMMessage(context.Context, *SendGroupDMMessageRequest) (*SendGroupDMMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendGroupDMMessage not implemented")
+}
+func (UnimplementedDirectMessageServiceServer) GetGroupDMMessages(context.Context, *GetGroupDMMessagesRequest) (*GetGroupDMMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupDMMessages not implemented")
+}
+func (UnimplementedDirectMessageServiceServer) AckDMChannel(context.Context, *AckDMChannelRequest) (*AckDMChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AckDMChannel not implemented")
+}
 func (UnimplementedDirectMessageServiceServer) mustEmbedUnimplementedDirectMessageServiceServer() {}
 func (UnimplementedDirectMessageServiceServer) testEmbeddedByValue()                              {}
 
@@ -548,6 +760,240 @@ func _DirectMessageService_SearchMessages_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DirectMessageService_ListMessageRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMessageRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirectMessageServiceServer).ListMessageRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DirectMessageService_ListMessageRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirectMessageServiceServer).ListMessageRequests(ctx, req.(*ListMessageRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirectMessageService_AcceptMessageRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptMessageRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirectMessageServiceServer).AcceptMessageRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DirectMessageService_AcceptMessageRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirectMessageServiceServer).AcceptMessageRequest(ctx, req.(*AcceptMessageRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirectMessageService_IgnoreMessageRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IgnoreMessageRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirectMessageServiceServer).IgnoreMessageRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DirectMessageService_IgnoreMessageRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirectMessageServiceServer).IgnoreMessageRequest(ctx, req.(*IgnoreMessageRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirectMessageService_CreateGroupDM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupDMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirectMessageServiceServer).CreateGroupDM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DirectMessageService_CreateGroupDM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirectMessageServiceServer).CreateGroupDM(ctx, req.(*CreateGroupDMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirectMessageService_GetDMChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDMChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirectMessageServiceServer).GetDMChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DirectMessageService_GetDMChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirectMessageServiceServer).GetDMChannels(ctx, req.(*GetDMChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirectMessageService_UpdateGroupDM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGroupDMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirectMessageServiceServer).UpdateGroupDM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DirectMessageService_UpdateGroupDM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirectMessageServiceServer).UpdateGroupDM(ctx, req.(*UpdateGroupDMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirectMessageService_AddGroupDMRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGroupDMRecipientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirectMessageServiceServer).AddGroupDMRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DirectMessageService_AddGroupDMRecipient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirectMessageServiceServer).AddGroupDMRecipient(ctx, req.(*AddGroupDMRecipientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirectMessageService_RemoveGroupDMRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveGroupDMRecipientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirectMessageServiceServer).RemoveGroupDMRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DirectMessageService_RemoveGroupDMRecipient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirectMessageServiceServer).RemoveGroupDMRecipient(ctx, req.(*RemoveGroupDMRecipientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirectMessageService_TransferGroupDMOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferGroupDMOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirectMessageServiceServer).TransferGroupDMOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DirectMessageService_TransferGroupDMOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirectMessageServiceServer).TransferGroupDMOwnership(ctx, req.(*TransferGroupDMOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirectMessageService_LeaveGroupDM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveGroupDMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirectMessageServiceServer).LeaveGroupDM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DirectMessageService_LeaveGroupDM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirectMessageServiceServer).LeaveGroupDM(ctx, req.(*LeaveGroupDMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirectMessageService_SendGroupDMMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendGroupDMMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirectMessageServiceServer).SendGroupDMMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DirectMessageService_SendGroupDMMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirectMessageServiceServer).SendGroupDMMessage(ctx, req.(*SendGroupDMMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirectMessageService_GetGroupDMMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupDMMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirectMessageServiceServer).GetGroupDMMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DirectMessageService_GetGroupDMMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirectMessageServiceServer).GetGroupDMMessages(ctx, req.(*GetGroupDMMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirectMessageService_AckDMChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckDMChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirectMessageServiceServer).AckDMChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DirectMessageService_AckDMChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirectMessageServiceServer).AckDMChannel(ctx, req.(*AckDMChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DirectMessageService_ServiceDesc is the grpc.ServiceDesc for DirectMessageService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -603,6 +1049,58 @@ var DirectMessageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchMessages",
 			Handler:    _DirectMessageService_SearchMessages_Handler,
 		},
+		{
+			MethodName: "ListMessageRequests",
+			Handler:    _DirectMessageService_ListMessageRequests_Handler,
+		},
+		{
+			MethodName: "AcceptMessageRequest",
+			Handler:    _DirectMessageService_AcceptMessageRequest_Handler,
+		},
+		{
+			MethodName: "IgnoreMessageRequest",
+			Handler:    _DirectMessageService_IgnoreMessageRequest_Handler,
+		},
+		{
+			MethodName: "CreateGroupDM",
+			Handler:    _DirectMessageService_CreateGroupDM_Handler,
+		},
+		{
+			MethodName: "GetDMChannels",
+			Handler:    _DirectMessageService_GetDMChannels_Handler,
+		},
+		{
+			MethodName: "UpdateGroupDM",
+			Handler:    _DirectMessageService_UpdateGroupDM_Handler,
+		},
+		{
+			MethodName: "AddGroupDMRecipient",
+			Handler:    _DirectMessageService_AddGroupDMRecipient_Handler,
+		},
+		{
+			MethodName: "RemoveGroupDMRecipient",
+			Handler:    _DirectMessageService_RemoveGroupDMRecipient_Handler,
+		},
+		{
+			MethodName: "TransferGroupDMOwnership",
+			Handler:    _DirectMessageService_TransferGroupDMOwnership_Handler,
+		},
+		{
+			MethodName: "LeaveGroupDM",
+			Handler:    _DirectMessageService_LeaveGroupDM_Handler,
+		},
+		{
+			MethodName: "SendGroupDMMessage",
+			Handler:    _DirectMessageService_SendGroupDMMessage_Handler,
+		},
+		{
+			MethodName: "GetGroupDMMessages",
+			Handler:    _DirectMessageService_GetGroupDMMessages_Handler,
+		},
+		{
+			MethodName: "AckDMChannel",
+			Handler:    _DirectMessageService_AckDMChannel_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -0,0 +1,1056 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: service/gateway/gateway_service.proto
+
+package gateway
+
+import (
+	schema "discord/gen/proto/schema"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Server Events
+type GatewayEventType int32
+
+const (
+	GatewayEventType_EVENT_UNSPECIFIED    GatewayEventType = 0
+	GatewayEventType_READY                GatewayEventType = 1
+	GatewayEventType_HEARTBEAT_ACK        GatewayEventType = 2
+	GatewayEventType_MESSAGE_CREATE       GatewayEventType = 3
+	GatewayEventType_MESSAGE_UPDATE       GatewayEventType = 4
+	GatewayEventType_MESSAGE_DELETE       GatewayEventType = 5
+	GatewayEventType_FRIEND_UPDATE        GatewayEventType = 6
+	GatewayEventType_PRESENCE_UPDATE      GatewayEventType = 7
+	GatewayEventType_USER_UPDATE          GatewayEventType = 8
+	GatewayEventType_VOICE_STATE_UPDATE   GatewayEventType = 9
+	GatewayEventType_RESUMED              GatewayEventType = 10
+	GatewayEventType_INVALID_SESSION      GatewayEventType = 11
+	GatewayEventType_MEMBER_UPDATE        GatewayEventType = 12
+	GatewayEventType_USER_SETTINGS_UPDATE GatewayEventType = 13
+	GatewayEventType_DM_CHANNEL_UPDATE    GatewayEventType = 14
+	GatewayEventType_CHANNEL_CREATE       GatewayEventType = 15
+	GatewayEventType_CHANNEL_UPDATE       GatewayEventType = 16 // Also sent when the channel's permission overwrites change
+	GatewayEventType_CHANNEL_DELETE       GatewayEventType = 17
+	GatewayEventType_ROLE_UPDATE          GatewayEventType = 18 // Sent when a role's permissions change or it is deleted
+)
+
+// Enum value maps for GatewayEventType.
+var (
+	GatewayEventType_name = map[int32]string{
+		0:  "EVENT_UNSPECIFIED",
+		1:  "READY",
+		2:  "HEARTBEAT_ACK",
+		3:  "MESSAGE_CREATE",
+		4:  "MESSAGE_UPDATE",
+		5:  "MESSAGE_DELETE",
+		6:  "FRIEND_UPDATE",
+		7:  "PRESENCE_UPDATE",
+		8:  "USER_UPDATE",
+		9:  "VOICE_STATE_UPDATE",
+		10: "RESUMED",
+		11: "INVALID_SESSION",
+		12: "MEMBER_UPDATE",
+		13: "USER_SETTINGS_UPDATE",
+		14: "DM_CHANNEL_UPDATE",
+		15: "CHANNEL_CREATE",
+		16: "CHANNEL_UPDATE",
+		17: "CHANNEL_DELETE",
+		18: "ROLE_UPDATE",
+	}
+	GatewayEventType_value = map[string]int32{
+		"EVENT_UNSPECIFIED":    0,
+		"READY":                1,
+		"HEARTBEAT_ACK":        2,
+		"MESSAGE_CREATE":       3,
+		"MESSAGE_UPDATE":       4,
+		"MESSAGE_DELETE":       5,
+		"FRIEND_UPDATE":        6,
+		"PRESENCE_UPDATE":      7,
+		"USER_UPDATE":          8,
+		"VOICE_STATE_UPDATE":   9,
+		"RESUMED":              10,
+		"INVALID_SESSION":      11,
+		"MEMBER_UPDATE":        12,
+		"USER_SETTINGS_UPDATE": 13,
+		"DM_CHANNEL_UPDATE":    14,
+		"CHANNEL_CREATE":       15,
+		"CHANNEL_UPDATE":       16,
+		"CHANNEL_DELETE":       17,
+		"ROLE_UPDATE":          18,
+	}
+)
+
+func (x GatewayEventType) Enum() *GatewayEventType {
+	p := new(GatewayEventType)
+	*p = x
+	return p
+}
+
+func (x GatewayEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GatewayEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_gateway_gateway_service_proto_enumTypes[0].Descriptor()
+}
+
+func (GatewayEventType) Type() protoreflect.EnumType {
+	return &file_service_gateway_gateway_service_proto_enumTypes[0]
+}
+
+func (x GatewayEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GatewayEventType.Descriptor instead.
+func (GatewayEventType) EnumDescriptor() ([]byte, []int) {
+	return file_service_gateway_gateway_service_proto_rawDescGZIP(), []int{0}
+}
+
+// Client Messages
+type GatewayRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*GatewayRequest_Identify
+	//	*GatewayRequest_Heartbeat
+	//	*GatewayRequest_Resume
+	Payload       isGatewayRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GatewayRequest) Reset() {
+	*x = GatewayRequest{}
+	mi := &file_service_gateway_gateway_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GatewayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayRequest) ProtoMessage() {}
+
+func (x *GatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_gateway_gateway_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayRequest.ProtoReflect.Descriptor instead.
+func (*GatewayRequest) Descriptor() ([]byte, []int) {
+	return file_service_gateway_gateway_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GatewayRequest) GetPayload() isGatewayRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *GatewayRequest) GetIdentify() *Identify {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayRequest_Identify); ok {
+			return x.Identify
+		}
+	}
+	return nil
+}
+
+func (x *GatewayRequest) GetHeartbeat() *Heartbeat {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayRequest_Heartbeat); ok {
+			return x.Heartbeat
+		}
+	}
+	return nil
+}
+
+func (x *GatewayRequest) GetResume() *Resume {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayRequest_Resume); ok {
+			return x.Resume
+		}
+	}
+	return nil
+}
+
+type isGatewayRequest_Payload interface {
+	isGatewayRequest_Payload()
+}
+
+type GatewayRequest_Identify struct {
+	Identify *Identify `protobuf:"bytes,1,opt,name=identify,proto3,oneof"`
+}
+
+type GatewayRequest_Heartbeat struct {
+	Heartbeat *Heartbeat `protobuf:"bytes,2,opt,name=heartbeat,proto3,oneof"`
+}
+
+type GatewayRequest_Resume struct {
+	Resume *Resume `protobuf:"bytes,3,opt,name=resume,proto3,oneof"`
+}
+
+func (*GatewayRequest_Identify) isGatewayRequest_Payload() {}
+
+func (*GatewayRequest_Heartbeat) isGatewayRequest_Payload() {}
+
+func (*GatewayRequest_Resume) isGatewayRequest_Payload() {}
+
+// Identify or Resume must be the first message of a gateway stream
+type Identify struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Identify) Reset() {
+	*x = Identify{}
+	mi := &file_service_gateway_gateway_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Identify) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Identify) ProtoMessage() {}
+
+func (x *Identify) ProtoReflect() protoreflect.Message {
+	mi := &file_service_gateway_gateway_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Identify.ProtoReflect.Descriptor instead.
+func (*Identify) Descriptor() ([]byte, []int) {
+	return file_service_gateway_gateway_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Identify) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// Resume reattaches to a session and replays every event after last_sequence.
+// INVALID_SESSION is sent instead when the session expired or the events were
+// already evicted from its replay buffer; the client must then IDENTIFY again.
+type Resume struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	LastSequence  int64                  `protobuf:"varint,3,opt,name=last_sequence,json=lastSequence,proto3" json:"last_sequence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Resume) Reset() {
+	*x = Resume{}
+	mi := &file_service_gateway_gateway_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Resume) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Resume) ProtoMessage() {}
+
+func (x *Resume) ProtoReflect() protoreflect.Message {
+	mi := &file_service_gateway_gateway_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Resume.ProtoReflect.Descriptor instead.
+func (*Resume) Descriptor() ([]byte, []int) {
+	return file_service_gateway_gateway_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Resume) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *Resume) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *Resume) GetLastSequence() int64 {
+	if x != nil {
+		return x.LastSequence
+	}
+	return 0
+}
+
+type Heartbeat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LastSequence  int64                  `protobuf:"varint,1,opt,name=last_sequence,json=lastSequence,proto3" json:"last_sequence,omitempty"` // Last sequence number the client received
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Heartbeat) Reset() {
+	*x = Heartbeat{}
+	mi := &file_service_gateway_gateway_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Heartbeat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Heartbeat) ProtoMessage() {}
+
+func (x *Heartbeat) ProtoReflect() protoreflect.Message {
+	mi := &file_service_gateway_gateway_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Heartbeat.ProtoReflect.Descriptor instead.
+func (*Heartbeat) Descriptor() ([]byte, []int) {
+	return file_service_gateway_gateway_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Heartbeat) GetLastSequence() int64 {
+	if x != nil {
+		return x.LastSequence
+	}
+	return 0
+}
+
+type GatewayEvent struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Sequence int64                  `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"` // Increases by one per dispatched event; 0 for HEARTBEAT_ACK, RESUMED and INVALID_SESSION
+	Type     GatewayEventType       `protobuf:"varint,2,opt,name=type,proto3,enum=protoservice.gateway.GatewayEventType" json:"type,omitempty"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*GatewayEvent_Ready
+	//	*GatewayEvent_Message
+	//	*GatewayEvent_MessageDelete
+	//	*GatewayEvent_Friend
+	//	*GatewayEvent_User
+	//	*GatewayEvent_VoiceState
+	//	*GatewayEvent_Member
+	//	*GatewayEvent_UserSettings
+	//	*GatewayEvent_DmChannel
+	//	*GatewayEvent_Channel
+	//	*GatewayEvent_Role
+	Payload       isGatewayEvent_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GatewayEvent) Reset() {
+	*x = GatewayEvent{}
+	mi := &file_service_gateway_gateway_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GatewayEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayEvent) ProtoMessage() {}
+
+func (x *GatewayEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_service_gateway_gateway_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayEvent.ProtoReflect.Descriptor instead.
+func (*GatewayEvent) Descriptor() ([]byte, []int) {
+	return file_service_gateway_gateway_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GatewayEvent) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *GatewayEvent) GetType() GatewayEventType {
+	if x != nil {
+		return x.Type
+	}
+	return GatewayEventType_EVENT_UNSPECIFIED
+}
+
+func (x *GatewayEvent) GetPayload() isGatewayEvent_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetReady() *Ready {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_Ready); ok {
+			return x.Ready
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetMessage() *schema.Message {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_Message); ok {
+			return x.Message
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetMessageDelete() *MessageDelete {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_MessageDelete); ok {
+			return x.MessageDelete
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetFriend() *schema.Friend {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_Friend); ok {
+			return x.Friend
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetUser() *schema.User {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_User); ok {
+			return x.User
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetVoiceState() *schema.VoiceState {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_VoiceState); ok {
+			return x.VoiceState
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetMember() *schema.ServerMember {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_Member); ok {
+			return x.Member
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetUserSettings() *schema.UserSettings {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_UserSettings); ok {
+			return x.UserSettings
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetDmChannel() *schema.DMChannel {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_DmChannel); ok {
+			return x.DmChannel
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetChannel() *schema.Channel {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_Channel); ok {
+			return x.Channel
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetRole() *schema.Role {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_Role); ok {
+			return x.Role
+		}
+	}
+	return nil
+}
+
+type isGatewayEvent_Payload interface {
+	isGatewayEvent_Payload()
+}
+
+type GatewayEvent_Ready struct {
+	Ready *Ready `protobuf:"bytes,3,opt,name=ready,proto3,oneof"`
+}
+
+type GatewayEvent_Message struct {
+	Message *schema.Message `protobuf:"bytes,4,opt,name=message,proto3,oneof"`
+}
+
+type GatewayEvent_MessageDelete struct {
+	MessageDelete *MessageDelete `protobuf:"bytes,5,opt,name=message_delete,json=messageDelete,proto3,oneof"`
+}
+
+type GatewayEvent_Friend struct {
+	Friend *schema.Friend `protobuf:"bytes,6,opt,name=friend,proto3,oneof"`
+}
+
+type GatewayEvent_User struct {
+	User *schema.User `protobuf:"bytes,7,opt,name=user,proto3,oneof"`
+}
+
+type GatewayEvent_VoiceState struct {
+	VoiceState *schema.VoiceState `protobuf:"bytes,8,opt,name=voice_state,json=voiceState,proto3,oneof"`
+}
+
+type GatewayEvent_Member struct {
+	Member *schema.ServerMember `protobuf:"bytes,9,opt,name=member,proto3,oneof"`
+}
+
+type GatewayEvent_UserSettings struct {
+	UserSettings *schema.UserSettings `protobuf:"bytes,10,opt,name=user_settings,json=userSettings,proto3,oneof"`
+}
+
+type GatewayEvent_DmChannel struct {
+	DmChannel *schema.DMChannel `protobuf:"bytes,11,opt,name=dm_channel,json=dmChannel,proto3,oneof"`
+}
+
+type GatewayEvent_Channel struct {
+	Channel *schema.Channel `protobuf:"bytes,12,opt,name=channel,proto3,oneof"`
+}
+
+type GatewayEvent_Role struct {
+	Role *schema.Role `protobuf:"bytes,13,opt,name=role,proto3,oneof"`
+}
+
+func (*GatewayEvent_Ready) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_Message) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_MessageDelete) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_Friend) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_User) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_VoiceState) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_Member) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_UserSettings) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_DmChannel) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_Channel) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_Role) isGatewayEvent_Payload() {}
+
+type Ready struct {
+	state            protoimpl.MessageState    `protogen:"open.v1"`
+	SessionId        string                    `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	User             *schema.User              `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Servers          []*schema.Server          `protobuf:"bytes,3,rep,name=servers,proto3" json:"servers,omitempty"`
+	Channels         []*schema.Channel         `protobuf:"bytes,4,rep,name=channels,proto3" json:"channels,omitempty"`
+	Friends          []*schema.Friend          `protobuf:"bytes,5,rep,name=friends,proto3" json:"friends,omitempty"`
+	Presences        []*schema.UserPresence    `protobuf:"bytes,6,rep,name=presences,proto3" json:"presences,omitempty"`
+	DmChannels       []*schema.DMChannel       `protobuf:"bytes,7,rep,name=dm_channels,json=dmChannels,proto3" json:"dm_channels,omitempty"`                     // Most recently active first
+	ReadStates       []*schema.ReadState       `protobuf:"bytes,8,rep,name=read_states,json=readStates,proto3" json:"read_states,omitempty"`                     // One per entry of channels
+	ServerReadStates []*schema.ServerReadState `protobuf:"bytes,9,rep,name=server_read_states,json=serverReadStates,proto3" json:"server_read_states,omitempty"` // One per entry of servers
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Ready) Reset() {
+	*x = Ready{}
+	mi := &file_service_gateway_gateway_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ready) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ready) ProtoMessage() {}
+
+func (x *Ready) ProtoReflect() protoreflect.Message {
+	mi := &file_service_gateway_gateway_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ready.ProtoReflect.Descriptor instead.
+func (*Ready) Descriptor() ([]byte, []int) {
+	return file_service_gateway_gateway_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Ready) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *Ready) GetUser() *schema.User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *Ready) GetServers() []*schema.Server {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+func (x *Ready) GetChannels() []*schema.Channel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *Ready) GetFriends() []*schema.Friend {
+	if x != nil {
+		return x.Friends
+	}
+	return nil
+}
+
+func (x *Ready) GetPresences() []*schema.UserPresence {
+	if x != nil {
+		return x.Presences
+	}
+	return nil
+}
+
+func (x *Ready) GetDmChannels() []*schema.DMChannel {
+	if x != nil {
+		return x.DmChannels
+	}
+	return nil
+}
+
+func (x *Ready) GetReadStates() []*schema.ReadState {
+	if x != nil {
+		return x.ReadStates
+	}
+	return nil
+}
+
+func (x *Ready) GetServerReadStates() []*schema.ServerReadState {
+	if x != nil {
+		return x.ServerReadStates
+	}
+	return nil
+}
+
+type MessageDelete struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     int32                  `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	MessageIds    []int32                `protobuf:"varint,2,rep,packed,name=message_ids,json=messageIds,proto3" json:"message_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageDelete) Reset() {
+	*x = MessageDelete{}
+	mi := &file_service_gateway_gateway_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageDelete) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageDelete) ProtoMessage() {}
+
+func (x *MessageDelete) ProtoReflect() protoreflect.Message {
+	mi := &file_service_gateway_gateway_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageDelete.ProtoReflect.Descriptor instead.
+func (*MessageDelete) Descriptor() ([]byte, []int) {
+	return file_service_gateway_gateway_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MessageDelete) GetChannelId() int32 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *MessageDelete) GetMessageIds() []int32 {
+	if x != nil {
+		return x.MessageIds
+	}
+	return nil
+}
+
+var File_service_gateway_gateway_service_proto protoreflect.FileDescriptor
+
+var file_service_gateway_gateway_service_proto_rawDesc = string([]byte{
+	0x0a, 0x25, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x1a, 0x14, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2f, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x13, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2f, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x2f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2f, 0x72, 0x65, 0x61,
+	0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2f, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd2, 0x01, 0x0a,
+	0x0e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x3c, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x79, 0x48, 0x00, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x79, 0x12, 0x3f, 0x0a,
+	0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61,
+	0x74, 0x48, 0x00, 0x52, 0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x36,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x48, 0x00, 0x52, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x22, 0x20, 0x0a, 0x08, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x62, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x30, 0x0a, 0x09, 0x48, 0x65, 0x61, 0x72, 0x74,
+	0x62, 0x65, 0x61, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x61, 0x73,
+	0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xc5, 0x05, 0x0a, 0x0c, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x79, 0x48, 0x00,
+	0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x30, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x4c, 0x0a, 0x0e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x66, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x06,
+	0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x27, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x48, 0x00, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12,
+	0x3a, 0x0a, 0x0b, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x2e, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52,
+	0x0a, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x40, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x48, 0x00, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x37, 0x0a, 0x0a, 0x64, 0x6d, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x2e, 0x44, 0x4d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x48, 0x00,
+	0x52, 0x09, 0x64, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x30, 0x0a, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x48, 0x00, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x27, 0x0a,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x48, 0x00,
+	0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x22, 0xd4, 0x03, 0x0a, 0x05, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x12, 0x2d, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
+	0x12, 0x30, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x73, 0x12, 0x2d, 0x0a, 0x07, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x07, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x73, 0x12, 0x37, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x09, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x0b, 0x64, 0x6d,
+	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x44, 0x4d,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x0a, 0x64, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x12, 0x37, 0x0a, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x0a, 0x72, 0x65, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x12,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x61,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65,
+	0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x22, 0x4f, 0x0a, 0x0d, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x73, 0x2a, 0x87, 0x03, 0x0a, 0x10, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15,
+	0x0a, 0x11, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x01,
+	0x12, 0x11, 0x0a, 0x0d, 0x48, 0x45, 0x41, 0x52, 0x54, 0x42, 0x45, 0x41, 0x54, 0x5f, 0x41, 0x43,
+	0x4b, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x43,
+	0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x45, 0x53, 0x53, 0x41,
+	0x47, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x4d,
+	0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x05, 0x12,
+	0x11, 0x0a, 0x0d, 0x46, 0x52, 0x49, 0x45, 0x4e, 0x44, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45,
+	0x10, 0x06, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x45, 0x53, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x55,
+	0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x07, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x53, 0x45, 0x52, 0x5f,
+	0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x08, 0x12, 0x16, 0x0a, 0x12, 0x56, 0x4f, 0x49, 0x43,
+	0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x09,
+	0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x53, 0x55, 0x4d, 0x45, 0x44, 0x10, 0x0a, 0x12, 0x13, 0x0a,
+	0x0f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e,
+	0x10, 0x0b, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x55, 0x50, 0x44,
+	0x41, 0x54, 0x45, 0x10, 0x0c, 0x12, 0x18, 0x0a, 0x14, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x53, 0x45,
+	0x54, 0x54, 0x49, 0x4e, 0x47, 0x53, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x0d, 0x12,
+	0x15, 0x0a, 0x11, 0x44, 0x4d, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x55, 0x50,
+	0x44, 0x41, 0x54, 0x45, 0x10, 0x0e, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45,
+	0x4c, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x0f, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x48,
+	0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x10, 0x12, 0x12,
+	0x0a, 0x0e, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45,
+	0x10, 0x11, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54,
+	0x45, 0x10, 0x12, 0x32, 0x69, 0x0a, 0x0e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x57, 0x0a, 0x07, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x28, 0x01, 0x30, 0x01, 0x42, 0xc3,
+	0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x42, 0x13, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x21, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x2f, 0x67, 0x65, 0x6e, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0xa2, 0x02, 0x03, 0x50, 0x47, 0x58, 0xaa, 0x02, 0x14, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0xca, 0x02, 0x14, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5c, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0xe2, 0x02, 0x20, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5c, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x3a, 0x3a, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_service_gateway_gateway_service_proto_rawDescOnce sync.Once
+	file_service_gateway_gateway_service_proto_rawDescData []byte
+)
+
+func file_service_gateway_gateway_service_proto_rawDescGZIP() []byte {
+	file_service_gateway_gateway_service_proto_rawDescOnce.Do(func() {
+		file_service_gateway_gateway_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_service_gateway_gateway_service_proto_rawDesc), len(file_service_gateway_gateway_service_proto_rawDesc)))
+	})
+	return file_service_gateway_gateway_service_proto_rawDescData
+}
+
+var file_service_gateway_gateway_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_service_gateway_gateway_service_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_service_gateway_gateway_service_proto_goTypes = []any{
+	(GatewayEventType)(0),          // 0: protoservice.gateway.GatewayEventType
+	(*GatewayRequest)(nil),         // 1: protoservice.gateway.GatewayRequest
+	(*Identify)(nil),               // 2: protoservice.gateway.Identify
+	(*Resume)(nil),                 // 3: protoservice.gateway.Resume
+	(*Heartbeat)(nil),              // 4: protoservice.gateway.Heartbeat
+	(*GatewayEvent)(nil),           // 5: protoservice.gateway.GatewayEvent
+	(*Ready)(nil),                  // 6: protoservice.gateway.Ready
+	(*MessageDelete)(nil),          // 7: protoservice.gateway.MessageDelete
+	(*schema.Message)(nil),         // 8: protoschema.Message
+	(*schema.Friend)(nil),          // 9: protoschema.Friend
+	(*schema.User)(nil),            // 10: protoschema.User
+	(*schema.VoiceState)(nil),      // 11: protoschema.VoiceState
+	(*schema.ServerMember)(nil),    // 12: protoschema.ServerMember
+	(*schema.UserSettings)(nil),    // 13: protoschema.UserSettings
+	(*schema.DMChannel)(nil),       // 14: protoschema.DMChannel
+	(*schema.Channel)(nil),         // 15: protoschema.Channel
+	(*schema.Role)(nil),            // 16: protoschema.Role
+	(*schema.Server)(nil),          // 17: protoschema.Server
+	(*schema.UserPresence)(nil),    // 18: protoschema.UserPresence
+	(*schema.ReadState)(nil),       // 19: protoschema.ReadState
+	(*schema.ServerReadState)(nil), // 20: protoschema.ServerReadState
+}
+var file_service_gateway_gateway_service_proto_depIdxs = []int32{
+	2,  // 0: protoservice.gateway.GatewayRequest.identify:type_name -> protoservice.gateway.Identify
+	4,  // 1: protoservice.gateway.GatewayRequest.heartbeat:type_name -> protoservice.gateway.Heartbeat
+	3,  // 2: protoservice.gateway.GatewayRequest.resume:type_name -> protoservice.gateway.Resume
+	0,  // 3: protoservice.gateway.GatewayEvent.type:type_name -> protoservice.gateway.GatewayEventType
+	6,  // 4: protoservice.gateway.GatewayEvent.ready:type_name -> protoservice.gateway.Ready
+	8,  // 5: protoservice.gateway.GatewayEvent.message:type_name -> protoschema.Message
+	7,  // 6: protoservice.gateway.GatewayEvent.message_delete:type_name -> protoservice.gateway.MessageDelete
+	9,  // 7: protoservice.gateway.GatewayEvent.friend:type_name -> protoschema.Friend
+	10, // 8: protoservice.gateway.GatewayEvent.user:type_name -> protoschema.User
+	11, // 9: protoservice.gateway.GatewayEvent.voice_state:type_name -> protoschema.VoiceState
+	12, // 10: protoservice.gateway.GatewayEvent.member:type_name -> protoschema.ServerMember
+	13, // 11: protoservice.gateway.GatewayEvent.user_settings:type_name -> protoschema.UserSettings
+	14, // 12: protoservice.gateway.GatewayEvent.dm_channel:type_name -> protoschema.DMChannel
+	15, // 13: protoservice.gateway.GatewayEvent.channel:type_name -> protoschema.Channel
+	16, // 14: protoservice.gateway.GatewayEvent.role:type_name -> protoschema.Role
+	10, // 15: protoservice.gateway.Ready.user:type_name -> protoschema.User
+	17, // 16: protoservice.gateway.Ready.servers:type_name -> protoschema.Server
+	15, // 17: protoservice.gateway.Ready.channels:type_name -> protoschema.Channel
+	9,  // 18: protoservice.gateway.Ready.friends:type_name -> protoschema.Friend
+	18, // 19: protoservice.gateway.Ready.presences:type_name -> protoschema.UserPresence
+	14, // 20: protoservice.gateway.Ready.dm_channels:type_name -> protoschema.DMChannel
+	19, // 21: protoservice.gateway.Ready.read_states:type_name -> protoschema.ReadState
+	20, // 22: protoservice.gateway.Ready.server_read_states:type_name -> protoschema.ServerReadState
+	1,  // 23: protoservice.gateway.GatewayService.Gateway:input_type -> protoservice.gateway.GatewayRequest
+	5,  // 24: protoservice.gateway.GatewayService.Gateway:output_type -> protoservice.gateway.GatewayEvent
+	24, // [24:25] is the sub-list for method output_type
+	23, // [23:24] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
+}
+
+func init() { file_service_gateway_gateway_service_proto_init() }
+func file_service_gateway_gateway_service_proto_init() {
+	if File_service_gateway_gateway_service_proto != nil {
+		return
+	}
+	file_service_gateway_gateway_service_proto_msgTypes[0].OneofWrappers = []any{
+		(*GatewayRequest_Identify)(nil),
+		(*GatewayRequest_Heartbeat)(nil),
+		(*GatewayRequest_Resume)(nil),
+	}
+	file_service_gateway_gateway_service_proto_msgTypes[4].OneofWrappers = []any{
+		(*GatewayEvent_Ready)(nil),
+		(*GatewayEvent_Message)(nil),
+		(*GatewayEvent_MessageDelete)(nil),
+		(*GatewayEvent_Friend)(nil),
+		(*GatewayEvent_User)(nil),
+		(*GatewayEvent_VoiceState)(nil),
+		(*GatewayEvent_Member)(nil),
+		(*GatewayEvent_UserSettings)(nil),
+		(*GatewayEvent_DmChannel)(nil),
+		(*GatewayEvent_Channel)(nil),
+		(*GatewayEvent_Role)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_service_gateway_gateway_service_proto_rawDesc), len(file_service_gateway_gateway_service_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_service_gateway_gateway_service_proto_goTypes,
+		DependencyIndexes: file_service_gateway_gateway_service_proto_depIdxs,
+		EnumInfos:         file_service_gateway_gateway_service_proto_enumTypes,
+		MessageInfos:      file_service_gateway_gateway_service_proto_msgTypes,
+	}.Build()
+	File_service_gateway_gateway_service_proto = out.File
+	file_service_gateway_gateway_service_proto_goTypes = nil
+	file_service_gateway_gateway_service_proto_depIdxs = nil
+}
@@ -0,0 +1,197 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: service/read_state/read_state_service.proto
+
+package read_state
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ReadStateService_Ack_FullMethodName            = "/protoservice.read_state.ReadStateService/Ack"
+	ReadStateService_MarkServerRead_FullMethodName = "/protoservice.read_state.ReadStateService/MarkServerRead"
+	ReadStateService_GetReadStates_FullMethodName  = "/protoservice.read_state.ReadStateService/GetReadStates"
+)
+
+// ReadStateServiceClient is the client API for ReadStateService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReadStateServiceClient interface {
+	Ack(ctx context.Context, in *AckRequest, opts ...grpc.CallOption) (*AckResponse, error)
+	MarkServerRead(ctx context.Context, in *MarkServerReadRequest, opts ...grpc.CallOption) (*MarkServerReadResponse, error)
+	GetReadStates(ctx context.Context, in *GetReadStatesRequest, opts ...grpc.CallOption) (*GetReadStatesResponse, error)
+}
+
+type readStateServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReadStateServiceClient(cc grpc.ClientConnInterface) ReadStateServiceClient {
+	return &readStateServiceClient{cc}
+}
+
+func (c *readStateServiceClient) Ack(ctx context.Context, in *AckRequest, opts ...grpc.CallOption) (*AckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AckResponse)
+	err := c.cc.Invoke(ctx, ReadStateService_Ack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *readStateServiceClient) MarkServerRead(ctx context.Context, in *MarkServerReadRequest, opts ...grpc.CallOption) (*MarkServerReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkServerReadResponse)
+	err := c.cc.Invoke(ctx, ReadStateService_MarkServerRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *readStateServiceClient) GetReadStates(ctx context.Context, in *GetReadStatesRequest, opts ...grpc.CallOption) (*GetReadStatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReadStatesResponse)
+	err := c.cc.Invoke(ctx, ReadStateService_GetReadStates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReadStateServiceServer is the server API for ReadStateService service.
+// All implementations must embed UnimplementedReadStateServiceServer
+// for forward compatibility.
+type ReadStateServiceServer interface {
+	Ack(context.Context, *AckRequest) (*AckResponse, error)
+	MarkServerRead(context.Context, *MarkServerReadRequest) (*MarkServerReadResponse, error)
+	GetReadStates(context.Context, *GetReadStatesRequest) (*GetReadStatesResponse, error)
+	mustEmbedUnimplementedReadStateServiceServer()
+}
+
+// UnimplementedReadStateServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReadStateServiceServer struct{}
+
+func (UnimplementedReadStateServiceServer) Ack(context.Context, *AckRequest) (*AckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ack not implemented")
+}
+func (UnimplementedReadStateServiceServer) MarkServerRead(context.Context, *MarkServerReadRequest) (*MarkServerReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkServerRead not implemented")
+}
+func (UnimplementedReadStateServiceServer) GetReadStates(context.Context, *GetReadStatesRequest) (*GetReadStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReadStates not implemented")
+}
+func (UnimplementedReadStateServiceServer) mustEmbedUnimplementedReadStateServiceServer() {}
+func (UnimplementedReadStateServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeReadStateServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReadStateServiceServer will
+// result in compilation errors.
+type UnsafeReadStateServiceServer interface {
+	mustEmbedUnimplementedReadStateServiceServer()
+}
+
+func RegisterReadStateServiceServer(s grpc.ServiceRegistrar, srv ReadStateServiceServer) {
+	// If the following call pancis, it indicates UnimplementedReadStateServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ReadStateService_ServiceDesc, srv)
+}
+
+func _ReadStateService_Ack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReadStateServiceServer).Ack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReadStateService_Ack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReadStateServiceServer).Ack(ctx, req.(*AckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReadStateService_MarkServerRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkServerReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReadStateServiceServer).MarkServerRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReadStateService_MarkServerRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReadStateServiceServer).MarkServerRead(ctx, req.(*MarkServerReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReadStateService_GetReadStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReadStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReadStateServiceServer).GetReadStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReadStateService_GetReadStates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReadStateServiceServer).GetReadStates(ctx, req.(*GetReadStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReadStateService_ServiceDesc is the grpc.ServiceDesc for ReadStateService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReadStateService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "protoservice.read_state.ReadStateService",
+	HandlerType: (*ReadStateServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Ack",
+			Handler:    _ReadStateService_Ack_Handler,
+		},
+		{
+			MethodName: "MarkServerRead",
+			Handler:    _ReadStateService_MarkServerRead_Handler,
+		},
+		{
+			MethodName: "GetReadStates",
+			Handler:    _ReadStateService_GetReadStates_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service/read_state/read_state_service.proto",
+}
@@ -23,6 +23,7 @@ const (
 	ServerService_GetServer_FullMethodName            = "/protoservice.server.ServerService/GetServer"
 	ServerService_UpdateServer_FullMethodName         = "/protoservice.server.ServerService/UpdateServer"
 	ServerService_DeleteServer_FullMethodName         = "/protoservice.server.ServerService/DeleteServer"
+	ServerService_TransferOwnership_FullMethodName    = "/protoservice.server.ServerService/TransferOwnership"
 	ServerService_GetUserServers_FullMethodName       = "/protoservice.server.ServerService/GetUserServers"
 	ServerService_AddMember_FullMethodName            = "/protoservice.server.ServerService/AddMember"
 	ServerService_RemoveMember_FullMethodName         = "/protoservice.server.ServerService/RemoveMember"
@@ -31,11 +32,20 @@ const (
 	ServerService_KickMember_FullMethodName           = "/protoservice.server.ServerService/KickMember"
 	ServerService_BanMember_FullMethodName            = "/protoservice.server.ServerService/BanMember"
 	ServerService_UnbanMember_FullMethodName          = "/protoservice.server.ServerService/UnbanMember"
+	ServerService_BulkBanMembers_FullMethodName       = "/protoservice.server.ServerService/BulkBanMembers"
+	ServerService_TimeoutMember_FullMethodName        = "/protoservice.server.ServerService/TimeoutMember"
+	ServerService_GetAuditLog_FullMethodName          = "/protoservice.server.ServerService/GetAuditLog"
+	ServerService_ReorderRoles_FullMethodName         = "/protoservice.server.ServerService/ReorderRoles"
+	ServerService_AssignRole_FullMethodName           = "/protoservice.server.ServerService/AssignRole"
+	ServerService_UnassignRole_FullMethodName         = "/protoservice.server.ServerService/UnassignRole"
 	ServerService_CreateInvite_FullMethodName         = "/protoservice.server.ServerService/CreateInvite"
 	ServerService_GetInvite_FullMethodName            = "/protoservice.server.ServerService/GetInvite"
 	ServerService_DeleteInvite_FullMethodName         = "/protoservice.server.ServerService/DeleteInvite"
 	ServerService_GetServerInvites_FullMethodName     = "/protoservice.server.ServerService/GetServerInvites"
 	ServerService_JoinServerWithInvite_FullMethodName = "/protoservice.server.ServerService/JoinServerWithInvite"
+	ServerService_GetInviteJoins_FullMethodName       = "/protoservice.server.ServerService/GetInviteJoins"
+	ServerService_ClaimVanityUrl_FullMethodName       = "/protoservice.server.ServerService/ClaimVanityUrl"
+	ServerService_ResolveVanityUrl_FullMethodName     = "/protoservice.server.ServerService/ResolveVanityUrl"
 	ServerService_CreateEmoji_FullMethodName          = "/protoservice.server.ServerService/CreateEmoji"
 	ServerService_DeleteEmoji_FullMethodName          = "/protoservice.server.ServerService/DeleteEmoji"
 	ServerService_GetServerEmojis_FullMethodName      = "/protoservice.server.ServerService/GetServerEmojis"
@@ -50,6 +60,7 @@ type ServerServiceClient interface {
 	GetServer(ctx context.Context, in *GetServerRequest, opts ...grpc.CallOption) (*GetServerResponse, error)
 	UpdateServer(ctx context.Context, in *UpdateServerRequest, opts ...grpc.CallOption) (*UpdateServerResponse, error)
 	DeleteServer(ctx context.Context, in *DeleteServerRequest, opts ...grpc.CallOption) (*DeleteServerResponse, error)
+	TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*TransferOwnershipResponse, error)
 	GetUserServers(ctx context.Context, in *GetUserServersRequest, opts ...grpc.CallOption) (*GetUserServersResponse, error)
 	// Member Management
 	AddMember(ctx context.Context, in *AddMemberRequest, opts ...grpc.CallOption) (*AddMemberResponse, error)
@@ -59,12 +70,22 @@ type ServerServiceClient interface {
 	KickMember(ctx context.Context, in *KickMemberRequest, opts ...grpc.CallOption) (*KickMemberResponse, error)
 	BanMember(ctx context.Context, in *BanMemberRequest, opts ...grpc.CallOption) (*BanMemberResponse, error)
 	UnbanMember(ctx context.Context, in *UnbanMemberRequest, opts ...grpc.CallOption) (*UnbanMemberResponse, error)
+	BulkBanMembers(ctx context.Context, in *BulkBanMembersRequest, opts ...grpc.CallOption) (*BulkBanMembersResponse, error)
+	TimeoutMember(ctx context.Context, in *TimeoutMemberRequest, opts ...grpc.CallOption) (*TimeoutMemberResponse, error)
+	GetAuditLog(ctx context.Context, in *GetAuditLogRequest, opts ...grpc.CallOption) (*GetAuditLogResponse, error)
+	// Role Management
+	ReorderRoles(ctx context.Context, in *ReorderRolesRequest, opts ...grpc.CallOption) (*ReorderRolesResponse, error)
+	AssignRole(ctx context.Context, in *AssignRoleRequest, opts ...grpc.CallOption) (*AssignRoleResponse, error)
+	UnassignRole(ctx context.Context, in *UnassignRoleRequest, opts ...grpc.CallOption) (*UnassignRoleResponse, error)
 	// Invite Management
 	CreateInvite(ctx context.Context, in *CreateInviteRequest, opts ...grpc.CallOption) (*CreateInviteResponse, error)
 	GetInvite(ctx context.Context, in *GetInviteRequest, opts ...grpc.CallOption) (*GetInviteResponse, error)
 	DeleteInvite(ctx context.Context, in *DeleteInviteRequest, opts ...grpc.CallOption) (*DeleteInviteResponse, error)
 	GetServerInvites(ctx context.Context, in *GetServerInvitesRequest, opts ...grpc.CallOption) (*GetServerInvitesResponse, error)
 	JoinServerWithInvite(ctx context.Context, in *JoinServerWithInviteRequest, opts ...grpc.CallOption) (*JoinServerWithInviteResponse, error)
+	GetInviteJoins(ctx context.Context, in *GetInviteJoinsRequest, opts ...grpc.CallOption) (*GetInviteJoinsResponse, error)
+	ClaimVanityUrl(ctx context.Context, in *ClaimVanityUrlRequest, opts ...grpc.CallOption) (*ClaimVanityUrlResponse, error)
+	ResolveVanityUrl(ctx context.Context, in *ResolveVanityUrlRequest, opts ...grpc.CallOption) (*ResolveVanityUrlResponse, error)
 	// Emoji & Sticker Management
 	CreateEmoji(ctx context.Context, in *CreateEmojiRequest, opts ...grpc.CallOption) (*CreateEmojiResponse, error)
 	DeleteEmoji(ctx context.Context, in *DeleteEmojiRequest, opts ...grpc.CallOption) (*DeleteEmojiResponse, error)
@@ -119,6 +140,16 @@ func (c *serverServiceClient) DeleteServer(ctx context.Context, in *DeleteServer
 	return out, nil
 }
 
+func (c *serverServiceClient) TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*TransferOwnershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferOwnershipResponse)
+	err := c.cc.Invoke(ctx, ServerService_TransferOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serverServiceClient) GetUserServers(ctx context.Context, in *GetUserServersRequest, opts ...grpc.CallOption) (*GetUserServersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetUserServersResponse)
@@ -208,6 +239,66 @@ func (c *serverServiceClient) UnbanMember(ctx context.Context, in *UnbanMemberRe
 	return out, nil
 }
 
+func (c *serverServiceClient) BulkBanMembers(ctx context.Context, in *BulkBanMembersRequest, opts ...grpc.CallOption) (*BulkBanMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkBanMembersResponse)
+	err := c.cc.Invoke(ctx, ServerService_BulkBanMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) TimeoutMember(ctx context.Context, in *TimeoutMemberRequest, opts ...grpc.CallOption) (*TimeoutMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TimeoutMemberResponse)
+	err := c.cc.Invoke(ctx, ServerService_TimeoutMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) GetAuditLog(ctx context.Context, in *GetAuditLogRequest, opts ...grpc.CallOption) (*GetAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAuditLogResponse)
+	err := c.cc.Invoke(ctx, ServerService_GetAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) ReorderRoles(ctx context.Context, in *ReorderRolesRequest, opts ...grpc.CallOption) (*ReorderRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderRolesResponse)
+	err := c.cc.Invoke(ctx, ServerService_ReorderRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) AssignRole(ctx context.Context, in *AssignRoleRequest, opts ...grpc.CallOption) (*AssignRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignRoleResponse)
+	err := c.cc.Invoke(ctx, ServerService_AssignRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) UnassignRole(ctx context.Context, in *UnassignRoleRequest, opts ...grpc.CallOption) (*UnassignRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnassignRoleResponse)
+	err := c.cc.Invoke(ctx, ServerService_UnassignRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serverServiceClient) CreateInvite(ctx context.Context, in *CreateInviteRequest, opts ...grpc.CallOption) (*CreateInviteResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateInviteResponse)
@@ -258,6 +349,36 @@ func (c *serverServiceClient) JoinServerWithInvite(ctx context.Context, in *Join
 	return out, nil
 }
 
+func (c *serverServiceClient) GetInviteJoins(ctx context.Context, in *GetInviteJoinsRequest, opts ...grpc.CallOption) (*GetInviteJoinsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInviteJoinsResponse)
+	err := c.cc.Invoke(ctx, ServerService_GetInviteJoins_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) ClaimVanityUrl(ctx context.Context, in *ClaimVanityUrlRequest, opts ...grpc.CallOption) (*ClaimVanityUrlResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimVanityUrlResponse)
+	err := c.cc.Invoke(ctx, ServerService_ClaimVanityUrl_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) ResolveVanityUrl(ctx context.Context, in *ResolveVanityUrlRequest, opts ...grpc.CallOption) (*ResolveVanityUrlResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveVanityUrlResponse)
+	err := c.cc.Invoke(ctx, ServerService_ResolveVanityUrl_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serverServiceClient) CreateEmoji(ctx context.Context, in *CreateEmojiRequest, opts ...grpc.CallOption) (*CreateEmojiResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateEmojiResponse)
@@ -297,6 +418,7 @@ type ServerServiceServer interface {
 	GetServer(context.Context, *GetServerRequest) (*GetServerResponse, error)
 	UpdateServer(context.Context, *UpdateServerRequest) (*UpdateServerResponse, error)
 	DeleteServer(context.Context, *DeleteServerRequest) (*DeleteServerResponse, error)
+	TransferOwnership(context.Context, *TransferOwnershipRequest) (*TransferOwnershipResponse, error)
 	GetUserServers(context.Context, *GetUserServersRequest) (*GetUserServersResponse, error)
 	// Member Management
 	AddMember(context.Context, *AddMemberRequest) (*AddMemberResponse, error)
@@ -306,12 +428,22 @@ type ServerServiceServer interface {
 	KickMember(context.Context, *KickMemberRequest) (*KickMemberResponse, error)
 	BanMember(context.Context, *BanMemberRequest) (*BanMemberResponse, error)
 	UnbanMember(context.Context, *UnbanMemberRequest) (*UnbanMemberResponse, error)
+	BulkBanMembers(context.Context, *BulkBanMembersRequest) (*BulkBanMembersResponse, error)
+	TimeoutMember(context.Context, *TimeoutMemberRequest) (*TimeoutMemberResponse, error)
+	GetAuditLog(context.Context, *GetAuditLogRequest) (*GetAuditLogResponse, error)
+	// Role Management
+	ReorderRoles(context.Context, *ReorderRolesRequest) (*ReorderRolesResponse, error)
+	AssignRole(context.Context, *AssignRoleRequest) (*AssignRoleResponse, error)
+	UnassignRole(context.Context, *UnassignRoleRequest) (*UnassignRoleResponse, error)
 	// Invite Management
 	CreateInvite(context.Context, *CreateInviteRequest) (*CreateInviteResponse, error)
 	GetInvite(context.Context, *GetInviteRequest) (*GetInviteResponse, error)
 	DeleteInvite(context.Context, *DeleteInviteRequest) (*DeleteInviteResponse, error)
 	GetServerInvites(context.Context, *GetServerInvitesRequest) (*GetServerInvitesResponse, error)
 	JoinServerWithInvite(context.Context, *JoinServerWithInviteRequest) (*JoinServerWithInviteResponse, error)
+	GetInviteJoins(context.Context, *GetInviteJoinsRequest) (*GetInviteJoinsResponse, error)
+	ClaimVanityUrl(context.Context, *ClaimVanityUrlRequest) (*ClaimVanityUrlResponse, error)
+	ResolveVanityUrl(context.Context, *ResolveVanityUrlRequest) (*ResolveVanityUrlResponse, error)
 	// Emoji & Sticker Management
 	CreateEmoji(context.Context, *CreateEmojiRequest) (*CreateEmojiResponse, error)
 	DeleteEmoji(context.Context, *DeleteEmojiRequest) (*DeleteEmojiResponse, error)
@@ -338,6 +470,9 @@ func (UnimplementedServerServiceServer) UpdateServer(context.Context, *UpdateSer
 func (UnimplementedServerServiceServer) DeleteServer(context.Context, *DeleteServerRequest) (*DeleteServerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteServer not implemented")
 }
+func (UnimplementedServerServiceServer) TransferOwnership(context.Context, *TransferOwnershipRequest) (*TransferOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
+}
 func (UnimplementedServerServiceServer) GetUserServers(context.Context, *GetUserServersRequest) (*GetUserServersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserServers not implemented")
 }
@@ -362,6 +497,24 @@ func (UnimplementedServerServiceServer) BanMember(context.Context, *BanMemberReq
 func (UnimplementedServerServiceServer) UnbanMember(context.Context, *UnbanMemberRequest) (*UnbanMemberResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnbanMember not implemented")
 }
+func (UnimplementedServerServiceServer) BulkBanMembers(context.Context, *BulkBanMembersRequest) (*BulkBanMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkBanMembers not implemented")
+}
+func (UnimplementedServerServiceServer) TimeoutMember(context.Context, *TimeoutMemberRequest) (*TimeoutMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimeoutMember not implemented")
+}
+func (UnimplementedServerServiceServer) GetAuditLog(context.Context, *GetAuditLogRequest) (*GetAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLog not implemented")
+}
+func (UnimplementedServerServiceServer) ReorderRoles(context.Context, *ReorderRolesRequest) (*ReorderRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderRoles not implemented")
+}
+func (UnimplementedServerServiceServer) AssignRole(context.Context, *AssignRoleRequest) (*AssignRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignRole not implemented")
+}
+func (UnimplementedServerServiceServer) UnassignRole(context.Context, *UnassignRoleRequest) (*UnassignRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnassignRole not implemented")
+}
 func (UnimplementedServerServiceServer) CreateInvite(context.Context, *CreateInviteRequest) (*CreateInviteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateInvite not implemented")
 }
@@ -377,6 +530,15 @@ func (UnimplementedServerServiceServer) GetServerInvites(context.Context, *GetSe
 func (UnimplementedServerServiceServer) JoinServerWithInvite(context.Context, *JoinServerWithInviteRequest) (*JoinServerWithInviteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method JoinServerWithInvite not implemented")
 }
+func (UnimplementedServerServiceServer) GetInviteJoins(context.Context, *GetInviteJoinsRequest) (*GetInviteJoinsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInviteJoins not implemented")
+}
+func (UnimplementedServerServiceServer) ClaimVanityUrl(context.Context, *ClaimVanityUrlRequest) (*ClaimVanityUrlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimVanityUrl not implemented")
+}
+func (UnimplementedServerServiceServer) ResolveVanityUrl(context.Context, *ResolveVanityUrlRequest) (*ResolveVanityUrlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveVanityUrl not implemented")
+}
 func (UnimplementedServerServiceServer) CreateEmoji(context.Context, *CreateEmojiRequest) (*CreateEmojiResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateEmoji not implemented")
 }
@@ -479,6 +641,24 @@ func _ServerService_DeleteServer_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ServerService_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).TransferOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_TransferOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).TransferOwnership(ctx, req.(*TransferOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ServerService_GetUserServers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUserServersRequest)
 	if err := dec(in); err != nil {
@@ -616,6 +796,114 @@ func _ServerService_UnbanMember_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ServerService_BulkBanMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkBanMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).BulkBanMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_BulkBanMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).BulkBanMembers(ctx, req.(*BulkBanMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_TimeoutMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeoutMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).TimeoutMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_TimeoutMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).TimeoutMember(ctx, req.(*TimeoutMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_GetAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).GetAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_GetAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).GetAuditLog(ctx, req.(*GetAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_ReorderRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).ReorderRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_ReorderRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).ReorderRoles(ctx, req.(*ReorderRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_AssignRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).AssignRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_AssignRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).AssignRole(ctx, req.(*AssignRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_UnassignRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnassignRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).UnassignRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_UnassignRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).UnassignRole(ctx, req.(*UnassignRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ServerService_CreateInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateInviteRequest)
 	if err := dec(in); err != nil {
@@ -706,6 +994,60 @@ func _ServerService_JoinServerWithInvite_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ServerService_GetInviteJoins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInviteJoinsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).GetInviteJoins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_GetInviteJoins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).GetInviteJoins(ctx, req.(*GetInviteJoinsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_ClaimVanityUrl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimVanityUrlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).ClaimVanityUrl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_ClaimVanityUrl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).ClaimVanityUrl(ctx, req.(*ClaimVanityUrlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_ResolveVanityUrl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveVanityUrlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).ResolveVanityUrl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_ResolveVanityUrl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).ResolveVanityUrl(ctx, req.(*ResolveVanityUrlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ServerService_CreateEmoji_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateEmojiRequest)
 	if err := dec(in); err != nil {
@@ -783,6 +1125,10 @@ var ServerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteServer",
 			Handler:    _ServerService_DeleteServer_Handler,
 		},
+		{
+			MethodName: "TransferOwnership",
+			Handler:    _ServerService_TransferOwnership_Handler,
+		},
 		{
 			MethodName: "GetUserServers",
 			Handler:    _ServerService_GetUserServers_Handler,
@@ -811,6 +1157,30 @@ var ServerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnbanMember",
 			Handler:    _ServerService_UnbanMember_Handler,
 		},
+		{
+			MethodName: "BulkBanMembers",
+			Handler:    _ServerService_BulkBanMembers_Handler,
+		},
+		{
+			MethodName: "TimeoutMember",
+			Handler:    _ServerService_TimeoutMember_Handler,
+		},
+		{
+			MethodName: "GetAuditLog",
+			Handler:    _ServerService_GetAuditLog_Handler,
+		},
+		{
+			MethodName: "ReorderRoles",
+			Handler:    _ServerService_ReorderRoles_Handler,
+		},
+		{
+			MethodName: "AssignRole",
+			Handler:    _ServerService_AssignRole_Handler,
+		},
+		{
+			MethodName: "UnassignRole",
+			Handler:    _ServerService_UnassignRole_Handler,
+		},
 		{
 			MethodName: "CreateInvite",
 			Handler:    _ServerService_CreateInvite_Handler,
@@ -831,6 +1201,18 @@ var ServerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "JoinServerWithInvite",
 			Handler:    _ServerService_JoinServerWithInvite_Handler,
 		},
+		{
+			MethodName: "GetInviteJoins",
+			Handler:    _ServerService_GetInviteJoins_Handler,
+		},
+		{
+			MethodName: "ClaimVanityUrl",
+			Handler:    _ServerService_ClaimVanityUrl_Handler,
+		},
+		{
+			MethodName: "ResolveVanityUrl",
+			Handler:    _ServerService_ResolveVanityUrl_Handler,
+		},
 		{
 			MethodName: "CreateEmoji",
 			Handler:    _ServerService_CreateEmoji_Handler,
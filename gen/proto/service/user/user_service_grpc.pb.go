@@ -29,6 +29,7 @@ const (
 	UserService_SetCustomStatus_FullMethodName          = "/protoservice.user.UserService/SetCustomStatus"
 	UserService_UpdateUserSettings_FullMethodName       = "/protoservice.user.UserService/UpdateUserSettings"
 	UserService_GetUserSettings_FullMethodName          = "/protoservice.user.UserService/GetUserSettings"
+	UserService_UpdateServerSettings_FullMethodName     = "/protoservice.user.UserService/UpdateServerSettings"
 	UserService_BlockUser_FullMethodName                = "/protoservice.user.UserService/BlockUser"
 	UserService_UnblockUser_FullMethodName              = "/protoservice.user.UserService/UnblockUser"
 	UserService_GetBlockedUsers_FullMethodName          = "/protoservice.user.UserService/GetBlockedUsers"
@@ -53,6 +54,7 @@ type UserServiceClient interface {
 	// User Settings
 	UpdateUserSettings(ctx context.Context, in *UpdateUserSettingsRequest, opts ...grpc.CallOption) (*UpdateUserSettingsResponse, error)
 	GetUserSettings(ctx context.Context, in *GetUserSettingsRequest, opts ...grpc.CallOption) (*GetUserSettingsResponse, error)
+	UpdateServerSettings(ctx context.Context, in *UpdateServerSettingsRequest, opts ...grpc.CallOption) (*UpdateServerSettingsResponse, error)
 	// User Relationships
 	BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error)
 	UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*UnblockUserResponse, error)
@@ -161,6 +163,16 @@ func (c *userServiceClient) GetUserSettings(ctx context.Context, in *GetUserSett
 	return out, nil
 }
 
+func (c *userServiceClient) UpdateServerSettings(ctx context.Context, in *UpdateServerSettingsRequest, opts ...grpc.CallOption) (*UpdateServerSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateServerSettingsResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdateServerSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BlockUserResponse)
@@ -255,6 +267,7 @@ type UserServiceServer interface {
 	// User Settings
 	UpdateUserSettings(context.Context, *UpdateUserSettingsRequest) (*UpdateUserSettingsResponse, error)
 	GetUserSettings(context.Context, *GetUserSettingsRequest) (*GetUserSettingsResponse, error)
+	UpdateServerSettings(context.Context, *UpdateServerSettingsRequest) (*UpdateServerSettingsResponse, error)
 	// User Relationships
 	BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error)
 	UnblockUser(context.Context, *UnblockUserRequest) (*UnblockUserResponse, error)
@@ -300,6 +313,9 @@ func (UnimplementedUserServiceServer) UpdateUserSettings(context.Context, *Updat
 func (UnimplementedUserServiceServer) GetUserSettings(context.Context, *GetUserSettingsRequest) (*GetUserSettingsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserSettings not implemented")
 }
+func (UnimplementedUserServiceServer) UpdateServerSettings(context.Context, *UpdateServerSettingsRequest) (*UpdateServerSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateServerSettings not implemented")
+}
 func (UnimplementedUserServiceServer) BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockUser not implemented")
 }
@@ -501,6 +517,24 @@ func _UserService_GetUserSettings_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_UpdateServerSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateServerSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateServerSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateServerSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateServerSettings(ctx, req.(*UpdateServerSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BlockUserRequest)
 	if err := dec(in); err != nil {
@@ -638,6 +672,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserSettings",
 			Handler:    _UserService_GetUserSettings_Handler,
 		},
+		{
+			MethodName: "UpdateServerSettings",
+			Handler:    _UserService_UpdateServerSettings_Handler,
+		},
 		{
 			MethodName: "BlockUser",
 			Handler:    _UserService_BlockUser_Handler,
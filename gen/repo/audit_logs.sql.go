@@ -62,25 +62,16 @@ func (q *Queries) CreateAuditLog(ctx context.Context, arg CreateAuditLogParams)
 	return i, err
 }
 
-const deleteOldAuditLogs = `-- name: DeleteOldAuditLogs :one
-DELETE FROM audit_logs WHERE created_at < $1 RETURNING id, server_id, user_id, action, target_id, target_type, changes, reason, created_at
+const deleteOldAuditLogs = `-- name: DeleteOldAuditLogs :execrows
+DELETE FROM audit_logs WHERE created_at < $1
 `
 
-func (q *Queries) DeleteOldAuditLogs(ctx context.Context, createdAt pgtype.Timestamp) (AuditLog, error) {
-	row := q.db.QueryRow(ctx, deleteOldAuditLogs, createdAt)
-	var i AuditLog
-	err := row.Scan(
-		&i.ID,
-		&i.ServerID,
-		&i.UserID,
-		&i.Action,
-		&i.TargetID,
-		&i.TargetType,
-		&i.Changes,
-		&i.Reason,
-		&i.CreatedAt,
-	)
-	return i, err
+func (q *Queries) DeleteOldAuditLogs(ctx context.Context, createdAt pgtype.Timestamp) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteOldAuditLogs, createdAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const getAuditLogs = `-- name: GetAuditLogs :many
@@ -237,3 +228,75 @@ func (q *Queries) GetAuditLogsByUser(ctx context.Context, arg GetAuditLogsByUser
 	}
 	return items, nil
 }
+
+const listAuditLogs = `-- name: ListAuditLogs :many
+SELECT id, server_id, user_id, action, target_id, target_type, changes, reason, created_at
+FROM audit_logs
+WHERE
+    server_id = $1
+    AND (
+        $2::int IS NULL
+        OR user_id = $2
+    )
+    AND (
+        $3::text IS NULL
+        OR action = $3
+    )
+    AND (
+        $4::int IS NULL
+        OR target_id = $4
+    )
+    AND (
+        $5::int IS NULL
+        OR id < $5
+    )
+ORDER BY id DESC
+LIMIT $6
+`
+
+type ListAuditLogsParams struct {
+	ServerID    int32       `json:"server_id"`
+	UserID      pgtype.Int4 `json:"user_id"`
+	Action      pgtype.Text `json:"action"`
+	TargetID    pgtype.Int4 `json:"target_id"`
+	BeforeID    pgtype.Int4 `json:"before_id"`
+	ResultLimit int32       `json:"result_limit"`
+}
+
+// Newest entries first; filters left NULL match every entry
+func (q *Queries) ListAuditLogs(ctx context.Context, arg ListAuditLogsParams) ([]AuditLog, error) {
+	rows, err := q.db.Query(ctx, listAuditLogs,
+		arg.ServerID,
+		arg.UserID,
+		arg.Action,
+		arg.TargetID,
+		arg.BeforeID,
+		arg.ResultLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditLog
+	for rows.Next() {
+		var i AuditLog
+		if err := rows.Scan(
+			&i.ID,
+			&i.ServerID,
+			&i.UserID,
+			&i.Action,
+			&i.TargetID,
+			&i.TargetType,
+			&i.Changes,
+			&i.Reason,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
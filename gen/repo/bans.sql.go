@@ -21,6 +21,14 @@ INSERT INTO
         expires_at
     )
 VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (server_id, user_id) DO
+UPDATE
+SET
+    moderator_id = EXCLUDED.moderator_id,
+    reason = EXCLUDED.reason,
+    expires_at = EXCLUDED.expires_at,
+    is_deleted = FALSE,
+    created_at = CURRENT_TIMESTAMP
 RETURNING
     id, server_id, user_id, moderator_id, reason, expires_at, is_deleted, created_at
 `
@@ -204,8 +212,7 @@ func (q *Queries) IsUserBanned(ctx context.Context, arg IsUserBannedParams) (boo
 const restoreBan = `-- name: RestoreBan :one
 UPDATE bans
 SET
-    is_deleted = FALSE,
-    updated_at = CURRENT_TIMESTAMP
+    is_deleted = FALSE
 WHERE
     server_id = $1
     AND user_id = $2
@@ -237,8 +244,7 @@ func (q *Queries) RestoreBan(ctx context.Context, arg RestoreBanParams) (Ban, er
 const softDeleteBan = `-- name: SoftDeleteBan :one
 UPDATE bans
 SET
-    is_deleted = TRUE,
-    updated_at = CURRENT_TIMESTAMP
+    is_deleted = TRUE
 WHERE
     server_id = $1
     AND user_id = $2
@@ -267,11 +273,10 @@ func (q *Queries) SoftDeleteBan(ctx context.Context, arg SoftDeleteBanParams) (B
 	return i, err
 }
 
-const softDeleteExpiredBans = `-- name: SoftDeleteExpiredBans :one
+const softDeleteExpiredBans = `-- name: SoftDeleteExpiredBans :many
 UPDATE bans
 SET
-    is_deleted = TRUE,
-    updated_at = CURRENT_TIMESTAMP
+    is_deleted = TRUE
 WHERE
     expires_at IS NOT NULL
     AND expires_at < CURRENT_TIMESTAMP
@@ -280,18 +285,31 @@ RETURNING
     id, server_id, user_id, moderator_id, reason, expires_at, is_deleted, created_at
 `
 
-func (q *Queries) SoftDeleteExpiredBans(ctx context.Context) (Ban, error) {
-	row := q.db.QueryRow(ctx, softDeleteExpiredBans)
-	var i Ban
-	err := row.Scan(
-		&i.ID,
-		&i.ServerID,
-		&i.UserID,
-		&i.ModeratorID,
-		&i.Reason,
-		&i.ExpiresAt,
-		&i.IsDeleted,
-		&i.CreatedAt,
-	)
-	return i, err
+func (q *Queries) SoftDeleteExpiredBans(ctx context.Context) ([]Ban, error) {
+	rows, err := q.db.Query(ctx, softDeleteExpiredBans)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Ban
+	for rows.Next() {
+		var i Ban
+		if err := rows.Scan(
+			&i.ID,
+			&i.ServerID,
+			&i.UserID,
+			&i.ModeratorID,
+			&i.Reason,
+			&i.ExpiresAt,
+			&i.IsDeleted,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
@@ -11,6 +11,44 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const archiveChannel = `-- name: ArchiveChannel :one
+UPDATE channels
+SET
+    is_archived = TRUE,
+    archived_at = CURRENT_TIMESTAMP,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    id = $1
+    AND is_deleted = FALSE
+RETURNING
+    id, server_id, category_id, name, type, position, topic, is_nsfw, slowmode_delay, user_limit, bitrate, is_private, is_deleted, created_at, updated_at, is_archived, archived_at
+`
+
+func (q *Queries) ArchiveChannel(ctx context.Context, id int32) (Channel, error) {
+	row := q.db.QueryRow(ctx, archiveChannel, id)
+	var i Channel
+	err := row.Scan(
+		&i.ID,
+		&i.ServerID,
+		&i.CategoryID,
+		&i.Name,
+		&i.Type,
+		&i.Position,
+		&i.Topic,
+		&i.IsNsfw,
+		&i.SlowmodeDelay,
+		&i.UserLimit,
+		&i.Bitrate,
+		&i.IsPrivate,
+		&i.IsDeleted,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.IsArchived,
+		&i.ArchivedAt,
+	)
+	return i, err
+}
+
 const createChannel = `-- name: CreateChannel :one
 INSERT INTO
     channels (
@@ -34,7 +72,7 @@ VALUES (
         $8
     )
 RETURNING
-    id, server_id, category_id, name, type, position, topic, is_nsfw, slowmode_delay, user_limit, bitrate, is_private, is_deleted, created_at, updated_at
+    id, server_id, category_id, name, type, position, topic, is_nsfw, slowmode_delay, user_limit, bitrate, is_private, is_deleted, created_at, updated_at, is_archived, archived_at
 `
 
 type CreateChannelParams struct {
@@ -76,12 +114,14 @@ func (q *Queries) CreateChannel(ctx context.Context, arg CreateChannelParams) (C
 		&i.IsDeleted,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.IsArchived,
+		&i.ArchivedAt,
 	)
 	return i, err
 }
 
 const getChannelByID = `-- name: GetChannelByID :one
-SELECT id, server_id, category_id, name, type, position, topic, is_nsfw, slowmode_delay, user_limit, bitrate, is_private, is_deleted, created_at, updated_at FROM channels WHERE id = $1 AND is_deleted = FALSE LIMIT 1
+SELECT id, server_id, category_id, name, type, position, topic, is_nsfw, slowmode_delay, user_limit, bitrate, is_private, is_deleted, created_at, updated_at, is_archived, archived_at FROM channels WHERE id = $1 AND is_deleted = FALSE LIMIT 1
 `
 
 func (q *Queries) GetChannelByID(ctx context.Context, id int32) (Channel, error) {
@@ -103,12 +143,14 @@ func (q *Queries) GetChannelByID(ctx context.Context, id int32) (Channel, error)
 		&i.IsDeleted,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.IsArchived,
+		&i.ArchivedAt,
 	)
 	return i, err
 }
 
 const getChannelsByCategory = `-- name: GetChannelsByCategory :many
-SELECT id, server_id, category_id, name, type, position, topic, is_nsfw, slowmode_delay, user_limit, bitrate, is_private, is_deleted, created_at, updated_at
+SELECT id, server_id, category_id, name, type, position, topic, is_nsfw, slowmode_delay, user_limit, bitrate, is_private, is_deleted, created_at, updated_at, is_archived, archived_at
 FROM channels
 WHERE
     category_id = $1
@@ -141,6 +183,8 @@ func (q *Queries) GetChannelsByCategory(ctx context.Context, categoryID pgtype.I
 			&i.IsDeleted,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.IsArchived,
+			&i.ArchivedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -153,7 +197,7 @@ func (q *Queries) GetChannelsByCategory(ctx context.Context, categoryID pgtype.I
 }
 
 const getChannelsByType = `-- name: GetChannelsByType :many
-SELECT id, server_id, category_id, name, type, position, topic, is_nsfw, slowmode_delay, user_limit, bitrate, is_private, is_deleted, created_at, updated_at
+SELECT id, server_id, category_id, name, type, position, topic, is_nsfw, slowmode_delay, user_limit, bitrate, is_private, is_deleted, created_at, updated_at, is_archived, archived_at
 FROM channels
 WHERE
     server_id = $1
@@ -192,6 +236,8 @@ func (q *Queries) GetChannelsByType(ctx context.Context, arg GetChannelsByTypePa
 			&i.IsDeleted,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.IsArchived,
+			&i.ArchivedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -204,7 +250,7 @@ func (q *Queries) GetChannelsByType(ctx context.Context, arg GetChannelsByTypePa
 }
 
 const getServerChannels = `-- name: GetServerChannels :many
-SELECT id, server_id, category_id, name, type, position, topic, is_nsfw, slowmode_delay, user_limit, bitrate, is_private, is_deleted, created_at, updated_at
+SELECT id, server_id, category_id, name, type, position, topic, is_nsfw, slowmode_delay, user_limit, bitrate, is_private, is_deleted, created_at, updated_at, is_archived, archived_at
 FROM channels
 WHERE
     server_id = $1
@@ -237,6 +283,8 @@ func (q *Queries) GetServerChannels(ctx context.Context, serverID int32) ([]Chan
 			&i.IsDeleted,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.IsArchived,
+			&i.ArchivedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -249,7 +297,7 @@ func (q *Queries) GetServerChannels(ctx context.Context, serverID int32) ([]Chan
 }
 
 const hardDeleteChannel = `-- name: HardDeleteChannel :one
-DELETE FROM channels WHERE id = $1 RETURNING id, server_id, category_id, name, type, position, topic, is_nsfw, slowmode_delay, user_limit, bitrate, is_private, is_deleted, created_at, updated_at
+DELETE FROM channels WHERE id = $1 RETURNING id, server_id, category_id, name, type, position, topic, is_nsfw, slowmode_delay, user_limit, bitrate, is_private, is_deleted, created_at, updated_at, is_archived, archived_at
 `
 
 func (q *Queries) HardDeleteChannel(ctx context.Context, id int32) (Channel, error) {
@@ -271,6 +319,8 @@ func (q *Queries) HardDeleteChannel(ctx context.Context, id int32) (Channel, err
 		&i.IsDeleted,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.IsArchived,
+		&i.ArchivedAt,
 	)
 	return i, err
 }
@@ -283,7 +333,7 @@ SET
 WHERE
     id = $1
 RETURNING
-    id, server_id, category_id, name, type, position, topic, is_nsfw, slowmode_delay, user_limit, bitrate, is_private, is_deleted, created_at, updated_at
+    id, server_id, category_id, name, type, position, topic, is_nsfw, slowmode_delay, user_limit, bitrate, is_private, is_deleted, created_at, updated_at, is_archived, archived_at
 `
 
 func (q *Queries) RestoreChannel(ctx context.Context, id int32) (Channel, error) {
@@ -305,6 +355,8 @@ func (q *Queries) RestoreChannel(ctx context.Context, id int32) (Channel, error)
 		&i.IsDeleted,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.IsArchived,
+		&i.ArchivedAt,
 	)
 	return i, err
 }
@@ -317,7 +369,7 @@ SET
 WHERE
     id = $1
 RETURNING
-    id, server_id, category_id, name, type, position, topic, is_nsfw, slowmode_delay, user_limit, bitrate, is_private, is_deleted, created_at, updated_at
+    id, server_id, category_id, name, type, position, topic, is_nsfw, slowmode_delay, user_limit, bitrate, is_private, is_deleted, created_at, updated_at, is_archived, archived_at
 `
 
 func (q *Queries) SoftDeleteChannel(ctx context.Context, id int32) (Channel, error) {
@@ -339,6 +391,8 @@ func (q *Queries) SoftDeleteChannel(ctx context.Context, id int32) (Channel, err
 		&i.IsDeleted,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.IsArchived,
+		&i.ArchivedAt,
 	)
 	return i, err
 }
@@ -365,7 +419,7 @@ WHERE
     id = $6
     AND is_deleted = FALSE
 RETURNING
-    id, server_id, category_id, name, type, position, topic, is_nsfw, slowmode_delay, user_limit, bitrate, is_private, is_deleted, created_at, updated_at
+    id, server_id, category_id, name, type, position, topic, is_nsfw, slowmode_delay, user_limit, bitrate, is_private, is_deleted, created_at, updated_at, is_archived, archived_at
 `
 
 type UpdateChannelParams struct {
@@ -403,6 +457,8 @@ func (q *Queries) UpdateChannel(ctx context.Context, arg UpdateChannelParams) (C
 		&i.IsDeleted,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.IsArchived,
+		&i.ArchivedAt,
 	)
 	return i, err
 }
@@ -416,7 +472,7 @@ WHERE
     id = $1
     AND is_deleted = FALSE
 RETURNING
-    id, server_id, category_id, name, type, position, topic, is_nsfw, slowmode_delay, user_limit, bitrate, is_private, is_deleted, created_at, updated_at
+    id, server_id, category_id, name, type, position, topic, is_nsfw, slowmode_delay, user_limit, bitrate, is_private, is_deleted, created_at, updated_at, is_archived, archived_at
 `
 
 type UpdateChannelPositionParams struct {
@@ -443,6 +499,8 @@ func (q *Queries) UpdateChannelPosition(ctx context.Context, arg UpdateChannelPo
 		&i.IsDeleted,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.IsArchived,
+		&i.ArchivedAt,
 	)
 	return i, err
 }
@@ -51,17 +51,58 @@ func (q *Queries) AcceptFriendRequest(ctx context.Context, arg AcceptFriendReque
 	return i, err
 }
 
+const areFriends = `-- name: AreFriends :one
+SELECT EXISTS (
+        SELECT 1
+        FROM friends
+        WHERE (
+                (
+                    user_id = $1
+                    AND friend_id = $2
+                )
+                OR (
+                    user_id = $2
+                    AND friend_id = $1
+                )
+            )
+            AND is_accepted = TRUE
+            AND is_blocked = FALSE
+            AND is_deleted = FALSE
+    ) AS are_friends
+`
+
+type AreFriendsParams struct {
+	UserA int32 `json:"user_a"`
+	UserB int32 `json:"user_b"`
+}
+
+func (q *Queries) AreFriends(ctx context.Context, arg AreFriendsParams) (bool, error) {
+	row := q.db.QueryRow(ctx, areFriends, arg.UserA, arg.UserB)
+	var are_friends bool
+	err := row.Scan(&are_friends)
+	return are_friends, err
+}
+
 const blockUser = `-- name: BlockUser :one
-UPDATE friends
+INSERT INTO
+    friends (
+        user_id,
+        friend_id,
+        is_pending,
+        is_accepted,
+        is_blocked,
+        is_muted
+    )
+VALUES ($1, $2, FALSE, FALSE, TRUE, TRUE)
+ON CONFLICT (user_id, friend_id) DO
+UPDATE
 SET
+    is_pending = FALSE,
     is_blocked = TRUE,
     is_accepted = FALSE,
     is_muted = TRUE,
+    is_deleted = FALSE,
     updated_at = CURRENT_TIMESTAMP
-WHERE
-    user_id = $1
-    AND friend_id = $2
-    AND is_deleted = FALSE
 RETURNING
     id, user_id, friend_id, alias_name, is_pending, is_accepted, is_blocked, is_favorite, is_muted, is_deleted, created_at, updated_at
 `
@@ -71,6 +112,7 @@ type BlockUserParams struct {
 	FriendID int32 `json:"friend_id"`
 }
 
+// Records the block even when the users never had a relationship
 func (q *Queries) BlockUser(ctx context.Context, arg BlockUserParams) (Friend, error) {
 	row := q.db.QueryRow(ctx, blockUser, arg.UserID, arg.FriendID)
 	var i Friend
@@ -574,6 +616,38 @@ func (q *Queries) HardDeleteFriendship(ctx context.Context, arg HardDeleteFriend
 	return i, err
 }
 
+const isBlockedBetween = `-- name: IsBlockedBetween :one
+SELECT EXISTS (
+        SELECT 1
+        FROM friends
+        WHERE (
+                (
+                    user_id = $1
+                    AND friend_id = $2
+                )
+                OR (
+                    user_id = $2
+                    AND friend_id = $1
+                )
+            )
+            AND is_blocked = TRUE
+            AND is_deleted = FALSE
+    ) AS is_blocked
+`
+
+type IsBlockedBetweenParams struct {
+	UserA int32 `json:"user_a"`
+	UserB int32 `json:"user_b"`
+}
+
+// Whether either user blocked the other
+func (q *Queries) IsBlockedBetween(ctx context.Context, arg IsBlockedBetweenParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isBlockedBetween, arg.UserA, arg.UserB)
+	var is_blocked bool
+	err := row.Scan(&is_blocked)
+	return is_blocked, err
+}
+
 const rejectFriendRequest = `-- name: RejectFriendRequest :one
 UPDATE friends
 SET
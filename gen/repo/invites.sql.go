@@ -223,8 +223,7 @@ func (q *Queries) HardDeleteInvitesByServer(ctx context.Context, serverID int32)
 const incrementInviteUses = `-- name: IncrementInviteUses :one
 UPDATE invites
 SET
-    uses = uses + 1,
-    updated_at = CURRENT_TIMESTAMP
+    uses = uses + 1
 WHERE
     code = $1
     AND is_deleted = FALSE
@@ -252,19 +251,29 @@ func (q *Queries) IncrementInviteUses(ctx context.Context, code string) (Invite,
 	return i, err
 }
 
-const restoreInvite = `-- name: RestoreInvite :one
+const redeemInvite = `-- name: RedeemInvite :one
 UPDATE invites
 SET
-    is_deleted = FALSE,
-    updated_at = CURRENT_TIMESTAMP
+    uses = COALESCE(uses, 0) + 1
 WHERE
     code = $1
+    AND is_deleted = FALSE
+    AND (
+        expires_at IS NULL
+        OR expires_at > CURRENT_TIMESTAMP
+    )
+    AND (
+        COALESCE(max_uses, 0) = 0
+        OR COALESCE(uses, 0) < max_uses
+    )
 RETURNING
     id, code, server_id, channel_id, inviter_id, max_uses, uses, max_age, temporary, is_deleted, created_at, expires_at
 `
 
-func (q *Queries) RestoreInvite(ctx context.Context, code string) (Invite, error) {
-	row := q.db.QueryRow(ctx, restoreInvite, code)
+// Counts a use only while the invite is live and has uses left. The row lock
+// taken by the update serialises concurrent redemptions, so max_uses holds.
+func (q *Queries) RedeemInvite(ctx context.Context, code string) (Invite, error) {
+	row := q.db.QueryRow(ctx, redeemInvite, code)
 	var i Invite
 	err := row.Scan(
 		&i.ID,
@@ -283,21 +292,18 @@ func (q *Queries) RestoreInvite(ctx context.Context, code string) (Invite, error
 	return i, err
 }
 
-const softDeleteExpiredInvites = `-- name: SoftDeleteExpiredInvites :one
+const restoreInvite = `-- name: RestoreInvite :one
 UPDATE invites
 SET
-    is_deleted = TRUE,
-    updated_at = CURRENT_TIMESTAMP
+    is_deleted = FALSE
 WHERE
-    expires_at IS NOT NULL
-    AND expires_at < CURRENT_TIMESTAMP
-    AND is_deleted = FALSE
+    code = $1
 RETURNING
     id, code, server_id, channel_id, inviter_id, max_uses, uses, max_age, temporary, is_deleted, created_at, expires_at
 `
 
-func (q *Queries) SoftDeleteExpiredInvites(ctx context.Context) (Invite, error) {
-	row := q.db.QueryRow(ctx, softDeleteExpiredInvites)
+func (q *Queries) RestoreInvite(ctx context.Context, code string) (Invite, error) {
+	row := q.db.QueryRow(ctx, restoreInvite, code)
 	var i Invite
 	err := row.Scan(
 		&i.ID,
@@ -316,11 +322,37 @@ func (q *Queries) SoftDeleteExpiredInvites(ctx context.Context) (Invite, error)
 	return i, err
 }
 
+const softDeleteExpiredInvites = `-- name: SoftDeleteExpiredInvites :execrows
+UPDATE invites
+SET
+    is_deleted = TRUE
+WHERE
+    is_deleted = FALSE
+    AND (
+        (
+            expires_at IS NOT NULL
+            AND expires_at < CURRENT_TIMESTAMP
+        )
+        OR (
+            COALESCE(max_uses, 0) > 0
+            AND COALESCE(uses, 0) >= max_uses
+        )
+    )
+`
+
+// Expired and used up invites can never be redeemed again
+func (q *Queries) SoftDeleteExpiredInvites(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, softDeleteExpiredInvites)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const softDeleteInvite = `-- name: SoftDeleteInvite :one
 UPDATE invites
 SET
-    is_deleted = TRUE,
-    updated_at = CURRENT_TIMESTAMP
+    is_deleted = TRUE
 WHERE
     code = $1
 RETURNING
@@ -350,8 +382,7 @@ func (q *Queries) SoftDeleteInvite(ctx context.Context, code string) (Invite, er
 const softDeleteInvitesByServer = `-- name: SoftDeleteInvitesByServer :one
 UPDATE invites
 SET
-    is_deleted = TRUE,
-    updated_at = CURRENT_TIMESTAMP
+    is_deleted = TRUE
 WHERE
     server_id = $1
     AND is_deleted = FALSE
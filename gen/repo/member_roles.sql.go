@@ -80,7 +80,7 @@ func (q *Queries) GetMemberRoles(ctx context.Context, memberID int32) ([]Role, e
 }
 
 const getRoleMembers = `-- name: GetRoleMembers :many
-SELECT sm.id, sm.server_id, sm.user_id, sm.nickname, sm.joined_at, sm.is_muted, sm.is_deafened, sm.updated_at
+SELECT sm.id, sm.server_id, sm.user_id, sm.nickname, sm.joined_at, sm.is_muted, sm.is_deafened, sm.updated_at, sm.invite_id, sm.temporary_until, sm.communication_disabled_until
 FROM
     server_members sm
     INNER JOIN member_roles mr ON sm.id = mr.member_id
@@ -106,6 +106,9 @@ func (q *Queries) GetRoleMembers(ctx context.Context, roleID int32) ([]ServerMem
 			&i.IsMuted,
 			&i.IsDeafened,
 			&i.UpdatedAt,
+			&i.InviteID,
+			&i.TemporaryUntil,
+			&i.CommunicationDisabledUntil,
 		); err != nil {
 			return nil, err
 		}
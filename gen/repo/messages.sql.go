@@ -12,7 +12,7 @@ import (
 )
 
 const bulkHardDeleteMessages = `-- name: BulkHardDeleteMessages :one
-DELETE FROM messages WHERE id = ANY ($1::int[]) RETURNING id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at
+DELETE FROM messages WHERE id = ANY ($1::int[]) RETURNING id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at, dm_channel_id
 `
 
 func (q *Queries) BulkHardDeleteMessages(ctx context.Context, dollar_1 []int32) (Message, error) {
@@ -34,6 +34,7 @@ func (q *Queries) BulkHardDeleteMessages(ctx context.Context, dollar_1 []int32)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.EditedAt,
+		&i.DmChannelID,
 	)
 	return i, err
 }
@@ -46,7 +47,7 @@ SET
 WHERE
     id = ANY ($1::int[])
 RETURNING
-    id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at
+    id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at, dm_channel_id
 `
 
 func (q *Queries) BulkSoftDeleteMessages(ctx context.Context, dollar_1 []int32) (Message, error) {
@@ -68,10 +69,81 @@ func (q *Queries) BulkSoftDeleteMessages(ctx context.Context, dollar_1 []int32)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.EditedAt,
+		&i.DmChannelID,
 	)
 	return i, err
 }
 
+const countSearchMessages = `-- name: CountSearchMessages :one
+SELECT COUNT(*)::int AS total
+FROM messages m
+WHERE
+    m.channel_id = ANY ($2::int[])
+    AND m.is_deleted = FALSE
+    AND (
+        $1::text = ''
+        OR to_tsvector('english', m.content) @@ websearch_to_tsquery('english', $1::text)
+    )
+    AND (
+        $3::int IS NULL
+        OR m.sender_id = $3
+    )
+    AND (
+        $4::bool IS NULL
+        OR EXISTS (
+            SELECT 1
+            FROM message_attachments ma
+            WHERE
+                ma.message_id = m.id
+                AND ma.is_deleted = FALSE
+        ) = $4
+    )
+    AND (
+        $5::int IS NULL
+        OR m.content ~ ('<@!?' || $5::int || '>')
+    )
+    AND (
+        $6::timestamp IS NULL
+        OR m.created_at < $6
+    )
+    AND (
+        $7::timestamp IS NULL
+        OR m.created_at > $7
+    )
+    AND (
+        $8::bool IS NULL
+        OR m.is_pinned = $8
+    )
+`
+
+type CountSearchMessagesParams struct {
+	Query          string           `json:"query"`
+	ChannelIds     []int32          `json:"channel_ids"`
+	SenderID       pgtype.Int4      `json:"sender_id"`
+	HasAttachment  pgtype.Bool      `json:"has_attachment"`
+	MentionsUserID pgtype.Int4      `json:"mentions_user_id"`
+	Before         pgtype.Timestamp `json:"before"`
+	After          pgtype.Timestamp `json:"after"`
+	Pinned         pgtype.Bool      `json:"pinned"`
+}
+
+// Counts every match of SearchMessages, regardless of the page
+func (q *Queries) CountSearchMessages(ctx context.Context, arg CountSearchMessagesParams) (int32, error) {
+	row := q.db.QueryRow(ctx, countSearchMessages,
+		arg.Query,
+		arg.ChannelIds,
+		arg.SenderID,
+		arg.HasAttachment,
+		arg.MentionsUserID,
+		arg.Before,
+		arg.After,
+		arg.Pinned,
+	)
+	var total int32
+	err := row.Scan(&total)
+	return total, err
+}
+
 const createChatMessage = `-- name: CreateChatMessage :one
 INSERT INTO
     messages (
@@ -85,7 +157,7 @@ INSERT INTO
     )
 VALUES ($1, $2, $3, $4, $5, $6, FALSE)
 RETURNING
-    id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at
+    id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at, dm_channel_id
 `
 
 type CreateChatMessageParams struct {
@@ -123,6 +195,7 @@ func (q *Queries) CreateChatMessage(ctx context.Context, arg CreateChatMessagePa
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.EditedAt,
+		&i.DmChannelID,
 	)
 	return i, err
 }
@@ -135,11 +208,12 @@ INSERT INTO
         content,
         message_type,
         reply_to_message_id,
-        mention_everyone
+        mention_everyone,
+        ischannel
     )
-VALUES ($1, $2, $3, $4, $5, $6)
+VALUES ($1, $2, $3, $4, $5, $6, TRUE)
 RETURNING
-    id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at
+    id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at, dm_channel_id
 `
 
 type CreateMessageParams struct {
@@ -177,12 +251,13 @@ func (q *Queries) CreateMessage(ctx context.Context, arg CreateMessageParams) (M
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.EditedAt,
+		&i.DmChannelID,
 	)
 	return i, err
 }
 
 const getChannelMessages = `-- name: GetChannelMessages :many
-SELECT id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at
+SELECT id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at, dm_channel_id
 FROM messages
 WHERE
     channel_id = $1
@@ -224,6 +299,7 @@ func (q *Queries) GetChannelMessages(ctx context.Context, arg GetChannelMessages
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.EditedAt,
+			&i.DmChannelID,
 		); err != nil {
 			return nil, err
 		}
@@ -236,7 +312,7 @@ func (q *Queries) GetChannelMessages(ctx context.Context, arg GetChannelMessages
 }
 
 const getChatMessageByID = `-- name: GetChatMessageByID :one
-SELECT id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at FROM messages WHERE id = $1 AND is_deleted = FALSE LIMIT 1
+SELECT id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at, dm_channel_id FROM messages WHERE id = $1 AND is_deleted = FALSE LIMIT 1
 `
 
 func (q *Queries) GetChatMessageByID(ctx context.Context, id int32) (Message, error) {
@@ -258,12 +334,13 @@ func (q *Queries) GetChatMessageByID(ctx context.Context, id int32) (Message, er
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.EditedAt,
+		&i.DmChannelID,
 	)
 	return i, err
 }
 
 const getChatMessages = `-- name: GetChatMessages :many
-SELECT id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at
+SELECT id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at, dm_channel_id
 FROM messages
 WHERE (
         (
@@ -319,6 +396,7 @@ func (q *Queries) GetChatMessages(ctx context.Context, arg GetChatMessagesParams
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.EditedAt,
+			&i.DmChannelID,
 		); err != nil {
 			return nil, err
 		}
@@ -331,7 +409,7 @@ func (q *Queries) GetChatMessages(ctx context.Context, arg GetChatMessagesParams
 }
 
 const getChatMessagesAfter = `-- name: GetChatMessagesAfter :many
-SELECT id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at
+SELECT id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at, dm_channel_id
 FROM messages
 WHERE (
         (
@@ -386,6 +464,7 @@ func (q *Queries) GetChatMessagesAfter(ctx context.Context, arg GetChatMessagesA
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.EditedAt,
+			&i.DmChannelID,
 		); err != nil {
 			return nil, err
 		}
@@ -398,7 +477,7 @@ func (q *Queries) GetChatMessagesAfter(ctx context.Context, arg GetChatMessagesA
 }
 
 const getChatMessagesBefore = `-- name: GetChatMessagesBefore :many
-SELECT id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at
+SELECT id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at, dm_channel_id
 FROM messages
 WHERE (
         (
@@ -453,6 +532,7 @@ func (q *Queries) GetChatMessagesBefore(ctx context.Context, arg GetChatMessages
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.EditedAt,
+			&i.DmChannelID,
 		); err != nil {
 			return nil, err
 		}
@@ -465,7 +545,7 @@ func (q *Queries) GetChatMessagesBefore(ctx context.Context, arg GetChatMessages
 }
 
 const getMessageByID = `-- name: GetMessageByID :one
-SELECT id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at FROM messages WHERE id = $1 AND is_deleted = FALSE LIMIT 1
+SELECT id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at, dm_channel_id FROM messages WHERE id = $1 AND is_deleted = FALSE LIMIT 1
 `
 
 func (q *Queries) GetMessageByID(ctx context.Context, id int32) (Message, error) {
@@ -487,18 +567,19 @@ func (q *Queries) GetMessageByID(ctx context.Context, id int32) (Message, error)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.EditedAt,
+		&i.DmChannelID,
 	)
 	return i, err
 }
 
 const getMessagesAfter = `-- name: GetMessagesAfter :many
-SELECT id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at
+SELECT id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at, dm_channel_id
 FROM messages
 WHERE
     channel_id = $1
     AND id > $2
     AND is_deleted = FALSE
-ORDER BY created_at ASC
+ORDER BY id ASC
 LIMIT $3
 `
 
@@ -533,6 +614,7 @@ func (q *Queries) GetMessagesAfter(ctx context.Context, arg GetMessagesAfterPara
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.EditedAt,
+			&i.DmChannelID,
 		); err != nil {
 			return nil, err
 		}
@@ -545,13 +627,13 @@ func (q *Queries) GetMessagesAfter(ctx context.Context, arg GetMessagesAfterPara
 }
 
 const getMessagesBefore = `-- name: GetMessagesBefore :many
-SELECT id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at
+SELECT id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at, dm_channel_id
 FROM messages
 WHERE
     channel_id = $1
     AND id < $2
     AND is_deleted = FALSE
-ORDER BY created_at DESC
+ORDER BY id DESC
 LIMIT $3
 `
 
@@ -586,6 +668,7 @@ func (q *Queries) GetMessagesBefore(ctx context.Context, arg GetMessagesBeforePa
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.EditedAt,
+			&i.DmChannelID,
 		); err != nil {
 			return nil, err
 		}
@@ -598,7 +681,7 @@ func (q *Queries) GetMessagesBefore(ctx context.Context, arg GetMessagesBeforePa
 }
 
 const getPinnedMessages = `-- name: GetPinnedMessages :many
-SELECT id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at
+SELECT id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at, dm_channel_id
 FROM messages
 WHERE
     channel_id = $1
@@ -632,6 +715,7 @@ func (q *Queries) GetPinnedMessages(ctx context.Context, channelID pgtype.Int4)
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.EditedAt,
+			&i.DmChannelID,
 		); err != nil {
 			return nil, err
 		}
@@ -643,8 +727,52 @@ func (q *Queries) GetPinnedMessages(ctx context.Context, channelID pgtype.Int4)
 	return items, nil
 }
 
+const getServerMessagesBySender = `-- name: GetServerMessagesBySender :many
+SELECT m.id, m.channel_id
+FROM messages m
+    INNER JOIN channels c ON c.id = m.channel_id
+WHERE
+    c.server_id = $1
+    AND m.sender_id = $2
+    AND m.created_at >= $3
+    AND m.ischannel = TRUE
+    AND m.is_deleted = FALSE
+`
+
+type GetServerMessagesBySenderParams struct {
+	ServerID  int32            `json:"server_id"`
+	SenderID  int32            `json:"sender_id"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+}
+
+type GetServerMessagesBySenderRow struct {
+	ID        int32       `json:"id"`
+	ChannelID pgtype.Int4 `json:"channel_id"`
+}
+
+// Messages a user sent in any channel of a server since the given time
+func (q *Queries) GetServerMessagesBySender(ctx context.Context, arg GetServerMessagesBySenderParams) ([]GetServerMessagesBySenderRow, error) {
+	rows, err := q.db.Query(ctx, getServerMessagesBySender, arg.ServerID, arg.SenderID, arg.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetServerMessagesBySenderRow
+	for rows.Next() {
+		var i GetServerMessagesBySenderRow
+		if err := rows.Scan(&i.ID, &i.ChannelID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getUserMessages = `-- name: GetUserMessages :many
-SELECT id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at
+SELECT id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at, dm_channel_id
 FROM messages
 WHERE
     sender_id = $1
@@ -686,6 +814,7 @@ func (q *Queries) GetUserMessages(ctx context.Context, arg GetUserMessagesParams
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.EditedAt,
+			&i.DmChannelID,
 		); err != nil {
 			return nil, err
 		}
@@ -698,7 +827,7 @@ func (q *Queries) GetUserMessages(ctx context.Context, arg GetUserMessagesParams
 }
 
 const hardDeleteChatMessage = `-- name: HardDeleteChatMessage :one
-DELETE FROM messages WHERE id = $1 RETURNING id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at
+DELETE FROM messages WHERE id = $1 RETURNING id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at, dm_channel_id
 `
 
 func (q *Queries) HardDeleteChatMessage(ctx context.Context, id int32) (Message, error) {
@@ -720,6 +849,7 @@ func (q *Queries) HardDeleteChatMessage(ctx context.Context, id int32) (Message,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.EditedAt,
+		&i.DmChannelID,
 	)
 	return i, err
 }
@@ -736,7 +866,7 @@ WHERE (
     )
     AND is_deleted = TRUE
 RETURNING
-    id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at
+    id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at, dm_channel_id
 `
 
 type HardDeleteChatMessagesParams struct {
@@ -763,12 +893,13 @@ func (q *Queries) HardDeleteChatMessages(ctx context.Context, arg HardDeleteChat
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.EditedAt,
+		&i.DmChannelID,
 	)
 	return i, err
 }
 
 const hardDeleteMessage = `-- name: HardDeleteMessage :one
-DELETE FROM messages WHERE id = $1 RETURNING id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at
+DELETE FROM messages WHERE id = $1 RETURNING id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at, dm_channel_id
 `
 
 func (q *Queries) HardDeleteMessage(ctx context.Context, id int32) (Message, error) {
@@ -790,10 +921,59 @@ func (q *Queries) HardDeleteMessage(ctx context.Context, id int32) (Message, err
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.EditedAt,
+		&i.DmChannelID,
 	)
 	return i, err
 }
 
+const hasMessagesFrom = `-- name: HasMessagesFrom :one
+SELECT EXISTS (
+        SELECT 1
+        FROM messages
+        WHERE
+            channel_id = $1
+            AND id >= $2
+            AND is_deleted = FALSE
+    ) AS has_messages
+`
+
+type HasMessagesFromParams struct {
+	ChannelID pgtype.Int4 `json:"channel_id"`
+	ID        int32       `json:"id"`
+}
+
+// Whether the channel has messages with an id of at least the given one
+func (q *Queries) HasMessagesFrom(ctx context.Context, arg HasMessagesFromParams) (bool, error) {
+	row := q.db.QueryRow(ctx, hasMessagesFrom, arg.ChannelID, arg.ID)
+	var has_messages bool
+	err := row.Scan(&has_messages)
+	return has_messages, err
+}
+
+const hasMessagesUntil = `-- name: HasMessagesUntil :one
+SELECT EXISTS (
+        SELECT 1
+        FROM messages
+        WHERE
+            channel_id = $1
+            AND id <= $2
+            AND is_deleted = FALSE
+    ) AS has_messages
+`
+
+type HasMessagesUntilParams struct {
+	ChannelID pgtype.Int4 `json:"channel_id"`
+	ID        int32       `json:"id"`
+}
+
+// Whether the channel has messages with an id of at most the given one
+func (q *Queries) HasMessagesUntil(ctx context.Context, arg HasMessagesUntilParams) (bool, error) {
+	row := q.db.QueryRow(ctx, hasMessagesUntil, arg.ChannelID, arg.ID)
+	var has_messages bool
+	err := row.Scan(&has_messages)
+	return has_messages, err
+}
+
 const pinMessage = `-- name: PinMessage :one
 UPDATE messages
 SET
@@ -803,7 +983,7 @@ WHERE
     id = $1
     AND is_deleted = FALSE
 RETURNING
-    id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at
+    id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at, dm_channel_id
 `
 
 func (q *Queries) PinMessage(ctx context.Context, id int32) (Message, error) {
@@ -825,6 +1005,7 @@ func (q *Queries) PinMessage(ctx context.Context, id int32) (Message, error) {
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.EditedAt,
+		&i.DmChannelID,
 	)
 	return i, err
 }
@@ -837,7 +1018,7 @@ SET
 WHERE
     id = $1
 RETURNING
-    id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at
+    id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at, dm_channel_id
 `
 
 func (q *Queries) RestoreMessage(ctx context.Context, id int32) (Message, error) {
@@ -859,12 +1040,13 @@ func (q *Queries) RestoreMessage(ctx context.Context, id int32) (Message, error)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.EditedAt,
+		&i.DmChannelID,
 	)
 	return i, err
 }
 
 const searchChatMessages = `-- name: SearchChatMessages :many
-SELECT id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at
+SELECT id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at, dm_channel_id
 FROM messages
 WHERE (
         (
@@ -923,6 +1105,7 @@ func (q *Queries) SearchChatMessages(ctx context.Context, arg SearchChatMessages
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.EditedAt,
+			&i.DmChannelID,
 		); err != nil {
 			return nil, err
 		}
@@ -935,55 +1118,122 @@ func (q *Queries) SearchChatMessages(ctx context.Context, arg SearchChatMessages
 }
 
 const searchMessages = `-- name: SearchMessages :many
-SELECT id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at
-FROM messages
+SELECT
+    m.id,
+    m.channel_id,
+    m.sender_id,
+    m.content,
+    m.is_pinned,
+    m.created_at,
+    ts_rank(
+        to_tsvector('english', m.content),
+        websearch_to_tsquery('english', $1::text)
+    )::real AS rank,
+    ts_headline(
+        'english',
+        m.content,
+        websearch_to_tsquery('english', $1::text),
+        'StartSel=**, StopSel=**, MaxWords=24, MinWords=8, MaxFragments=2'
+    )::text AS highlight
+FROM messages m
 WHERE
-    channel_id = $1
-    AND content ILIKE '%' || $2 || '%'
-    AND is_deleted = FALSE
-ORDER BY created_at DESC
-LIMIT $3
+    m.channel_id = ANY ($2::int[])
+    AND m.is_deleted = FALSE
+    AND (
+        $1::text = ''
+        OR to_tsvector('english', m.content) @@ websearch_to_tsquery('english', $1::text)
+    )
+    AND (
+        $3::int IS NULL
+        OR m.sender_id = $3
+    )
+    AND (
+        $4::bool IS NULL
+        OR EXISTS (
+            SELECT 1
+            FROM message_attachments ma
+            WHERE
+                ma.message_id = m.id
+                AND ma.is_deleted = FALSE
+        ) = $4
+    )
+    AND (
+        $5::int IS NULL
+        OR m.content ~ ('<@!?' || $5::int || '>')
+    )
+    AND (
+        $6::timestamp IS NULL
+        OR m.created_at < $6
+    )
+    AND (
+        $7::timestamp IS NULL
+        OR m.created_at > $7
+    )
+    AND (
+        $8::bool IS NULL
+        OR m.is_pinned = $8
+    )
+ORDER BY rank DESC, m.created_at DESC
+LIMIT $9::int
 OFFSET
-    $4
+    $10::int
 `
 
 type SearchMessagesParams struct {
-	ChannelID pgtype.Int4 `json:"channel_id"`
-	Column2   pgtype.Text `json:"column_2"`
-	Limit     int32       `json:"limit"`
-	Offset    int32       `json:"offset"`
+	Query          string           `json:"query"`
+	ChannelIds     []int32          `json:"channel_ids"`
+	SenderID       pgtype.Int4      `json:"sender_id"`
+	HasAttachment  pgtype.Bool      `json:"has_attachment"`
+	MentionsUserID pgtype.Int4      `json:"mentions_user_id"`
+	Before         pgtype.Timestamp `json:"before"`
+	After          pgtype.Timestamp `json:"after"`
+	Pinned         pgtype.Bool      `json:"pinned"`
+	ResultLimit    int32            `json:"result_limit"`
+	ResultOffset   int32            `json:"result_offset"`
 }
 
-func (q *Queries) SearchMessages(ctx context.Context, arg SearchMessagesParams) ([]Message, error) {
+type SearchMessagesRow struct {
+	ID        int32            `json:"id"`
+	ChannelID pgtype.Int4      `json:"channel_id"`
+	SenderID  int32            `json:"sender_id"`
+	Content   string           `json:"content"`
+	IsPinned  pgtype.Bool      `json:"is_pinned"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+	Rank      float32          `json:"rank"`
+	Highlight string           `json:"highlight"`
+}
+
+// Full-text search over a set of channels, best match first. An empty query
+// matches every message so that filters can be used on their own.
+func (q *Queries) SearchMessages(ctx context.Context, arg SearchMessagesParams) ([]SearchMessagesRow, error) {
 	rows, err := q.db.Query(ctx, searchMessages,
-		arg.ChannelID,
-		arg.Column2,
-		arg.Limit,
-		arg.Offset,
+		arg.Query,
+		arg.ChannelIds,
+		arg.SenderID,
+		arg.HasAttachment,
+		arg.MentionsUserID,
+		arg.Before,
+		arg.After,
+		arg.Pinned,
+		arg.ResultLimit,
+		arg.ResultOffset,
 	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Message
+	var items []SearchMessagesRow
 	for rows.Next() {
-		var i Message
+		var i SearchMessagesRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.ChannelID,
-			&i.ReceiverID,
-			&i.Ischannel,
 			&i.SenderID,
 			&i.Content,
-			&i.MessageType,
-			&i.ReplyToMessageID,
-			&i.IsEdited,
 			&i.IsPinned,
-			&i.MentionEveryone,
-			&i.IsDeleted,
 			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.EditedAt,
+			&i.Rank,
+			&i.Highlight,
 		); err != nil {
 			return nil, err
 		}
@@ -1003,7 +1253,7 @@ SET
 WHERE
     id = $1
 RETURNING
-    id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at
+    id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at, dm_channel_id
 `
 
 func (q *Queries) SoftDeleteChatMessage(ctx context.Context, id int32) (Message, error) {
@@ -1025,6 +1275,7 @@ func (q *Queries) SoftDeleteChatMessage(ctx context.Context, id int32) (Message,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.EditedAt,
+		&i.DmChannelID,
 	)
 	return i, err
 }
@@ -1037,7 +1288,7 @@ SET
 WHERE
     id = $1
 RETURNING
-    id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at
+    id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at, dm_channel_id
 `
 
 func (q *Queries) SoftDeleteMessage(ctx context.Context, id int32) (Message, error) {
@@ -1059,6 +1310,7 @@ func (q *Queries) SoftDeleteMessage(ctx context.Context, id int32) (Message, err
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.EditedAt,
+		&i.DmChannelID,
 	)
 	return i, err
 }
@@ -1072,7 +1324,7 @@ WHERE
     id = $1
     AND is_deleted = FALSE
 RETURNING
-    id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at
+    id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at, dm_channel_id
 `
 
 func (q *Queries) UnpinMessage(ctx context.Context, id int32) (Message, error) {
@@ -1094,6 +1346,7 @@ func (q *Queries) UnpinMessage(ctx context.Context, id int32) (Message, error) {
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.EditedAt,
+		&i.DmChannelID,
 	)
 	return i, err
 }
@@ -1109,7 +1362,7 @@ WHERE
     id = $1
     AND is_deleted = FALSE
 RETURNING
-    id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at
+    id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at, dm_channel_id
 `
 
 type UpdateChatMessageParams struct {
@@ -1136,6 +1389,7 @@ func (q *Queries) UpdateChatMessage(ctx context.Context, arg UpdateChatMessagePa
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.EditedAt,
+		&i.DmChannelID,
 	)
 	return i, err
 }
@@ -1151,7 +1405,7 @@ WHERE
     id = $1
     AND is_deleted = FALSE
 RETURNING
-    id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at
+    id, channel_id, receiver_id, ischannel, sender_id, content, message_type, reply_to_message_id, is_edited, is_pinned, mention_everyone, is_deleted, created_at, updated_at, edited_at, dm_channel_id
 `
 
 type UpdateMessageParams struct {
@@ -1178,6 +1432,7 @@ func (q *Queries) UpdateMessage(ctx context.Context, arg UpdateMessageParams) (M
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.EditedAt,
+		&i.DmChannelID,
 	)
 	return i, err
 }
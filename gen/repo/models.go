@@ -20,6 +20,14 @@ type AuditLog struct {
 	CreatedAt  pgtype.Timestamp `json:"created_at"`
 }
 
+type BackupCode struct {
+	ID        int32            `json:"id"`
+	UserID    int32            `json:"user_id"`
+	CodeHash  string           `json:"code_hash"`
+	UsedAt    pgtype.Timestamp `json:"used_at"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+}
+
 type Ban struct {
 	ID          int32            `json:"id"`
 	ServerID    int32            `json:"server_id"`
@@ -47,6 +55,8 @@ type Channel struct {
 	IsDeleted     pgtype.Bool      `json:"is_deleted"`
 	CreatedAt     pgtype.Timestamp `json:"created_at"`
 	UpdatedAt     pgtype.Timestamp `json:"updated_at"`
+	IsArchived    pgtype.Bool      `json:"is_archived"`
+	ArchivedAt    pgtype.Timestamp `json:"archived_at"`
 }
 
 type ChannelPermission struct {
@@ -60,6 +70,54 @@ type ChannelPermission struct {
 	UpdatedAt        pgtype.Timestamp `json:"updated_at"`
 }
 
+type DeletedEntity struct {
+	ID         int32            `json:"id"`
+	EntityType string           `json:"entity_type"`
+	EntityID   int32            `json:"entity_id"`
+	ScopeType  string           `json:"scope_type"`
+	ScopeID    int32            `json:"scope_id"`
+	DeletedAt  pgtype.Timestamp `json:"deleted_at"`
+}
+
+type DmChannel struct {
+	ID            int32            `json:"id"`
+	Name          pgtype.Text      `json:"name"`
+	Icon          pgtype.Text      `json:"icon"`
+	OwnerID       pgtype.Int4      `json:"owner_id"`
+	IsGroup       pgtype.Bool      `json:"is_group"`
+	LastMessageID pgtype.Int4      `json:"last_message_id"`
+	LastMessageAt pgtype.Timestamp `json:"last_message_at"`
+	CreatedAt     pgtype.Timestamp `json:"created_at"`
+}
+
+type DmMessageRequest struct {
+	ID          int32            `json:"id"`
+	SenderID    int32            `json:"sender_id"`
+	RecipientID int32            `json:"recipient_id"`
+	Status      string           `json:"status"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
+	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
+}
+
+type DmParticipant struct {
+	ID                int32            `json:"id"`
+	DmChannelID       int32            `json:"dm_channel_id"`
+	UserID            int32            `json:"user_id"`
+	LastReadMessageID pgtype.Int4      `json:"last_read_message_id"`
+	JoinedAt          pgtype.Timestamp `json:"joined_at"`
+}
+
+type EmailToken struct {
+	ID        int32            `json:"id"`
+	UserID    int32            `json:"user_id"`
+	Purpose   string           `json:"purpose"`
+	Email     string           `json:"email"`
+	TokenHash string           `json:"token_hash"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+	ExpiresAt pgtype.Timestamp `json:"expires_at"`
+	UsedAt    pgtype.Timestamp `json:"used_at"`
+}
+
 type Emoji struct {
 	ID            int32            `json:"id"`
 	ServerID      int32            `json:"server_id"`
@@ -128,6 +186,7 @@ type Message struct {
 	CreatedAt        pgtype.Timestamp `json:"created_at"`
 	UpdatedAt        pgtype.Timestamp `json:"updated_at"`
 	EditedAt         pgtype.Timestamp `json:"edited_at"`
+	DmChannelID      pgtype.Int4      `json:"dm_channel_id"`
 }
 
 type MessageAttachment struct {
@@ -160,6 +219,22 @@ type MessageReaction struct {
 	CreatedAt pgtype.Timestamp `json:"created_at"`
 }
 
+type ReadState struct {
+	UserID            int32            `json:"user_id"`
+	ChannelID         int32            `json:"channel_id"`
+	LastReadMessageID int32            `json:"last_read_message_id"`
+	UpdatedAt         pgtype.Timestamp `json:"updated_at"`
+}
+
+type RefreshToken struct {
+	ID        int32            `json:"id"`
+	SessionID int32            `json:"session_id"`
+	TokenHash string           `json:"token_hash"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+	ExpiresAt pgtype.Timestamp `json:"expires_at"`
+	UsedAt    pgtype.Timestamp `json:"used_at"`
+}
+
 type Role struct {
 	ID          int32            `json:"id"`
 	ServerID    int32            `json:"server_id"`
@@ -194,14 +269,29 @@ type Server struct {
 }
 
 type ServerMember struct {
+	ID                         int32            `json:"id"`
+	ServerID                   int32            `json:"server_id"`
+	UserID                     int32            `json:"user_id"`
+	Nickname                   pgtype.Text      `json:"nickname"`
+	JoinedAt                   pgtype.Timestamp `json:"joined_at"`
+	IsMuted                    pgtype.Bool      `json:"is_muted"`
+	IsDeafened                 pgtype.Bool      `json:"is_deafened"`
+	UpdatedAt                  pgtype.Timestamp `json:"updated_at"`
+	InviteID                   pgtype.Int4      `json:"invite_id"`
+	TemporaryUntil             pgtype.Timestamp `json:"temporary_until"`
+	CommunicationDisabledUntil pgtype.Timestamp `json:"communication_disabled_until"`
+}
+
+type Session struct {
 	ID         int32            `json:"id"`
-	ServerID   int32            `json:"server_id"`
 	UserID     int32            `json:"user_id"`
-	Nickname   pgtype.Text      `json:"nickname"`
-	JoinedAt   pgtype.Timestamp `json:"joined_at"`
-	IsMuted    pgtype.Bool      `json:"is_muted"`
-	IsDeafened pgtype.Bool      `json:"is_deafened"`
-	UpdatedAt  pgtype.Timestamp `json:"updated_at"`
+	DeviceName pgtype.Text      `json:"device_name"`
+	IpAddress  pgtype.Text      `json:"ip_address"`
+	UserAgent  pgtype.Text      `json:"user_agent"`
+	CreatedAt  pgtype.Timestamp `json:"created_at"`
+	LastUsedAt pgtype.Timestamp `json:"last_used_at"`
+	RevokedAt  pgtype.Timestamp `json:"revoked_at"`
+	VerifiedAt pgtype.Timestamp `json:"verified_at"`
 }
 
 type User struct {
@@ -237,6 +327,38 @@ type UserPresence struct {
 	UpdatedAt             pgtype.Timestamp `json:"updated_at"`
 }
 
+type UserServerSetting struct {
+	UserID            int32            `json:"user_id"`
+	ServerID          int32            `json:"server_id"`
+	Muted             bool             `json:"muted"`
+	NotificationLevel pgtype.Text      `json:"notification_level"`
+	AllowDms          bool             `json:"allow_dms"`
+	UpdatedAt         pgtype.Timestamp `json:"updated_at"`
+}
+
+type UserSetting struct {
+	UserID                   int32            `json:"user_id"`
+	Version                  int32            `json:"version"`
+	Theme                    string           `json:"theme"`
+	Locale                   string           `json:"locale"`
+	ShowCurrentActivity      bool             `json:"show_current_activity"`
+	DmPrivacy                string           `json:"dm_privacy"`
+	FriendRequestPrivacy     string           `json:"friend_request_privacy"`
+	ExplicitContentFilter    string           `json:"explicit_content_filter"`
+	EnableNotifications      bool             `json:"enable_notifications"`
+	DefaultNotificationLevel string           `json:"default_notification_level"`
+	CreatedAt                pgtype.Timestamp `json:"created_at"`
+	UpdatedAt                pgtype.Timestamp `json:"updated_at"`
+}
+
+type UserTotp struct {
+	UserID           int32            `json:"user_id"`
+	SecretCiphertext string           `json:"secret_ciphertext"`
+	ConfirmedAt      pgtype.Timestamp `json:"confirmed_at"`
+	LastUsedStep     int64            `json:"last_used_step"`
+	CreatedAt        pgtype.Timestamp `json:"created_at"`
+}
+
 type VoiceState struct {
 	ID         int32            `json:"id"`
 	UserID     int32            `json:"user_id"`
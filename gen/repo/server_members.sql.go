@@ -13,20 +13,34 @@ import (
 
 const addServerMember = `-- name: AddServerMember :one
 INSERT INTO
-    server_members (server_id, user_id, nickname)
-VALUES ($1, $2, $3)
+    server_members (
+        server_id,
+        user_id,
+        nickname,
+        invite_id,
+        temporary_until
+    )
+VALUES ($1, $2, $3, $4, $5)
 RETURNING
-    id, server_id, user_id, nickname, joined_at, is_muted, is_deafened, updated_at
+    id, server_id, user_id, nickname, joined_at, is_muted, is_deafened, updated_at, invite_id, temporary_until, communication_disabled_until
 `
 
 type AddServerMemberParams struct {
-	ServerID int32       `json:"server_id"`
-	UserID   int32       `json:"user_id"`
-	Nickname pgtype.Text `json:"nickname"`
+	ServerID       int32            `json:"server_id"`
+	UserID         int32            `json:"user_id"`
+	Nickname       pgtype.Text      `json:"nickname"`
+	InviteID       pgtype.Int4      `json:"invite_id"`
+	TemporaryUntil pgtype.Timestamp `json:"temporary_until"`
 }
 
 func (q *Queries) AddServerMember(ctx context.Context, arg AddServerMemberParams) (ServerMember, error) {
-	row := q.db.QueryRow(ctx, addServerMember, arg.ServerID, arg.UserID, arg.Nickname)
+	row := q.db.QueryRow(ctx, addServerMember,
+		arg.ServerID,
+		arg.UserID,
+		arg.Nickname,
+		arg.InviteID,
+		arg.TemporaryUntil,
+	)
 	var i ServerMember
 	err := row.Scan(
 		&i.ID,
@@ -37,10 +51,57 @@ func (q *Queries) AddServerMember(ctx context.Context, arg AddServerMemberParams
 		&i.IsMuted,
 		&i.IsDeafened,
 		&i.UpdatedAt,
+		&i.InviteID,
+		&i.TemporaryUntil,
+		&i.CommunicationDisabledUntil,
 	)
 	return i, err
 }
 
+const clearExpiredMemberTimeouts = `-- name: ClearExpiredMemberTimeouts :many
+UPDATE server_members
+SET
+    communication_disabled_until = NULL,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    communication_disabled_until IS NOT NULL
+    AND communication_disabled_until <= CURRENT_TIMESTAMP
+RETURNING
+    id, server_id, user_id, nickname, joined_at, is_muted, is_deafened, updated_at, invite_id, temporary_until, communication_disabled_until
+`
+
+func (q *Queries) ClearExpiredMemberTimeouts(ctx context.Context) ([]ServerMember, error) {
+	rows, err := q.db.Query(ctx, clearExpiredMemberTimeouts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ServerMember
+	for rows.Next() {
+		var i ServerMember
+		if err := rows.Scan(
+			&i.ID,
+			&i.ServerID,
+			&i.UserID,
+			&i.Nickname,
+			&i.JoinedAt,
+			&i.IsMuted,
+			&i.IsDeafened,
+			&i.UpdatedAt,
+			&i.InviteID,
+			&i.TemporaryUntil,
+			&i.CommunicationDisabledUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const countServerMembers = `-- name: CountServerMembers :one
 SELECT COUNT(*) FROM server_members WHERE server_id = $1
 `
@@ -52,8 +113,76 @@ func (q *Queries) CountServerMembers(ctx context.Context, serverID int32) (int64
 	return count, err
 }
 
+const getInviteJoins = `-- name: GetInviteJoins :many
+SELECT
+    sm.user_id,
+    sm.joined_at,
+    sm.temporary_until,
+    i.code,
+    i.inviter_id
+FROM server_members sm
+    INNER JOIN invites i ON i.id = sm.invite_id
+WHERE
+    sm.server_id = $1
+    AND (
+        $2::text IS NULL
+        OR i.code = $2
+    )
+ORDER BY sm.joined_at DESC
+LIMIT $3
+OFFSET
+    $4
+`
+
+type GetInviteJoinsParams struct {
+	ServerID     int32       `json:"server_id"`
+	Code         pgtype.Text `json:"code"`
+	ResultLimit  int32       `json:"result_limit"`
+	ResultOffset int32       `json:"result_offset"`
+}
+
+type GetInviteJoinsRow struct {
+	UserID         int32            `json:"user_id"`
+	JoinedAt       pgtype.Timestamp `json:"joined_at"`
+	TemporaryUntil pgtype.Timestamp `json:"temporary_until"`
+	Code           string           `json:"code"`
+	InviterID      int32            `json:"inviter_id"`
+}
+
+// Members who joined through an invite, including invites deleted since
+func (q *Queries) GetInviteJoins(ctx context.Context, arg GetInviteJoinsParams) ([]GetInviteJoinsRow, error) {
+	rows, err := q.db.Query(ctx, getInviteJoins,
+		arg.ServerID,
+		arg.Code,
+		arg.ResultLimit,
+		arg.ResultOffset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetInviteJoinsRow
+	for rows.Next() {
+		var i GetInviteJoinsRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.JoinedAt,
+			&i.TemporaryUntil,
+			&i.Code,
+			&i.InviterID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getServerMember = `-- name: GetServerMember :one
-SELECT id, server_id, user_id, nickname, joined_at, is_muted, is_deafened, updated_at
+SELECT id, server_id, user_id, nickname, joined_at, is_muted, is_deafened, updated_at, invite_id, temporary_until, communication_disabled_until
 FROM server_members
 WHERE
     server_id = $1
@@ -78,12 +207,15 @@ func (q *Queries) GetServerMember(ctx context.Context, arg GetServerMemberParams
 		&i.IsMuted,
 		&i.IsDeafened,
 		&i.UpdatedAt,
+		&i.InviteID,
+		&i.TemporaryUntil,
+		&i.CommunicationDisabledUntil,
 	)
 	return i, err
 }
 
 const getServerMembers = `-- name: GetServerMembers :many
-SELECT id, server_id, user_id, nickname, joined_at, is_muted, is_deafened, updated_at
+SELECT id, server_id, user_id, nickname, joined_at, is_muted, is_deafened, updated_at, invite_id, temporary_until, communication_disabled_until
 FROM server_members
 WHERE
     server_id = $1
@@ -117,6 +249,9 @@ func (q *Queries) GetServerMembers(ctx context.Context, arg GetServerMembersPara
 			&i.IsMuted,
 			&i.IsDeafened,
 			&i.UpdatedAt,
+			&i.InviteID,
+			&i.TemporaryUntil,
+			&i.CommunicationDisabledUntil,
 		); err != nil {
 			return nil, err
 		}
@@ -129,7 +264,7 @@ func (q *Queries) GetServerMembers(ctx context.Context, arg GetServerMembersPara
 }
 
 const getUserServerMemberships = `-- name: GetUserServerMemberships :many
-SELECT id, server_id, user_id, nickname, joined_at, is_muted, is_deafened, updated_at
+SELECT id, server_id, user_id, nickname, joined_at, is_muted, is_deafened, updated_at, invite_id, temporary_until, communication_disabled_until
 FROM server_members
 WHERE
     user_id = $1
@@ -154,6 +289,65 @@ func (q *Queries) GetUserServerMemberships(ctx context.Context, userID int32) ([
 			&i.IsMuted,
 			&i.IsDeafened,
 			&i.UpdatedAt,
+			&i.InviteID,
+			&i.TemporaryUntil,
+			&i.CommunicationDisabledUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const makeMemberPermanent = `-- name: MakeMemberPermanent :exec
+UPDATE server_members
+SET
+    temporary_until = NULL,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    id = $1
+    AND temporary_until IS NOT NULL
+`
+
+func (q *Queries) MakeMemberPermanent(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, makeMemberPermanent, id)
+	return err
+}
+
+const removeExpiredTemporaryMembers = `-- name: RemoveExpiredTemporaryMembers :many
+DELETE FROM server_members
+WHERE
+    temporary_until IS NOT NULL
+    AND temporary_until < CURRENT_TIMESTAMP
+RETURNING
+    id, server_id, user_id, nickname, joined_at, is_muted, is_deafened, updated_at, invite_id, temporary_until, communication_disabled_until
+`
+
+func (q *Queries) RemoveExpiredTemporaryMembers(ctx context.Context) ([]ServerMember, error) {
+	rows, err := q.db.Query(ctx, removeExpiredTemporaryMembers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ServerMember
+	for rows.Next() {
+		var i ServerMember
+		if err := rows.Scan(
+			&i.ID,
+			&i.ServerID,
+			&i.UserID,
+			&i.Nickname,
+			&i.JoinedAt,
+			&i.IsMuted,
+			&i.IsDeafened,
+			&i.UpdatedAt,
+			&i.InviteID,
+			&i.TemporaryUntil,
+			&i.CommunicationDisabledUntil,
 		); err != nil {
 			return nil, err
 		}
@@ -171,7 +365,7 @@ WHERE
     server_id = $1
     AND user_id = $2
 RETURNING
-    id, server_id, user_id, nickname, joined_at, is_muted, is_deafened, updated_at
+    id, server_id, user_id, nickname, joined_at, is_muted, is_deafened, updated_at, invite_id, temporary_until, communication_disabled_until
 `
 
 type RemoveServerMemberParams struct {
@@ -191,6 +385,88 @@ func (q *Queries) RemoveServerMember(ctx context.Context, arg RemoveServerMember
 		&i.IsMuted,
 		&i.IsDeafened,
 		&i.UpdatedAt,
+		&i.InviteID,
+		&i.TemporaryUntil,
+		&i.CommunicationDisabledUntil,
+	)
+	return i, err
+}
+
+const removeTemporaryMemberships = `-- name: RemoveTemporaryMemberships :many
+DELETE FROM server_members
+WHERE
+    user_id = $1
+    AND temporary_until IS NOT NULL
+RETURNING
+    id, server_id, user_id, nickname, joined_at, is_muted, is_deafened, updated_at, invite_id, temporary_until, communication_disabled_until
+`
+
+func (q *Queries) RemoveTemporaryMemberships(ctx context.Context, userID int32) ([]ServerMember, error) {
+	rows, err := q.db.Query(ctx, removeTemporaryMemberships, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ServerMember
+	for rows.Next() {
+		var i ServerMember
+		if err := rows.Scan(
+			&i.ID,
+			&i.ServerID,
+			&i.UserID,
+			&i.Nickname,
+			&i.JoinedAt,
+			&i.IsMuted,
+			&i.IsDeafened,
+			&i.UpdatedAt,
+			&i.InviteID,
+			&i.TemporaryUntil,
+			&i.CommunicationDisabledUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setMemberTimeout = `-- name: SetMemberTimeout :one
+UPDATE server_members
+SET
+    communication_disabled_until = $1,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    server_id = $2
+    AND user_id = $3
+RETURNING
+    id, server_id, user_id, nickname, joined_at, is_muted, is_deafened, updated_at, invite_id, temporary_until, communication_disabled_until
+`
+
+type SetMemberTimeoutParams struct {
+	CommunicationDisabledUntil pgtype.Timestamp `json:"communication_disabled_until"`
+	ServerID                   int32            `json:"server_id"`
+	UserID                     int32            `json:"user_id"`
+}
+
+// A NULL communication_disabled_until ends the timeout
+func (q *Queries) SetMemberTimeout(ctx context.Context, arg SetMemberTimeoutParams) (ServerMember, error) {
+	row := q.db.QueryRow(ctx, setMemberTimeout, arg.CommunicationDisabledUntil, arg.ServerID, arg.UserID)
+	var i ServerMember
+	err := row.Scan(
+		&i.ID,
+		&i.ServerID,
+		&i.UserID,
+		&i.Nickname,
+		&i.JoinedAt,
+		&i.IsMuted,
+		&i.IsDeafened,
+		&i.UpdatedAt,
+		&i.InviteID,
+		&i.TemporaryUntil,
+		&i.CommunicationDisabledUntil,
 	)
 	return i, err
 }
@@ -205,7 +481,7 @@ WHERE
     server_id = $1
     AND user_id = $2
 RETURNING
-    id, server_id, user_id, nickname, joined_at, is_muted, is_deafened, updated_at
+    id, server_id, user_id, nickname, joined_at, is_muted, is_deafened, updated_at, invite_id, temporary_until, communication_disabled_until
 `
 
 type UpdateMemberMuteStatusParams struct {
@@ -232,6 +508,9 @@ func (q *Queries) UpdateMemberMuteStatus(ctx context.Context, arg UpdateMemberMu
 		&i.IsMuted,
 		&i.IsDeafened,
 		&i.UpdatedAt,
+		&i.InviteID,
+		&i.TemporaryUntil,
+		&i.CommunicationDisabledUntil,
 	)
 	return i, err
 }
@@ -245,7 +524,7 @@ WHERE
     server_id = $1
     AND user_id = $2
 RETURNING
-    id, server_id, user_id, nickname, joined_at, is_muted, is_deafened, updated_at
+    id, server_id, user_id, nickname, joined_at, is_muted, is_deafened, updated_at, invite_id, temporary_until, communication_disabled_until
 `
 
 type UpdateMemberNicknameParams struct {
@@ -266,6 +545,9 @@ func (q *Queries) UpdateMemberNickname(ctx context.Context, arg UpdateMemberNick
 		&i.IsMuted,
 		&i.IsDeafened,
 		&i.UpdatedAt,
+		&i.InviteID,
+		&i.TemporaryUntil,
+		&i.CommunicationDisabledUntil,
 	)
 	return i, err
 }
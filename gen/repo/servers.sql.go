@@ -121,6 +121,36 @@ func (q *Queries) GetServerByID(ctx context.Context, id int32) (Server, error) {
 	return i, err
 }
 
+const getServerByVanityUrl = `-- name: GetServerByVanityUrl :one
+SELECT id, name, icon, banner, description, owner_id, region, member_count, is_verified, vanity_url, is_deleted, created_at, updated_at
+FROM servers
+WHERE
+    vanity_url = $1
+    AND is_deleted = FALSE
+LIMIT 1
+`
+
+func (q *Queries) GetServerByVanityUrl(ctx context.Context, vanityUrl pgtype.Text) (Server, error) {
+	row := q.db.QueryRow(ctx, getServerByVanityUrl, vanityUrl)
+	var i Server
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Icon,
+		&i.Banner,
+		&i.Description,
+		&i.OwnerID,
+		&i.Region,
+		&i.MemberCount,
+		&i.IsVerified,
+		&i.VanityUrl,
+		&i.IsDeleted,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const getServersByOwner = `-- name: GetServersByOwner :many
 SELECT id, name, icon, banner, description, owner_id, region, member_count, is_verified, vanity_url, is_deleted, created_at, updated_at
 FROM servers
@@ -298,6 +328,44 @@ func (q *Queries) RestoreServer(ctx context.Context, id int32) (Server, error) {
 	return i, err
 }
 
+const setServerVanityUrl = `-- name: SetServerVanityUrl :one
+UPDATE servers
+SET
+    vanity_url = $2,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    id = $1
+    AND is_deleted = FALSE
+RETURNING
+    id, name, icon, banner, description, owner_id, region, member_count, is_verified, vanity_url, is_deleted, created_at, updated_at
+`
+
+type SetServerVanityUrlParams struct {
+	ID        int32       `json:"id"`
+	VanityUrl pgtype.Text `json:"vanity_url"`
+}
+
+func (q *Queries) SetServerVanityUrl(ctx context.Context, arg SetServerVanityUrlParams) (Server, error) {
+	row := q.db.QueryRow(ctx, setServerVanityUrl, arg.ID, arg.VanityUrl)
+	var i Server
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Icon,
+		&i.Banner,
+		&i.Description,
+		&i.OwnerID,
+		&i.Region,
+		&i.MemberCount,
+		&i.IsVerified,
+		&i.VanityUrl,
+		&i.IsDeleted,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const softDeleteServer = `-- name: SoftDeleteServer :one
 UPDATE servers
 SET
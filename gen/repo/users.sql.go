@@ -459,6 +459,21 @@ func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]User, e
 	return items, nil
 }
 
+const markUserVerified = `-- name: MarkUserVerified :exec
+UPDATE users
+SET
+    is_verified = TRUE,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    id = $1
+    AND is_deleted = FALSE
+`
+
+func (q *Queries) MarkUserVerified(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, markUserVerified, id)
+	return err
+}
+
 const restoreUser = `-- name: RestoreUser :one
 UPDATE users
 SET
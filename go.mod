@@ -8,6 +8,7 @@ require (
 	github.com/knadh/koanf v1.5.0
 	github.com/minio/minio-go/v7 v7.0.97
 	github.com/pquerna/otp v1.5.0
+	github.com/redis/go-redis/v9 v9.9.0
 	github.com/stretchr/testify v1.11.1
 	github.com/twmb/franz-go v1.20.4
 	github.com/xwb1989/sqlparser v0.0.0-20180606152119-120387863bf2
@@ -19,7 +20,9 @@ require (
 
 require (
 	github.com/boombuler/barcode v1.0.1-0.20190219062509-6c824513bacc // indirect
+	github.com/cespare/xxhash/v2 v2.3.0 // indirect
 	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/dgryski/go-rendezvous v0.0.0-20200823014737-9f7001d12a5f // indirect
 	github.com/dustin/go-humanize v1.0.1 // indirect
 	github.com/fsnotify/fsnotify v1.4.9 // indirect
 	github.com/go-ini/ini v1.67.0 // indirect
@@ -1,24 +1,47 @@
 package app
 
 import (
+	"context"
+
 	"discord/config"
 
 	authController "discord/internal/auth/controller"
 	authRepo "discord/internal/auth/repository"
 	authService "discord/internal/auth/service"
+	authUtil "discord/internal/auth/util"
+
+	auditRepo "discord/internal/audit/repository"
+	auditService "discord/internal/audit/service"
+
+	channelRepo "discord/internal/channel/repository"
+	channelService "discord/internal/channel/service"
+
+	dmRepo "discord/internal/dm/repository"
+	dmService "discord/internal/dm/service"
 
 	friendRepo "discord/internal/friend/repository"
 	friendService "discord/internal/friend/service"
 
+	gatewayRepo "discord/internal/gateway/repository"
+	gatewayService "discord/internal/gateway/service"
+
 	messageRepo "discord/internal/message/repository"
 	messageService "discord/internal/message/service"
 
+	permissionRepo "discord/internal/permission/repository"
+	permissionService "discord/internal/permission/service"
+
+	readStateRepo "discord/internal/readstate/repository"
+	readStateService "discord/internal/readstate/service"
+
 	serverRepo "discord/internal/server/repository"
 	serverService "discord/internal/server/service"
 
-	// syncController "discord/internal/sync/controller"
-	// syncRepo "discord/internal/sync/repository"
-	// syncService "discord/internal/sync/service"
+	syncRepo "discord/internal/sync/repository"
+	syncService "discord/internal/sync/service"
+
+	textChannelRepo "discord/internal/textchannel/repository"
+	textChannelService "discord/internal/textchannel/service"
 
 	userRepo "discord/internal/user/repository"
 	userService "discord/internal/user/service"
@@ -26,44 +49,99 @@ import (
 	voiceRepo "discord/internal/voice/repository"
 	voiceService "discord/internal/voice/service"
 
+	channelPb "discord/gen/proto/service/channel"
+	dmPb "discord/gen/proto/service/dm"
 	friendPb "discord/gen/proto/service/friend"
+	gatewayPb "discord/gen/proto/service/gateway"
 	messagePb "discord/gen/proto/service/message"
+	permissionPb "discord/gen/proto/service/permission"
+	readStatePb "discord/gen/proto/service/read_state"
 	serverPb "discord/gen/proto/service/server"
+	syncPb "discord/gen/proto/service/sync"
+	textChannelPb "discord/gen/proto/service/text_channel"
 	userPb "discord/gen/proto/service/user"
 	voicePb "discord/gen/proto/service/voice_channel"
 
+	"discord/pkg/mailer"
+	"discord/pkg/pubsub"
+	"discord/pkg/throttle"
+
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
 )
 
 // Application holds all application dependencies
 type Application struct {
 	Config *config.Config
 	DB     *pgxpool.Pool
+	Broker pubsub.Broker
+	Redis  *redis.Client
 
 	// Repositories
-	AuthRepo    *authRepo.AuthRepository
-	FriendRepo  *friendRepo.FriendRepository
-	MessageRepo *messageRepo.MessageRepository
-	ServerRepo  *serverRepo.ServerRepository
-	// SyncRepo    *syncRepo.SyncRepository
-	UserRepo  *userRepo.UserRepository
-	VoiceRepo *voiceRepo.VoiceRepository
+	AuditRepo       *auditRepo.AuditRepository
+	AuthRepo        *authRepo.AuthRepository
+	ChannelRepo     *channelRepo.ChannelRepository
+	DMRepo          *dmRepo.DMRepository
+	FriendRepo      *friendRepo.FriendRepository
+	GatewayRepo     *gatewayRepo.GatewayRepository
+	MessageRepo     *messageRepo.MessageRepository
+	PermissionRepo  *permissionRepo.PermissionRepository
+	ReadStateRepo   *readStateRepo.ReadStateRepository
+	ServerRepo      *serverRepo.ServerRepository
+	SyncRepo        *syncRepo.SyncRepository
+	TextChannelRepo *textChannelRepo.TextChannelRepository
+	UserRepo        *userRepo.UserRepository
+	VoiceRepo       *voiceRepo.VoiceRepository
+
+	// Keys tokens are signed and verified with
+	SigningKeys *authUtil.KeySet
+
+	// Encrypts TOTP secrets at rest
+	TOTPSecrets *authUtil.SecretBox
+
+	// Proxies trusted to report the client address
+	TrustedProxies *authUtil.TrustedProxies
+
+	// Renders and delivers emails
+	Mail *mailer.Sender
+
+	// Failed login counters, shared through Redis when configured
+	LoginStore throttle.Store
+
+	// Permission resolver shared by services that enforce role and channel permissions
+	PermissionResolver *permissionService.Resolver
 
 	// Services
-	AuthSvc    *authService.AuthService
-	FriendSvc  *friendService.FriendService
-	MessageSvc *messageService.MessageService
-	ServerSvc  *serverService.ServerService
-	// SyncSvc    *syncService.SyncService
-	UserSvc  *userService.UserService
-	VoiceSvc *voiceService.VoiceService
+	AuditSvc       *auditService.AuditService
+	AuthSvc        *authService.AuthService
+	ChannelSvc     *channelService.ChannelService
+	DMSvc          *dmService.MessageService
+	FriendSvc      *friendService.FriendService
+	GatewaySvc     *gatewayService.GatewayService
+	MessageSvc     *messageService.MessageService
+	PermissionSvc  *permissionService.PermissionService
+	ReadStateSvc   *readStateService.ReadStateService
+	ServerSvc      *serverService.ServerService
+	SyncSvc        *syncService.SyncService
+	TextChannelSvc *textChannelService.TextChannelService
+	UserSvc        *userService.UserService
+	VoiceSvc       *voiceService.VoiceService
 
 	// Controllers
-	AuthCtrl    *authController.AuthController
-	FriendCtrl  *friendPb.FriendServiceServer
-	MessageCtrl *messagePb.MessageServiceServer
-	ServerCtrl  *serverPb.ServerServiceServer
-	// SyncCtrl    *syncController.SyncController
-	UserCtrl  *userPb.UserServiceServer
-	VoiceCtrl *voicePb.VoiceChannelServiceServer
+	AuthCtrl        *authController.AuthController
+	ChannelCtrl     *channelPb.ChannelServiceServer
+	DMCtrl          *dmPb.DirectMessageServiceServer
+	FriendCtrl      *friendPb.FriendServiceServer
+	GatewayCtrl     *gatewayPb.GatewayServiceServer
+	MessageCtrl     *messagePb.MessageServiceServer
+	PermissionCtrl  *permissionPb.PermissionServiceServer
+	ReadStateCtrl   *readStatePb.ReadStateServiceServer
+	ServerCtrl      *serverPb.ServerServiceServer
+	SyncCtrl        *syncPb.SyncServiceServer
+	TextChannelCtrl *textChannelPb.TextChannelServiceServer
+	UserCtrl        *userPb.UserServiceServer
+	VoiceCtrl       *voicePb.VoiceChannelServiceServer
+
+	// stopJobs cancels the background jobs started in Initialize
+	stopJobs context.CancelFunc
 }
@@ -1,30 +1,63 @@
 package app
 
 import (
+	"context"
+	"encoding/base64"
 	"fmt"
 	"log"
+	"os"
+	"path/filepath"
+	"time"
 
 	"discord/config"
 
 	authController "discord/internal/auth/controller"
 	authRepo "discord/internal/auth/repository"
 	authService "discord/internal/auth/service"
+	authUtil "discord/internal/auth/util"
+
+	auditRepo "discord/internal/audit/repository"
+	auditService "discord/internal/audit/service"
+
+	channelController "discord/internal/channel/controller"
+	channelRepo "discord/internal/channel/repository"
+	channelService "discord/internal/channel/service"
+
+	dmController "discord/internal/dm/controller"
+	dmRepo "discord/internal/dm/repository"
+	dmService "discord/internal/dm/service"
 
 	friendController "discord/internal/friend/controller"
 	friendRepo "discord/internal/friend/repository"
 	friendService "discord/internal/friend/service"
 
+	gatewayController "discord/internal/gateway/controller"
+	gatewayRepo "discord/internal/gateway/repository"
+	gatewayService "discord/internal/gateway/service"
+
 	messageController "discord/internal/message/controller"
 	messageRepo "discord/internal/message/repository"
 	messageService "discord/internal/message/service"
 
+	permissionController "discord/internal/permission/controller"
+	permissionRepo "discord/internal/permission/repository"
+	permissionService "discord/internal/permission/service"
+
+	readStateController "discord/internal/readstate/controller"
+	readStateRepo "discord/internal/readstate/repository"
+	readStateService "discord/internal/readstate/service"
+
 	serverController "discord/internal/server/controller"
 	serverRepo "discord/internal/server/repository"
 	serverService "discord/internal/server/service"
 
-	// syncController "discord/internal/sync/controller"
-	// syncRepo "discord/internal/sync/repository"
-	// syncService "discord/internal/sync/service"
+	syncController "discord/internal/sync/controller"
+	syncRepo "discord/internal/sync/repository"
+	syncService "discord/internal/sync/service"
+
+	textChannelController "discord/internal/textchannel/controller"
+	textChannelRepo "discord/internal/textchannel/repository"
+	textChannelService "discord/internal/textchannel/service"
 
 	userController "discord/internal/user/controller"
 	userRepo "discord/internal/user/repository"
@@ -33,6 +66,12 @@ import (
 	voiceController "discord/internal/voice/controller"
 	voiceRepo "discord/internal/voice/repository"
 	voiceService "discord/internal/voice/service"
+
+	"discord/pkg/mailer"
+	"discord/pkg/pubsub"
+	"discord/pkg/throttle"
+
+	"github.com/redis/go-redis/v9"
 )
 
 // Initialize initializes all application dependencies
@@ -51,6 +90,33 @@ func (app *Application) Initialize() error {
 	}
 	app.DB = config.DB
 	log.Println("✅ Database connected")
+
+	// Connect pubsub to the other replicas
+	if err := app.initPubSub(); err != nil {
+		return fmt.Errorf("failed to initialize pubsub: %w", err)
+	}
+
+	// Load the token signing keys
+	if err := app.initSigningKeys(); err != nil {
+		return fmt.Errorf("failed to load signing keys: %w", err)
+	}
+	if err := app.initTOTPKey(); err != nil {
+		return fmt.Errorf("failed to load TOTP key: %w", err)
+	}
+	if app.TrustedProxies, err = authUtil.ParseTrustedProxies(app.Config.Auth.TrustedProxies); err != nil {
+		return fmt.Errorf("failed to load auth.trusted_proxies: %w", err)
+	}
+
+	// Set up email delivery
+	if err := app.initMailer(); err != nil {
+		return fmt.Errorf("failed to initialize mailer: %w", err)
+	}
+
+	// Count failed logins across replicas
+	if err := app.initLoginStore(); err != nil {
+		return fmt.Errorf("failed to initialize login throttling: %w", err)
+	}
+
 	// Initialize repositories
 	app.initRepositories()
 	log.Println("✅ Repositories initialized")
@@ -63,44 +129,298 @@ func (app *Application) Initialize() error {
 	app.initControllers()
 	log.Println("✅ Controllers initialized")
 
+	// Start background jobs
+	app.startBackgroundJobs()
+	log.Println("✅ Background jobs started")
+
+	return nil
+}
+
+// initPubSub attaches the process pubsub to the configured broker
+func (app *Application) initPubSub() error {
+	cfg := app.Config.PubSub
+	switch cfg.Broker {
+	case "":
+		log.Println("✅ PubSub running in-process")
+		return nil
+	case "redpanda":
+		if len(cfg.Brokers) == 0 {
+			return fmt.Errorf("pubsub.brokers is required for redpanda")
+		}
+		topic := cfg.Topic
+		if topic == "" {
+			topic = "discord-pubsub"
+		}
+		app.Broker = pubsub.NewRedpandaBroker(cfg.Brokers, topic)
+	default:
+		return fmt.Errorf("unknown pubsub broker %q", cfg.Broker)
+	}
+	pubsub.Get().UseBroker(app.Broker)
+	log.Printf("✅ PubSub connected to %s", cfg.Broker)
+	return nil
+}
+
+// initSigningKeys loads the configured token signing keys. Outside production
+// a missing configuration falls back to a key generated at startup, which
+// signs everyone out on every restart.
+func (app *Application) initSigningKeys() error {
+	cfg := app.Config.Auth
+	issuer := cfg.Issuer
+	if issuer == "" {
+		issuer = "discord"
+	}
+
+	if len(cfg.Keys) == 0 {
+		if app.Config.Service.Environment == "production" {
+			return fmt.Errorf("auth.keys is required in production")
+		}
+		key, err := authUtil.GenerateSigningKey("ephemeral")
+		if err != nil {
+			return err
+		}
+		app.SigningKeys, err = authUtil.NewKeySet(issuer, key)
+		if err != nil {
+			return err
+		}
+		log.Println("⚠️  No auth.keys configured, signing tokens with an ephemeral key")
+		return nil
+	}
+
+	keys := make([]*authUtil.SigningKey, len(cfg.Keys))
+	for i, keyCfg := range cfg.Keys {
+		var err error
+		switch {
+		case keyCfg.PrivateKeyFile != "":
+			var data []byte
+			if data, err = os.ReadFile(keyCfg.PrivateKeyFile); err == nil {
+				keys[i], err = authUtil.ParsePrivateKeyPEM(keyCfg.ID, data)
+			}
+		case keyCfg.PublicKeyFile != "":
+			var data []byte
+			if data, err = os.ReadFile(keyCfg.PublicKeyFile); err == nil {
+				keys[i], err = authUtil.ParsePublicKeyPEM(keyCfg.ID, data)
+			}
+		default:
+			err = fmt.Errorf("key %s has neither private_key_file nor public_key_file", keyCfg.ID)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	var err error
+	app.SigningKeys, err = authUtil.NewKeySet(issuer, keys...)
+	if err != nil {
+		return err
+	}
+	log.Printf("✅ Signing tokens with key %s (%d keys loaded)", keys[0].ID, len(keys))
+	return nil
+}
+
+// initTOTPKey loads the key TOTP secrets are encrypted with. Outside
+// production a missing key falls back to one generated at startup, which
+// breaks every 2FA enrolment on restart.
+func (app *Application) initTOTPKey() error {
+	encoded := app.Config.Auth.TOTPKey
+	if encoded == "" {
+		if app.Config.Service.Environment == "production" {
+			return fmt.Errorf("auth.totp_key is required in production")
+		}
+		key, err := authUtil.GenerateSecretBoxKey()
+		if err != nil {
+			return err
+		}
+		app.TOTPSecrets, err = authUtil.NewSecretBox(key)
+		if err != nil {
+			return err
+		}
+		log.Println("⚠️  No auth.totp_key configured, encrypting TOTP secrets with an ephemeral key")
+		return nil
+	}
+
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return fmt.Errorf("auth.totp_key is not base64: %w", err)
+	}
+	app.TOTPSecrets, err = authUtil.NewSecretBox(key)
+	return err
+}
+
+// initMailer sets up the configured email delivery. Outside production a
+// missing configuration writes emails into a temporary directory instead.
+func (app *Application) initMailer() error {
+	cfg := app.Config.Mail
+	production := app.Config.Service.Environment == "production"
+
+	from := cfg.From
+	if from == "" {
+		from = "Discord <noreply@localhost>"
+	}
+	baseURL := cfg.BaseURL
+	if baseURL == "" {
+		if production {
+			return fmt.Errorf("mail.base_url is required in production")
+		}
+		baseURL = "http://localhost:3000"
+	}
+
+	var m mailer.Mailer
+	switch cfg.Driver {
+	case "smtp":
+		if cfg.Host == "" {
+			return fmt.Errorf("mail.host is required for smtp")
+		}
+		port := cfg.Port
+		if port == 0 {
+			port = 587
+		}
+		m = mailer.NewSMTPMailer(cfg.Host, port, cfg.Username, cfg.Password, from)
+		log.Printf("✅ Sending emails through %s:%d", cfg.Host, port)
+	case "file", "":
+		if cfg.Driver == "" && production {
+			return fmt.Errorf("mail.driver is required in production")
+		}
+		dir := cfg.Dir
+		if dir == "" {
+			dir = filepath.Join(os.TempDir(), "discord-mail")
+		}
+		fileMailer, err := mailer.NewFileMailer(dir, from)
+		if err != nil {
+			return err
+		}
+		m = fileMailer
+		log.Printf("⚠️  Writing emails to %s instead of sending them", dir)
+	case "memory":
+		m = mailer.NewMemoryMailer()
+		log.Println("⚠️  Dropping emails, mail.driver is memory")
+	default:
+		return fmt.Errorf("unknown mail driver %q", cfg.Driver)
+	}
+
+	templates, err := mailer.LoadTemplates()
+	if err != nil {
+		return err
+	}
+	app.Mail = mailer.NewSender(m, templates, baseURL)
+	return nil
+}
+
+// initLoginStore picks where failed logins are counted. With Redis every
+// replica sees the same counts, falling back to local counts while Redis is
+// unreachable. Without it every replica counts on its own.
+func (app *Application) initLoginStore() error {
+	url := app.Config.Database.Redis.URL
+	if url == "" {
+		app.LoginStore = throttle.NewMemoryStore()
+		log.Println("⚠️  No database.redis.url configured, counting failed logins per replica")
+		return nil
+	}
+
+	opts, err := redis.ParseURL(url)
+	if err != nil {
+		return err
+	}
+	app.Redis = redis.NewClient(opts)
+	app.LoginStore = throttle.NewFallbackStore(throttle.NewRedisStore(app.Redis), throttle.NewMemoryStore())
+	log.Println("✅ Counting failed logins in Redis")
 	return nil
 }
 
 // initRepositories initializes all repository instances
 func (app *Application) initRepositories() {
+	app.AuditRepo = auditRepo.NewAuditRepository(app.DB)
 	app.AuthRepo = authRepo.NewAuthRepository(app.DB)
+	app.ChannelRepo = channelRepo.NewChannelRepository(app.DB)
+	app.DMRepo = dmRepo.NewDMRepository(app.DB)
 	app.FriendRepo = friendRepo.NewFriendRepository(app.DB)
+	app.GatewayRepo = gatewayRepo.NewGatewayRepository(app.DB)
 	app.MessageRepo = messageRepo.NewMessageRepository(app.DB)
+	app.PermissionRepo = permissionRepo.NewPermissionRepository(app.DB)
+	app.ReadStateRepo = readStateRepo.NewReadStateRepository(app.DB)
 	app.ServerRepo = serverRepo.NewServerRepository(app.DB)
-	// app.SyncRepo = syncRepo.NewSyncRepository(app.DB)
+	app.SyncRepo = syncRepo.NewSyncRepository(app.DB)
+	app.TextChannelRepo = textChannelRepo.NewTextChannelRepository(app.DB)
 	app.UserRepo = userRepo.NewUserRepository(app.DB)
 	app.VoiceRepo = voiceRepo.NewVoiceRepository(app.DB)
 }
 
 // initServices initializes all service instances
 func (app *Application) initServices() {
-	app.AuthSvc = authService.NewAuthService(app.AuthRepo)
+	app.PermissionResolver = permissionService.NewResolver(app.PermissionRepo)
+
+	app.AuditSvc = auditService.NewAuditService(app.AuditRepo)
+	app.AuthSvc = authService.NewAuthService(app.AuthRepo, app.SigningKeys, app.TOTPSecrets, app.Mail, app.LoginStore)
+	app.ChannelSvc = channelService.NewChannelService(app.ChannelRepo, app.PermissionResolver, app.AuditSvc)
+	app.DMSvc = dmService.NewMessageService(app.DMRepo)
 	app.FriendSvc = friendService.NewFriendService(app.FriendRepo)
-	app.MessageSvc = messageService.NewMessageService(app.MessageRepo)
-	app.ServerSvc = serverService.NewServerService(app.ServerRepo)
-	// app.SyncSvc = syncService.NewSyncService(app.SyncRepo)
+	app.GatewaySvc = gatewayService.NewGatewayService(app.GatewayRepo, app.PermissionResolver, app.AuthSvc)
+	app.MessageSvc = messageService.NewMessageService(app.MessageRepo, app.PermissionResolver)
+	app.PermissionSvc = permissionService.NewPermissionService(app.PermissionRepo, app.PermissionResolver)
+	app.ReadStateSvc = readStateService.NewReadStateService(app.ReadStateRepo, app.PermissionResolver)
+	app.ServerSvc = serverService.NewServerService(app.ServerRepo, app.PermissionResolver, app.AuditSvc)
+	app.SyncSvc = syncService.NewSyncService(app.SyncRepo)
+	app.TextChannelSvc = textChannelService.NewTextChannelService(app.TextChannelRepo, app.PermissionResolver, app.AuditSvc)
 	app.UserSvc = userService.NewUserService(app.UserRepo)
-	app.VoiceSvc = voiceService.NewVoiceService(app.VoiceRepo)
+	app.VoiceSvc = voiceService.NewVoiceService(app.VoiceRepo, app.PermissionResolver)
+
+	// Temporary members lose their membership once they are gone from the gateway
+	app.GatewaySvc.OnDisconnect(func(userID int32) {
+		if err := app.ServerSvc.RevokeTemporaryMemberships(context.Background(), userID); err != nil {
+			log.Printf("failed to revoke temporary memberships of user %d: %v", userID, err)
+		}
+	})
+
+	// Timed out members may not speak, so they are server muted in voice
+	app.ServerSvc.OnTimeout(func(ctx context.Context, serverID, userID int32) {
+		if err := app.VoiceSvc.MuteInServer(ctx, userID, serverID); err != nil {
+			log.Printf("failed to mute timed out user %d in server %d: %v", userID, serverID, err)
+		}
+	})
 }
 
 // initControllers initializes all controller instances
 func (app *Application) initControllers() {
-	app.AuthCtrl = authController.NewAuthController(app.AuthSvc)
+	app.AuthCtrl = authController.NewAuthController(app.AuthSvc, app.TrustedProxies)
+	app.ChannelCtrl = channelController.NewChannelController(app.ChannelSvc)
+	app.DMCtrl = dmController.NewDMController(app.DMSvc)
 	app.FriendCtrl = friendController.NewFriendController(app.FriendSvc)
+	app.GatewayCtrl = gatewayController.NewGatewayController(app.GatewaySvc)
 	app.MessageCtrl = messageController.NewMessageController(app.MessageSvc)
+	app.PermissionCtrl = permissionController.NewPermissionController(app.PermissionSvc, app.ServerSvc)
+	app.ReadStateCtrl = readStateController.NewReadStateController(app.ReadStateSvc)
 	app.ServerCtrl = serverController.NewServerController(app.ServerSvc)
-	// app.SyncCtrl = syncController.NewSyncController(app.SyncSvc)
+	app.SyncCtrl = syncController.NewSyncController(app.SyncSvc)
+	app.TextChannelCtrl = textChannelController.NewTextChannelController(app.TextChannelSvc)
 	app.UserCtrl = userController.NewUserController(app.UserSvc)
 	app.VoiceCtrl = voiceController.NewVoiceController(app.VoiceSvc)
 }
 
+// startBackgroundJobs starts periodic maintenance jobs that run until Shutdown
+func (app *Application) startBackgroundJobs() {
+	ctx, cancel := context.WithCancel(context.Background())
+	app.stopJobs = cancel
+
+	app.SyncSvc.StartDeletedEntityPruner(ctx, time.Hour)
+	app.ServerSvc.StartInviteSweeper(ctx, 5*time.Minute)
+	app.ServerSvc.StartBanScheduler(ctx, time.Minute)
+	app.ServerSvc.StartTimeoutScheduler(ctx, time.Minute)
+	app.AuditSvc.StartRetention(ctx, time.Hour)
+}
+
 // Shutdown gracefully shuts down the application
 func (app *Application) Shutdown() {
+	if app.stopJobs != nil {
+		app.stopJobs()
+		log.Println("✅ Background jobs stopped")
+	}
+	if app.Broker != nil {
+		app.Broker.Close()
+		log.Println("✅ PubSub broker closed")
+	}
+	if app.Redis != nil {
+		app.Redis.Close()
+		log.Println("✅ Redis connection closed")
+	}
 	if app.DB != nil {
 		app.DB.Close()
 		log.Println("✅ Database connection closed")
@@ -6,9 +6,16 @@ import (
 	"net"
 
 	authPb "discord/gen/proto/service/auth"
+	channelPb "discord/gen/proto/service/channel"
+	dmPb "discord/gen/proto/service/dm"
 	friendPb "discord/gen/proto/service/friend"
+	gatewayPb "discord/gen/proto/service/gateway"
 	messagePb "discord/gen/proto/service/message"
+	permissionPb "discord/gen/proto/service/permission"
+	readStatePb "discord/gen/proto/service/read_state"
 	serverPb "discord/gen/proto/service/server"
+	syncPb "discord/gen/proto/service/sync"
+	textChannelPb "discord/gen/proto/service/text_channel"
 	userPb "discord/gen/proto/service/user"
 	voicePb "discord/gen/proto/service/voice_channel"
 	"discord/internal/common/middleware"
@@ -22,21 +29,22 @@ func (app *Application) StartServer() error {
 	// Create gRPC server with interceptors
 	grpcServer := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
-			middleware.RecoveryInterceptor(), // Panic recovery (first)
-			middleware.LoggingInterceptor(),  // Request logging
-			// middleware.RateLimitInterceptor(), // Rate limiting
-			middleware.AuthInterceptor(), // Authentication (last)
+			middleware.RecoveryInterceptor(),                    // Panic recovery (first)
+			middleware.LoggingInterceptor(),                     // Request logging
+			middleware.AuthInterceptor(app.AuthSvc),             // Authentication
+			middleware.RateLimitInterceptor(app.TrustedProxies), // Rate limiting per user, so after authentication
 		),
 		grpc.ChainStreamInterceptor(
-			middleware.StreamRecoveryInterceptor(), // Panic recovery for streams
-			middleware.StreamLoggingInterceptor(),  // Logging for streams
+			middleware.StreamRecoveryInterceptor(),        // Panic recovery for streams
+			middleware.StreamLoggingInterceptor(),         // Logging for streams
+			middleware.StreamAuthInterceptor(app.AuthSvc), // Authentication for streams
 		),
 	)
 
 	// Register all services
 	app.registerServices(grpcServer)
 	log.Println("✅ gRPC services registered")
-	log.Println("✅ Interceptors enabled: Recovery, Logging, RateLimit, Auth")
+	log.Println("✅ Interceptors enabled: Recovery, Logging, Auth, RateLimit")
 
 	// Get port
 	port := app.Config.Service.Port
@@ -63,10 +71,16 @@ func (app *Application) StartServer() error {
 // registerServices registers all gRPC services
 func (app *Application) registerServices(grpcServer *grpc.Server) {
 	authPb.RegisterAuthServiceServer(grpcServer, app.AuthCtrl)
+	channelPb.RegisterChannelServiceServer(grpcServer, *app.ChannelCtrl)
+	dmPb.RegisterDirectMessageServiceServer(grpcServer, *app.DMCtrl)
 	friendPb.RegisterFriendServiceServer(grpcServer, *app.FriendCtrl)
+	gatewayPb.RegisterGatewayServiceServer(grpcServer, *app.GatewayCtrl)
 	messagePb.RegisterMessageServiceServer(grpcServer, *app.MessageCtrl)
+	permissionPb.RegisterPermissionServiceServer(grpcServer, *app.PermissionCtrl)
+	readStatePb.RegisterReadStateServiceServer(grpcServer, *app.ReadStateCtrl)
 	serverPb.RegisterServerServiceServer(grpcServer, *app.ServerCtrl)
-	// syncPb.RegisterSyncServiceServer(grpcServer, app.SyncCtrl)
+	syncPb.RegisterSyncServiceServer(grpcServer, *app.SyncCtrl)
+	textChannelPb.RegisterTextChannelServiceServer(grpcServer, *app.TextChannelCtrl)
 	userPb.RegisterUserServiceServer(grpcServer, *app.UserCtrl)
 	voicePb.RegisterVoiceChannelServiceServer(grpcServer, *app.VoiceCtrl)
 }
@@ -81,13 +95,19 @@ func (app *Application) printStartupInfo(port string) {
 	log.Printf("🌍 Environment: %s", app.Config.Service.Environment)
 	log.Printf("🗄️  Database:    Connected")
 	log.Println("\n📦 Registered Services:")
-	log.Println("   ✓ AuthService         - User registration & authentication")
-	log.Println("   ✓ FriendService       - Friend management & requests")
-	log.Println("   ✓ MessageService      - Messages, reactions, attachments")
-	log.Println("   ✓ ServerService       - Servers, members, roles, invites")
-	log.Println("   ✓ SyncService         - Real-time data synchronization")
-	log.Println("   ✓ UserService         - User profiles & settings")
-	log.Println("   ✓ VoiceChannelService - Voice states & connections")
+	log.Println("   ✓ AuthService          - User registration & authentication")
+	log.Println("   ✓ ChannelService       - Channels, categories, overwrites")
+	log.Println("   ✓ DirectMessageService - Direct messages, pins, reactions")
+	log.Println("   ✓ FriendService        - Friend management & requests")
+	log.Println("   ✓ GatewayService       - Real-time events over one stream")
+	log.Println("   ✓ MessageService       - Messages, reactions, attachments")
+	log.Println("   ✓ PermissionService    - Permission flags & roles")
+	log.Println("   ✓ ReadStateService     - Read markers, unread & mention counts")
+	log.Println("   ✓ ServerService        - Servers, members, roles, invites")
+	log.Println("   ✓ SyncService          - Real-time data synchronization")
+	log.Println("   ✓ TextChannelService   - Text channel groups & archiving")
+	log.Println("   ✓ UserService          - User profiles & settings")
+	log.Println("   ✓ VoiceChannelService  - Voice states & connections")
 	log.Println("\n✨ Server is ready to accept connections!")
 	log.Println(separator + "\n")
 }
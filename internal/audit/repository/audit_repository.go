@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"discord/gen/repo"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AuditRepository struct {
+	db      *pgxpool.Pool
+	queries *repo.Queries
+}
+
+func NewAuditRepository(db *pgxpool.Pool) *AuditRepository {
+	return &AuditRepository{
+		db:      db,
+		queries: repo.New(db),
+	}
+}
+
+// Filter narrows a listing of audit log entries; zero values match everything
+type Filter struct {
+	UserID   int32
+	Action   string
+	TargetID int32
+	BeforeID int32
+}
+
+// CreateAuditLog stores an audit log entry
+func (r *AuditRepository) CreateAuditLog(ctx context.Context, serverID, userID int32, action string, targetID int32, targetType string, changes []byte, reason *string) (repo.AuditLog, error) {
+	params := repo.CreateAuditLogParams{
+		ServerID: serverID,
+		UserID:   pgtype.Int4{Int32: userID, Valid: userID != 0},
+		Action:   action,
+		TargetID: pgtype.Int4{Int32: targetID, Valid: targetID != 0},
+		Changes:  changes,
+	}
+	if targetType != "" {
+		params.TargetType = pgtype.Text{String: targetType, Valid: true}
+	}
+	if reason != nil {
+		params.Reason = pgtype.Text{String: *reason, Valid: true}
+	}
+	return r.queries.CreateAuditLog(ctx, params)
+}
+
+// ListAuditLogs retrieves the newest entries of a server matching filter
+func (r *AuditRepository) ListAuditLogs(ctx context.Context, serverID int32, filter Filter, limit int32) ([]repo.AuditLog, error) {
+	params := repo.ListAuditLogsParams{
+		ServerID:    serverID,
+		ResultLimit: limit,
+	}
+	if filter.UserID != 0 {
+		params.UserID = pgtype.Int4{Int32: filter.UserID, Valid: true}
+	}
+	if filter.Action != "" {
+		params.Action = pgtype.Text{String: filter.Action, Valid: true}
+	}
+	if filter.TargetID != 0 {
+		params.TargetID = pgtype.Int4{Int32: filter.TargetID, Valid: true}
+	}
+	if filter.BeforeID != 0 {
+		params.BeforeID = pgtype.Int4{Int32: filter.BeforeID, Valid: true}
+	}
+	return r.queries.ListAuditLogs(ctx, params)
+}
+
+// DeleteOldAuditLogs removes audit log entries older than the given time
+func (r *AuditRepository) DeleteOldAuditLogs(ctx context.Context, before time.Time) (int64, error) {
+	return r.queries.DeleteOldAuditLogs(ctx, pgtype.Timestamp{Time: before.UTC(), Valid: true})
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"discord/gen/repo"
+	auditRepo "discord/internal/audit/repository"
+	auditUtil "discord/internal/audit/util"
+	commonErrors "discord/internal/common/errors"
+	commonUtil "discord/internal/common/util"
+)
+
+const (
+	DefaultAuditLogLimit = 50
+	MaxAuditLogLimit     = 100
+
+	// AuditLogRetention is how long audit log entries are kept
+	AuditLogRetention = 90 * 24 * time.Hour
+)
+
+// Entry is one administrative action. Before and After are the affected
+// entity as it was and as it is now; their differing fields are stored as the
+// changes of the entry. Reason defaults to the one the request sent.
+type Entry struct {
+	ServerID   int32
+	UserID     int32
+	Action     string
+	TargetID   int32
+	TargetType string
+	Before     any
+	After      any
+	Reason     *string
+}
+
+// AuditService writes the audit log of servers. Services record an entry
+// after each administrative action succeeded.
+type AuditService struct {
+	auditRepo *auditRepo.AuditRepository
+}
+
+func NewAuditService(auditRepo *auditRepo.AuditRepository) *AuditService {
+	return &AuditService{
+		auditRepo: auditRepo,
+	}
+}
+
+// Record stores an entry. The action it describes already happened, so a
+// failure is logged instead of returned.
+func (s *AuditService) Record(ctx context.Context, entry Entry) {
+	changes, err := auditUtil.Diff(entry.Before, entry.After)
+	if err != nil {
+		log.Printf("failed to diff audit log entry %s: %v", entry.Action, err)
+		return
+	}
+	var data []byte
+	if len(changes) > 0 {
+		if data, err = json.Marshal(changes); err != nil {
+			log.Printf("failed to encode audit log entry %s: %v", entry.Action, err)
+			return
+		}
+	}
+
+	reason := entry.Reason
+	if reason == nil {
+		reason = auditUtil.ReasonFromContext(ctx)
+	}
+
+	if _, err := s.auditRepo.CreateAuditLog(ctx, entry.ServerID, entry.UserID, entry.Action, entry.TargetID, entry.TargetType, data, reason); err != nil {
+		log.Printf("failed to record audit log entry %s in server %d: %v", entry.Action, entry.ServerID, err)
+	}
+}
+
+// GetAuditLog returns one page of a server's audit log, newest first, and the
+// cursor of the next page, which is empty on the last page. Callers check
+// that the user may view the audit log.
+func (s *AuditService) GetAuditLog(ctx context.Context, serverID int32, filter auditRepo.Filter, cursor string, limit int32) ([]repo.AuditLog, string, error) {
+	if limit <= 0 {
+		limit = DefaultAuditLogLimit
+	}
+	if limit > MaxAuditLogLimit {
+		limit = MaxAuditLogLimit
+	}
+	if cursor != "" {
+		beforeID, err := auditUtil.DecodeCursor(cursor, serverID)
+		if err != nil {
+			return nil, "", commonErrors.ErrInvalidInput
+		}
+		filter.BeforeID = beforeID
+	}
+
+	// One extra entry tells whether there is a next page
+	entries, err := s.auditRepo.ListAuditLogs(ctx, serverID, filter, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+	if int32(len(entries)) <= limit {
+		return entries, "", nil
+	}
+	entries = entries[:limit]
+	return entries, auditUtil.EncodeCursor(serverID, entries[limit-1].ID), nil
+}
+
+// PruneAuditLogs drops entries past the retention window
+func (s *AuditService) PruneAuditLogs(ctx context.Context) (int64, error) {
+	return s.auditRepo.DeleteOldAuditLogs(ctx, time.Now().Add(-AuditLogRetention))
+}
+
+// StartRetention prunes the audit log every interval until ctx is done
+func (s *AuditService) StartRetention(ctx context.Context, interval time.Duration) {
+	go commonUtil.RunPeriodic(ctx, interval, func(ctx context.Context) {
+		pruned, err := s.PruneAuditLogs(ctx)
+		if err != nil {
+			log.Printf("failed to prune audit logs: %v", err)
+			return
+		}
+		if pruned > 0 {
+			log.Printf("pruned %d audit log entries", pruned)
+		}
+	})
+}
@@ -0,0 +1,35 @@
+package util
+
+// Actions recorded in the audit log
+const (
+	ServerUpdate      = "server_update"
+	OwnershipTransfer = "ownership_transfer"
+	VanityURLUpdate   = "vanity_url_update"
+	ChannelCreate     = "channel_create"
+	ChannelUpdate     = "channel_update"
+	ChannelDelete     = "channel_delete"
+	OverwriteUpdate   = "channel_overwrite_update"
+	OverwriteDelete   = "channel_overwrite_delete"
+	MemberKick        = "member_kick"
+	MemberBanAdd      = "member_ban_add"
+	MemberBanRemove   = "member_ban_remove"
+	MemberUpdate      = "member_update"
+	MemberTimeout     = "member_timeout"
+	MemberRoleAdd     = "member_role_add"
+	MemberRoleRemove  = "member_role_remove"
+	RoleCreate        = "role_create"
+	RoleUpdate        = "role_update"
+	RoleDelete        = "role_delete"
+	InviteCreate      = "invite_create"
+	InviteDelete      = "invite_delete"
+)
+
+// Types of the entity an audit log entry targets
+const (
+	TargetServer    = "server"
+	TargetChannel   = "channel"
+	TargetOverwrite = "overwrite"
+	TargetUser      = "user"
+	TargetRole      = "role"
+	TargetInvite    = "invite"
+)
@@ -0,0 +1,75 @@
+package util
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+)
+
+// Change is one field an audited action changed. Old is absent for created
+// entities and New for deleted ones.
+type Change struct {
+	Key string          `json:"key"`
+	Old json.RawMessage `json:"old,omitempty"`
+	New json.RawMessage `json:"new,omitempty"`
+}
+
+// Fields that change on every write and say nothing about the action
+var ignoredKeys = map[string]struct{}{
+	"updated_at": {},
+}
+
+// Diff compares the JSON forms of two versions of an entity and returns the
+// fields that differ, sorted by key. Either side may be nil.
+func Diff(before, after any) ([]Change, error) {
+	oldFields, err := fields(before)
+	if err != nil {
+		return nil, err
+	}
+	newFields, err := fields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]struct{}, len(oldFields)+len(newFields))
+	for key := range oldFields {
+		keys[key] = struct{}{}
+	}
+	for key := range newFields {
+		keys[key] = struct{}{}
+	}
+
+	var changes []Change
+	for key := range keys {
+		if _, ok := ignoredKeys[key]; ok {
+			continue
+		}
+		if bytes.Equal(oldFields[key], newFields[key]) {
+			continue
+		}
+		changes = append(changes, Change{Key: key, Old: oldFields[key], New: newFields[key]})
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Key < changes[j].Key })
+	return changes, nil
+}
+
+// fields returns the JSON encoded fields of an entity, leaving out nulls
+func fields(entity any) (map[string]json.RawMessage, error) {
+	if entity == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(entity)
+	if err != nil {
+		return nil, err
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	for key, value := range raw {
+		if bytes.Equal(value, []byte("null")) {
+			delete(raw, key)
+		}
+	}
+	return raw, nil
+}
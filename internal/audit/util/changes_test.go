@@ -0,0 +1,46 @@
+package util
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type entity struct {
+	Name      string  `json:"name"`
+	Topic     *string `json:"topic"`
+	Position  int     `json:"position"`
+	UpdatedAt string  `json:"updated_at"`
+}
+
+func TestDiffReportsChangedFields(t *testing.T) {
+	topic := "news"
+	before := entity{Name: "general", Position: 1, UpdatedAt: "a"}
+	after := entity{Name: "general", Topic: &topic, Position: 2, UpdatedAt: "b"}
+
+	changes, err := Diff(before, after)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 2 {
+		t.Fatalf("expected 2 changes, got %+v", changes)
+	}
+	if changes[0].Key != "position" || string(changes[0].Old) != "1" || string(changes[0].New) != "2" {
+		t.Errorf("unexpected position change %+v", changes[0])
+	}
+	if changes[1].Key != "topic" || changes[1].Old != nil || string(changes[1].New) != `"news"` {
+		t.Errorf("unexpected topic change %+v", changes[1])
+	}
+}
+
+func TestDiffOfCreatedEntity(t *testing.T) {
+	changes, err := Diff(nil, entity{Name: "general"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, _ := json.Marshal(changes)
+	expected := `[{"key":"name","new":"general"},{"key":"position","new":0}]`
+	if string(data) != expected {
+		t.Errorf("expected %s, got %s", expected, data)
+	}
+}
@@ -0,0 +1,42 @@
+package util
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidCursor is returned for cursors that were not issued for the server
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// EncodeCursor returns the opaque cursor of the page after entryID. Entries are
+// listed newest first by id, so the next page holds the entries below it.
+func EncodeCursor(serverID, entryID int32) string {
+	raw := fmt.Sprintf("%d:%d", serverID, entryID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeCursor returns the entry id a cursor continues after and checks that
+// it belongs to the server
+func DecodeCursor(cursor string, serverID int32) (int32, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, ErrInvalidCursor
+	}
+
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != 2 {
+		return 0, ErrInvalidCursor
+	}
+	cursorServerID, err := strconv.ParseInt(parts[0], 10, 32)
+	if err != nil || int32(cursorServerID) != serverID {
+		return 0, ErrInvalidCursor
+	}
+	entryID, err := strconv.ParseInt(parts[1], 10, 32)
+	if err != nil || entryID <= 0 {
+		return 0, ErrInvalidCursor
+	}
+	return int32(entryID), nil
+}
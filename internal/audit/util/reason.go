@@ -0,0 +1,52 @@
+package util
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// ReasonHeader is the gRPC metadata key clients send an audit log reason in.
+// The value is URL encoded so it can carry any text.
+const ReasonHeader = "x-audit-log-reason"
+
+// MaxReasonLength is the longest reason that is recorded, in characters
+const MaxReasonLength = 512
+
+// ReasonFromContext returns the audit log reason of the calling request, or
+// nil when it did not send one
+func ReasonFromContext(ctx context.Context) *string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+	values := md.Get(ReasonHeader)
+	if len(values) == 0 {
+		return nil
+	}
+
+	reason, err := url.QueryUnescape(values[0])
+	if err != nil {
+		reason = values[0]
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil
+	}
+	reason = truncate(reason, MaxReasonLength)
+	return &reason
+}
+
+// truncate cuts s down to at most max characters, never inside one
+func truncate(s string, max int) string {
+	count := 0
+	for i := range s {
+		if count == max {
+			return s[:i]
+		}
+		count++
+	}
+	return s
+}
@@ -0,0 +1,76 @@
+package util
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/metadata"
+)
+
+func withReason(reason string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(ReasonHeader, reason))
+}
+
+func TestReasonFromContext(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want *string
+	}{
+		{"no metadata", context.Background(), nil},
+		{"no reason", metadata.NewIncomingContext(context.Background(), metadata.Pairs("other", "x")), nil},
+		{"blank reason", withReason("%20%20"), nil},
+		{"url encoded", withReason(url.QueryEscape(" spam, again ")), ptr("spam, again")},
+		{"not url encoded", withReason("100%"), ptr("100%")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ReasonFromContext(tt.ctx)
+			if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+				t.Errorf("expected %v, got %v", deref(tt.want), deref(got))
+			}
+		})
+	}
+}
+
+func TestReasonFromContextTruncates(t *testing.T) {
+	tests := []struct {
+		name   string
+		reason string
+	}{
+		{"ascii", strings.Repeat("a", MaxReasonLength+10)},
+		{"multibyte", strings.Repeat("é", MaxReasonLength+10)},
+		{"boundary inside a character", "a" + strings.Repeat("日", MaxReasonLength)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ReasonFromContext(withReason(url.QueryEscape(tt.reason)))
+			if got == nil {
+				t.Fatal("expected a reason")
+			}
+			if !utf8.ValidString(*got) {
+				t.Errorf("truncated inside a character: %q", *got)
+			}
+			if n := utf8.RuneCountInString(*got); n != MaxReasonLength {
+				t.Errorf("expected %d characters, got %d", MaxReasonLength, n)
+			}
+			if !strings.HasPrefix(tt.reason, *got) {
+				t.Error("expected a prefix of the reason")
+			}
+		})
+	}
+}
+
+func ptr(s string) *string {
+	return &s
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return "<nil>"
+	}
+	return *s
+}
@@ -2,21 +2,31 @@ package controller
 
 import (
 	"context"
+	"errors"
+	"strconv"
+
 	"discord/gen/proto/service/auth"
+	"discord/gen/repo"
 	authService "discord/internal/auth/service"
+	authUtil "discord/internal/auth/util"
+	commonErrors "discord/internal/common/errors"
 
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
 type AuthController struct {
 	auth.UnimplementedAuthServiceServer
 	authService *authService.AuthService
+	proxies     *authUtil.TrustedProxies
 }
 
-func NewAuthController(authService *authService.AuthService) *AuthController {
+func NewAuthController(authService *authService.AuthService, proxies *authUtil.TrustedProxies) *AuthController {
 	return &AuthController{
 		authService: authService,
+		proxies:     proxies,
 	}
 }
 
@@ -42,16 +52,63 @@ func (c *AuthController) Login(ctx context.Context, req *auth.LoginRequest) (*au
 		return nil, status.Error(codes.InvalidArgument, "username and password are required")
 	}
 
-	accessToken, refreshToken, user, err := c.authService.Login(ctx, req.Username, req.Password)
+	device := authService.DeviceInfo{
+		Name:      req.DeviceName,
+		IPAddress: c.proxies.ClientIP(ctx),
+		UserAgent: authUtil.UserAgent(ctx),
+	}
+
+	result, err := c.authService.Login(ctx, req.Username, req.Password, device)
+	if err != nil {
+		return nil, loginError(ctx, err)
+	}
+
+	return loginResponse(result), nil
+}
+
+// LoginMFA completes a login with a TOTP or backup code
+func (c *AuthController) LoginMFA(ctx context.Context, req *auth.LoginMFARequest) (*auth.LoginResponse, error) {
+	if req.MfaTicket == "" || req.Code == "" {
+		return nil, status.Error(codes.InvalidArgument, "mfa ticket and code are required")
+	}
+
+	device := authService.DeviceInfo{
+		Name:      req.DeviceName,
+		IPAddress: c.proxies.ClientIP(ctx),
+		UserAgent: authUtil.UserAgent(ctx),
+	}
+
+	result, err := c.authService.LoginMFA(ctx, req.MfaTicket, req.Code, device)
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, "invalid credentials")
+		return nil, loginError(ctx, err)
 	}
 
+	return loginResponse(result), nil
+}
+
+// loginError converts a login error. Throttled logins tell the client when to
+// retry in the retry-after header.
+func loginError(ctx context.Context, err error) error {
+	var throttled *authService.ThrottledError
+	if errors.As(err, &throttled) {
+		grpc.SetHeader(ctx, metadata.Pairs("retry-after", strconv.Itoa(throttled.RetryAfterSeconds())))
+		return status.Error(codes.ResourceExhausted, throttled.Error())
+	}
+	if errors.Is(err, commonErrors.ErrInvalidCredentials) {
+		return status.Error(codes.Unauthenticated, "invalid credentials")
+	}
+	return commonErrors.ToGRPCError(err)
+}
+
+// loginResponse converts a login result to auth.LoginResponse
+func loginResponse(result *authService.LoginResult) *auth.LoginResponse {
 	return &auth.LoginResponse{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-		User:         user,
-	}, nil
+		AccessToken:  result.AccessToken,
+		RefreshToken: result.RefreshToken,
+		User:         result.User,
+		MfaRequired:  result.MFATicket != "",
+		MfaTicket:    result.MFATicket,
+	}
 }
 
 // Logout handles user logout
@@ -77,7 +134,7 @@ func (c *AuthController) RefreshToken(ctx context.Context, req *auth.RefreshToke
 		return nil, status.Error(codes.InvalidArgument, "refresh token is required")
 	}
 
-	accessToken, refreshToken, err := c.authService.RefreshToken(ctx, req.RefreshToken)
+	accessToken, refreshToken, err := c.authService.RefreshToken(ctx, req.RefreshToken, c.proxies.ClientIP(ctx))
 	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
 	}
@@ -97,7 +154,7 @@ func (c *AuthController) VerifyEmail(ctx context.Context, req *auth.VerifyEmailR
 
 	err := c.authService.VerifyEmail(ctx, req.Token)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, commonErrors.ToGRPCError(err)
 	}
 
 	return &auth.VerifyEmailResponse{
@@ -106,6 +163,17 @@ func (c *AuthController) VerifyEmail(ctx context.Context, req *auth.VerifyEmailR
 	}, nil
 }
 
+// ResendVerificationEmail mails the caller a new verification link
+func (c *AuthController) ResendVerificationEmail(ctx context.Context, req *auth.ResendVerificationEmailRequest) (*auth.ResendVerificationEmailResponse, error) {
+	userID := ctx.Value("user_id").(int32)
+
+	if err := c.authService.SendVerificationEmail(ctx, userID); err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	return &auth.ResendVerificationEmailResponse{Success: true}, nil
+}
+
 // ForgotPassword initiates password reset
 func (c *AuthController) ForgotPassword(ctx context.Context, req *auth.ForgotPasswordRequest) (*auth.ForgotPasswordResponse, error) {
 	if req.Email == "" {
@@ -128,9 +196,13 @@ func (c *AuthController) ResetPassword(ctx context.Context, req *auth.ResetPassw
 		return nil, status.Error(codes.InvalidArgument, "token and new password are required")
 	}
 
+	if err := authUtil.ValidatePassword(req.NewPassword); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	err := c.authService.ResetPassword(ctx, req.Token, req.NewPassword)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, commonErrors.ToGRPCError(err)
 	}
 
 	return &auth.ResetPasswordResponse{
@@ -144,7 +216,9 @@ func (c *AuthController) ChangePassword(ctx context.Context, req *auth.ChangePas
 		return nil, status.Error(codes.InvalidArgument, "old and new password are required")
 	}
 
-	err := c.authService.ChangePassword(ctx, req.UserId, req.OldPassword, req.NewPassword)
+	userID := ctx.Value("user_id").(int32)
+
+	err := c.authService.ChangePassword(ctx, userID, req.OldPassword, req.NewPassword)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -157,9 +231,11 @@ func (c *AuthController) ChangePassword(ctx context.Context, req *auth.ChangePas
 
 // Enable2FA enables two-factor authentication
 func (c *AuthController) Enable2FA(ctx context.Context, req *auth.Enable2FARequest) (*auth.Enable2FAResponse, error) {
-	secret, qrCode, backupCodes, err := c.authService.Enable2FA(ctx, req.UserId)
+	userID := ctx.Value("user_id").(int32)
+
+	secret, qrCode, backupCodes, err := c.authService.Enable2FA(ctx, userID)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, commonErrors.ToGRPCError(err)
 	}
 
 	return &auth.Enable2FAResponse{
@@ -176,13 +252,150 @@ func (c *AuthController) Verify2FA(ctx context.Context, req *auth.Verify2FAReque
 		return nil, status.Error(codes.InvalidArgument, "2FA code is required")
 	}
 
-	valid, err := c.authService.Verify2FA(ctx, req.UserId, req.Code)
+	userID := ctx.Value("user_id").(int32)
+
+	valid, err := c.authService.Verify2FA(ctx, userID, req.Code)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	message := "2FA verified"
+	if !valid {
+		message = "invalid 2FA code"
 	}
 
 	return &auth.Verify2FAResponse{
 		Valid:   valid,
-		Message: "2FA verified",
+		Message: message,
+	}, nil
+}
+
+// Disable2FA turns two-factor authentication off
+func (c *AuthController) Disable2FA(ctx context.Context, req *auth.Disable2FARequest) (*auth.Disable2FAResponse, error) {
+	userID := ctx.Value("user_id").(int32)
+
+	if req.Code == "" {
+		return nil, status.Error(codes.InvalidArgument, "2FA code is required")
+	}
+
+	if err := c.authService.Disable2FA(ctx, userID, req.Code); err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	return &auth.Disable2FAResponse{Success: true}, nil
+}
+
+// RegenerateBackupCodes replaces the user's backup codes
+func (c *AuthController) RegenerateBackupCodes(ctx context.Context, req *auth.RegenerateBackupCodesRequest) (*auth.RegenerateBackupCodesResponse, error) {
+	userID := ctx.Value("user_id").(int32)
+
+	if req.Code == "" {
+		return nil, status.Error(codes.InvalidArgument, "2FA code is required")
+	}
+
+	backupCodes, err := c.authService.RegenerateBackupCodes(ctx, userID, req.Code)
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	return &auth.RegenerateBackupCodesResponse{BackupCodes: backupCodes}, nil
+}
+
+// Reverify lets the session perform sensitive actions for a while
+func (c *AuthController) Reverify(ctx context.Context, req *auth.ReverifyRequest) (*auth.ReverifyResponse, error) {
+	userID := ctx.Value("user_id").(int32)
+	sessionID, _ := ctx.Value("session_id").(int32)
+
+	if req.Password == "" && req.Code == "" {
+		return nil, status.Error(codes.InvalidArgument, "password or 2FA code is required")
+	}
+
+	if err := c.authService.Reverify(ctx, userID, sessionID, req.Password, req.Code); err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	return &auth.ReverifyResponse{Success: true}, nil
+}
+
+// sessionToProto converts a session to auth.Session
+func sessionToProto(session repo.Session, currentSessionID int32) *auth.Session {
+	return &auth.Session{
+		Id:         session.ID,
+		DeviceName: session.DeviceName.String,
+		IpAddress:  session.IpAddress.String,
+		UserAgent:  session.UserAgent.String,
+		CreatedAt:  session.CreatedAt.Time.Unix(),
+		LastUsedAt: session.LastUsedAt.Time.Unix(),
+		IsCurrent:  session.ID == currentSessionID,
+	}
+}
+
+// ListSessions lists the devices the user is signed in on
+func (c *AuthController) ListSessions(ctx context.Context, req *auth.ListSessionsRequest) (*auth.ListSessionsResponse, error) {
+	userID := ctx.Value("user_id").(int32)
+	sessionID, _ := ctx.Value("session_id").(int32)
+
+	sessions, err := c.authService.ListSessions(ctx, userID)
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	pbSessions := make([]*auth.Session, len(sessions))
+	for i, session := range sessions {
+		pbSessions[i] = sessionToProto(session, sessionID)
+	}
+
+	return &auth.ListSessionsResponse{Sessions: pbSessions}, nil
+}
+
+// RevokeSession signs one device out
+func (c *AuthController) RevokeSession(ctx context.Context, req *auth.RevokeSessionRequest) (*auth.RevokeSessionResponse, error) {
+	userID := ctx.Value("user_id").(int32)
+
+	if req.SessionId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "session id is required")
+	}
+
+	if err := c.authService.RevokeSession(ctx, userID, req.SessionId); err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	return &auth.RevokeSessionResponse{Success: true}, nil
+}
+
+// RevokeAllOtherSessions signs every other device out
+func (c *AuthController) RevokeAllOtherSessions(ctx context.Context, req *auth.RevokeAllOtherSessionsRequest) (*auth.RevokeAllOtherSessionsResponse, error) {
+	userID := ctx.Value("user_id").(int32)
+	sessionID, _ := ctx.Value("session_id").(int32)
+
+	revoked, err := c.authService.RevokeAllOtherSessions(ctx, userID, sessionID)
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	return &auth.RevokeAllOtherSessionsResponse{
+		RevokedCount: int32(revoked),
+		Success:      true,
 	}, nil
 }
+
+// GetJWKS returns the public keys tokens are verified with
+func (c *AuthController) GetJWKS(ctx context.Context, req *auth.GetJWKSRequest) (*auth.GetJWKSResponse, error) {
+	jwks := c.authService.JWKS()
+
+	keys := make([]*auth.JSONWebKey, len(jwks))
+	for i, jwk := range jwks {
+		keys[i] = &auth.JSONWebKey{
+			Kty: jwk.Kty,
+			Kid: jwk.Kid,
+			Alg: jwk.Alg,
+			Use: jwk.Use,
+			Crv: jwk.Crv,
+			X:   jwk.X,
+			N:   jwk.N,
+			E:   jwk.E,
+		}
+	}
+
+	return &auth.GetJWKSResponse{Keys: keys}, nil
+}
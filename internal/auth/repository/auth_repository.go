@@ -2,9 +2,12 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"discord/gen/repo"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -22,13 +25,16 @@ func NewAuthRepository(db *pgxpool.Pool) *AuthRepository {
 }
 
 // CreateUser creates a new user in database
-func (r *AuthRepository) CreateUser(ctx context.Context, username, email, password string) error {
-	_, err := r.queries.CreateUser(ctx, repo.CreateUserParams{
+func (r *AuthRepository) CreateUser(ctx context.Context, username, email, password string) (*repo.User, error) {
+	user, err := r.queries.CreateUser(ctx, repo.CreateUserParams{
 		Username: username,
 		Email:    email,
 		Password: password,
 	})
-	return err
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
 }
 
 // GetUserByID retrieves user by ID
@@ -76,6 +82,83 @@ func (r *AuthRepository) UpdatePassword(ctx context.Context, userID int32, passw
 	return err
 }
 
+// ResetPassword sets a new password and revokes every session of the user,
+// signing out whoever might know the old one
+func (r *AuthRepository) ResetPassword(ctx context.Context, userID int32, password string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+	if _, err := qtx.UpdateUserPassword(ctx, repo.UpdateUserPasswordParams{
+		ID:       userID,
+		Password: password,
+	}); err != nil {
+		return err
+	}
+	if err := qtx.RevokeAllUserSessions(ctx, userID); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// GetLocale returns the locale a user chose in their settings, or "" when
+// they never saved any
+func (r *AuthRepository) GetLocale(ctx context.Context, userID int32) (string, error) {
+	settings, err := r.queries.GetUserSettings(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return settings.Locale, nil
+}
+
+// IssueEmailToken stores the hash of a token mailed to a user. Earlier tokens
+// for the same purpose stop working.
+func (r *AuthRepository) IssueEmailToken(ctx context.Context, userID int32, purpose, email, tokenHash string, expiresAt time.Time) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+	if err := qtx.InvalidateUserEmailTokens(ctx, repo.InvalidateUserEmailTokensParams{
+		UserID:  userID,
+		Purpose: purpose,
+	}); err != nil {
+		return err
+	}
+	if _, err := qtx.CreateEmailToken(ctx, repo.CreateEmailTokenParams{
+		UserID:    userID,
+		Purpose:   purpose,
+		Email:     email,
+		TokenHash: tokenHash,
+		ExpiresAt: pgtype.Timestamp{Time: expiresAt, Valid: true},
+	}); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// UseEmailToken uses up a mailed token. It returns pgx.ErrNoRows when the
+// token is unknown, expired, meant for another purpose or was already used.
+func (r *AuthRepository) UseEmailToken(ctx context.Context, tokenHash, purpose string) (repo.EmailToken, error) {
+	return r.queries.UseEmailToken(ctx, repo.UseEmailTokenParams{
+		TokenHash: tokenHash,
+		Purpose:   purpose,
+	})
+}
+
+// MarkVerified marks the email address of a user as verified
+func (r *AuthRepository) MarkVerified(ctx context.Context, userID int32) error {
+	return r.queries.MarkUserVerified(ctx, userID)
+}
+
 // Enable2FA enables or disables 2FA for user
 func (r *AuthRepository) Enable2FA(ctx context.Context, userID int32, enabled bool) error {
 	_, err := r.queries.Enable2FA(ctx, repo.Enable2FAParams{
@@ -91,3 +174,236 @@ func (r *AuthRepository) DeleteUser(ctx context.Context, userID int32) error {
 	_, err := r.queries.SoftDeleteUser(ctx, userID)
 	return err
 }
+
+// CreateSession opens a session for a signed in device together with its
+// first refresh token
+func (r *AuthRepository) CreateSession(ctx context.Context, userID int32, deviceName, ipAddress, userAgent, tokenHash string, expiresAt time.Time) (repo.Session, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return repo.Session{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+	session, err := qtx.CreateSession(ctx, repo.CreateSessionParams{
+		UserID:     userID,
+		DeviceName: optionalText(deviceName),
+		IpAddress:  optionalText(ipAddress),
+		UserAgent:  optionalText(userAgent),
+	})
+	if err != nil {
+		return repo.Session{}, err
+	}
+
+	if _, err := qtx.CreateRefreshToken(ctx, repo.CreateRefreshTokenParams{
+		SessionID: session.ID,
+		TokenHash: tokenHash,
+		ExpiresAt: pgtype.Timestamp{Time: expiresAt, Valid: true},
+	}); err != nil {
+		return repo.Session{}, err
+	}
+
+	return session, tx.Commit(ctx)
+}
+
+// GetSession retrieves a session by ID
+func (r *AuthRepository) GetSession(ctx context.Context, sessionID int32) (repo.Session, error) {
+	return r.queries.GetSession(ctx, sessionID)
+}
+
+// ListActiveSessions retrieves the sessions of a user that were not revoked
+func (r *AuthRepository) ListActiveSessions(ctx context.Context, userID int32) ([]repo.Session, error) {
+	return r.queries.ListActiveUserSessions(ctx, userID)
+}
+
+// TouchSession records that a session was used from ipAddress
+func (r *AuthRepository) TouchSession(ctx context.Context, sessionID int32, ipAddress string) error {
+	return r.queries.TouchSession(ctx, repo.TouchSessionParams{
+		IpAddress: optionalText(ipAddress),
+		ID:        sessionID,
+	})
+}
+
+// RevokeSession revokes a session of a user. It reports whether an active
+// session was revoked.
+func (r *AuthRepository) RevokeSession(ctx context.Context, sessionID, userID int32) (bool, error) {
+	rows, err := r.queries.RevokeSession(ctx, repo.RevokeSessionParams{
+		ID:     sessionID,
+		UserID: userID,
+	})
+	return rows > 0, err
+}
+
+// RevokeOtherSessions revokes every active session of a user except keepID
+func (r *AuthRepository) RevokeOtherSessions(ctx context.Context, userID, keepID int32) (int64, error) {
+	return r.queries.RevokeOtherUserSessions(ctx, repo.RevokeOtherUserSessionsParams{
+		UserID: userID,
+		ID:     keepID,
+	})
+}
+
+// UseRefreshToken uses up a refresh token. It returns pgx.ErrNoRows when the
+// token is unknown or was already used.
+func (r *AuthRepository) UseRefreshToken(ctx context.Context, tokenHash string) (repo.RefreshToken, error) {
+	return r.queries.UseRefreshToken(ctx, tokenHash)
+}
+
+// GetRefreshToken retrieves a refresh token, used or not, by its hash
+func (r *AuthRepository) GetRefreshToken(ctx context.Context, tokenHash string) (repo.RefreshToken, error) {
+	return r.queries.GetRefreshTokenByHash(ctx, tokenHash)
+}
+
+// IssueRefreshToken issues the next refresh token of a session and records
+// that the session was used
+func (r *AuthRepository) IssueRefreshToken(ctx context.Context, sessionID int32, tokenHash string, expiresAt time.Time, ipAddress string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+	if _, err := qtx.CreateRefreshToken(ctx, repo.CreateRefreshTokenParams{
+		SessionID: sessionID,
+		TokenHash: tokenHash,
+		ExpiresAt: pgtype.Timestamp{Time: expiresAt, Valid: true},
+	}); err != nil {
+		return err
+	}
+	if err := qtx.TouchSession(ctx, repo.TouchSessionParams{
+		IpAddress: optionalText(ipAddress),
+		ID:        sessionID,
+	}); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// MarkSessionVerified records that the user just proved who they are in a
+// session
+func (r *AuthRepository) MarkSessionVerified(ctx context.Context, sessionID int32) error {
+	return r.queries.MarkSessionVerified(ctx, sessionID)
+}
+
+// GetTOTP retrieves the TOTP enrolment of a user
+func (r *AuthRepository) GetTOTP(ctx context.Context, userID int32) (repo.UserTotp, error) {
+	return r.queries.GetUserTOTP(ctx, userID)
+}
+
+// StartTOTPEnrolment stores a new, unconfirmed TOTP secret together with a
+// fresh set of backup codes. It returns pgx.ErrNoRows when the user already
+// has a confirmed secret.
+func (r *AuthRepository) StartTOTPEnrolment(ctx context.Context, userID int32, secretCiphertext string, codeHashes []string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+	if _, err := qtx.UpsertUserTOTP(ctx, repo.UpsertUserTOTPParams{
+		UserID:           userID,
+		SecretCiphertext: secretCiphertext,
+	}); err != nil {
+		return err
+	}
+	if err := replaceBackupCodes(ctx, qtx, userID, codeHashes); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// ConfirmTOTP activates a pending TOTP enrolment and turns 2FA on. It reports
+// whether there was an enrolment to confirm.
+func (r *AuthRepository) ConfirmTOTP(ctx context.Context, userID int32) (bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+	rows, err := qtx.ConfirmUserTOTP(ctx, userID)
+	if err != nil || rows == 0 {
+		return false, err
+	}
+	if _, err := qtx.Enable2FA(ctx, repo.Enable2FAParams{
+		ID:           userID,
+		Is2faEnabled: pgtype.Bool{Bool: true, Valid: true},
+	}); err != nil {
+		return false, err
+	}
+	return true, tx.Commit(ctx)
+}
+
+// DisableTOTP turns 2FA off and deletes the TOTP secret and backup codes
+func (r *AuthRepository) DisableTOTP(ctx context.Context, userID int32) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+	if err := qtx.DeleteUserTOTP(ctx, userID); err != nil {
+		return err
+	}
+	if err := qtx.DeleteUserBackupCodes(ctx, userID); err != nil {
+		return err
+	}
+	if _, err := qtx.Enable2FA(ctx, repo.Enable2FAParams{
+		ID:           userID,
+		Is2faEnabled: pgtype.Bool{Bool: false, Valid: true},
+	}); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// UseTOTPStep records the time step of an accepted TOTP code. It reports
+// false when that step, or a later one, was already used.
+func (r *AuthRepository) UseTOTPStep(ctx context.Context, userID int32, step int64) (bool, error) {
+	rows, err := r.queries.UseTOTPStep(ctx, repo.UseTOTPStepParams{
+		Step:   step,
+		UserID: userID,
+	})
+	return rows > 0, err
+}
+
+// UseBackupCode uses up a backup code. It reports false when the code is
+// unknown or was already used.
+func (r *AuthRepository) UseBackupCode(ctx context.Context, userID int32, codeHash string) (bool, error) {
+	rows, err := r.queries.UseBackupCode(ctx, repo.UseBackupCodeParams{
+		UserID:   userID,
+		CodeHash: codeHash,
+	})
+	return rows > 0, err
+}
+
+// ReplaceBackupCodes replaces every backup code of a user
+func (r *AuthRepository) ReplaceBackupCodes(ctx context.Context, userID int32, codeHashes []string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := replaceBackupCodes(ctx, r.queries.WithTx(tx), userID, codeHashes); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func replaceBackupCodes(ctx context.Context, q *repo.Queries, userID int32, codeHashes []string) error {
+	if err := q.DeleteUserBackupCodes(ctx, userID); err != nil {
+		return err
+	}
+	return q.CreateBackupCodes(ctx, repo.CreateBackupCodesParams{
+		UserID:     userID,
+		CodeHashes: codeHashes,
+	})
+}
+
+func optionalText(s string) pgtype.Text {
+	return pgtype.Text{String: s, Valid: s != ""}
+}
@@ -3,22 +3,60 @@ package service
 import (
 	"context"
 	"errors"
+	"log"
 	"time"
 
 	"discord/gen/proto/schema"
+	"discord/gen/repo"
 	authRepo "discord/internal/auth/repository"
 	"discord/internal/auth/util"
+	commonErrors "discord/internal/common/errors"
+	"discord/pkg/mailer"
+	"discord/pkg/throttle"
 
+	"github.com/jackc/pgx/v5"
 	"golang.org/x/crypto/bcrypt"
 )
 
+// sessionTouchInterval limits how often authenticated requests record the
+// last use of their session
+const sessionTouchInterval = time.Minute
+
+// reverifyWindow is how long after verifying themselves a user may perform
+// sensitive actions without verifying again
+const reverifyWindow = 10 * time.Minute
+
+// backupCodeCount is how many backup codes a user gets at a time
+const backupCodeCount = 10
+
+// notifyTimeout bounds the delivery of security notices, which happens in the
+// background
+const notifyTimeout = 30 * time.Second
+
+// DeviceInfo describes the device a session is opened from
+type DeviceInfo struct {
+	Name      string
+	IPAddress string
+	UserAgent string
+}
+
 type AuthService struct {
 	authRepo *authRepo.AuthRepository
+	keys     *util.KeySet
+	secrets  *util.SecretBox
+	mail     *mailer.Sender
+	throttle *loginThrottle
 }
 
-func NewAuthService(authRepo *authRepo.AuthRepository) *AuthService {
+// NewAuthService creates the AuthService. Failed logins are counted in
+// loginStore, which replicas should share.
+func NewAuthService(authRepo *authRepo.AuthRepository, keys *util.KeySet, secrets *util.SecretBox, mail *mailer.Sender, loginStore throttle.Store) *AuthService {
 	return &AuthService{
 		authRepo: authRepo,
+		keys:     keys,
+		secrets:  secrets,
+		mail:     mail,
+		throttle: newLoginThrottle(loginStore),
 	}
 }
 
@@ -47,40 +85,117 @@ func (s *AuthService) Register(ctx context.Context, username, email, password st
 	}
 
 	// Create user
-	return s.authRepo.CreateUser(ctx, username, email, string(hashedPassword))
+	user, err := s.authRepo.CreateUser(ctx, username, email, string(hashedPassword))
+	if err != nil {
+		return err
+	}
+
+	// The account works without a verified address, and the mail can be
+	// requested again
+	if err := s.sendVerificationEmail(ctx, user); err != nil {
+		log.Printf("failed to send verification email to user %d: %v", user.ID, err)
+	}
+	return nil
 }
 
-// Login authenticates user and returns tokens
-func (s *AuthService) Login(ctx context.Context, username, password string) (string, string, *schema.User, error) {
+// LoginResult is the outcome of a login. With 2FA enabled the password alone
+// only earns an MFA ticket, to be traded in LoginMFA for the tokens.
+type LoginResult struct {
+	AccessToken  string
+	RefreshToken string
+	User         *schema.User
+	MFATicket    string
+}
+
+// Login authenticates user and, unless 2FA is enabled, opens a session for
+// the device and returns its tokens
+func (s *AuthService) Login(ctx context.Context, username, password string, device DeviceInfo) (*LoginResult, error) {
 	// Get user by username or email
 	user, err := s.authRepo.GetUserByUsername(ctx, username)
 	if err != nil {
 		user, err = s.authRepo.GetUserByEmail(ctx, username)
 		if err != nil {
-			return "", "", nil, errors.New("invalid credentials")
+			user = nil
 		}
 	}
 
-	// Verify password
-	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password))
+	attempt, err := s.reserveLogin(ctx, device.IPAddress, accountKey(user, username))
 	if err != nil {
-		return "", "", nil, errors.New("invalid credentials")
+		return nil, err
 	}
 
-	// Generate tokens
-	accessToken, err := util.GenerateJWT(user.ID, 24*time.Hour)
+	// Verify password, taking as long for unknown users
+	passwordHash := dummyPasswordHash()
+	if user != nil {
+		passwordHash = []byte(user.Password)
+	}
+	err = bcrypt.CompareHashAndPassword(passwordHash, []byte(password))
+	if err != nil || user == nil {
+		s.loginFailed(attempt, user)
+		return nil, commonErrors.ErrInvalidCredentials
+	}
+
+	if user.Is2faEnabled.Bool {
+		// The second factor is throttled on its own attempt
+		s.releaseLogin(ctx, attempt)
+		ticket, err := s.keys.Issue(util.Claims{UserID: user.ID, Type: util.TokenTypeMFATicket}, util.MFATicketDuration)
+		if err != nil {
+			return nil, err
+		}
+		return &LoginResult{MFATicket: ticket}, nil
+	}
+
+	return s.completeLogin(ctx, user, device, attempt)
+}
+
+// LoginMFA finishes a login of a user with 2FA enabled, given the ticket from
+// Login and a TOTP or backup code
+func (s *AuthService) LoginMFA(ctx context.Context, ticket, code string, device DeviceInfo) (*LoginResult, error) {
+	claims, err := s.keys.Parse(ticket, util.TokenTypeMFATicket)
 	if err != nil {
-		return "", "", nil, err
+		return nil, commonErrors.ErrInvalidToken
+	}
+
+	user, err := s.authRepo.GetUserByID(ctx, claims.UserID)
+	if err != nil || !user.Is2faEnabled.Bool {
+		return nil, commonErrors.ErrInvalidToken
 	}
 
-	refreshToken, err := util.GenerateJWT(user.ID, 30*24*time.Hour)
+	// Wrong codes count like wrong passwords
+	attempt, err := s.reserveLogin(ctx, device.IPAddress, accountKey(user, ""))
 	if err != nil {
-		return "", "", nil, err
+		return nil, err
+	}
+	if err := s.verifySecondFactor(ctx, user.ID, code, true); err != nil {
+		if errors.Is(err, commonErrors.ErrInvalidCredentials) {
+			s.loginFailed(attempt, user)
+		} else {
+			s.releaseLogin(ctx, attempt)
+		}
+		return nil, err
 	}
 
+	return s.completeLogin(ctx, user, device, attempt)
+}
+
+// completeLogin opens a session for a user who passed every login check
+func (s *AuthService) completeLogin(ctx context.Context, user *repo.User, device DeviceInfo, attempt *loginAttempt) (*LoginResult, error) {
+	accessToken, refreshToken, err := s.openSession(ctx, user.ID, device)
+	if err != nil {
+		s.releaseLogin(ctx, attempt)
+		return nil, err
+	}
+	s.loginSucceeded(ctx, attempt)
+
 	// Update user status to online
 	s.authRepo.UpdateUserStatus(ctx, user.ID, "online")
 
+	s.notify(user, mailer.TemplateNewLogin, mailer.Data{
+		Device:    device.Name,
+		IPAddress: device.IPAddress,
+		Time:      time.Now(),
+	})
+
 	// Convert to proto user
 	protoUser := &schema.User{
 		Id:              user.ID,
@@ -92,7 +207,7 @@ func (s *AuthService) Login(ctx context.Context, username, password string) (str
 		ColorCode:       user.ColorCode.String,
 		BackgroundColor: user.BackgroundColor.String,
 		BackgroundPic:   user.BackgroundPic.String,
-		Status:          user.Status,
+		Status:          "online",
 		CustomStatus:    user.CustomStatus.String,
 		IsBot:           user.IsBot.Bool,
 		IsVerified:      user.IsVerified.Bool,
@@ -101,36 +216,94 @@ func (s *AuthService) Login(ctx context.Context, username, password string) (str
 		UpdatedAt:       user.UpdatedAt.Time.Unix(),
 	}
 
-	return accessToken, refreshToken, protoUser, nil
+	return &LoginResult{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		User:         protoUser,
+	}, nil
 }
 
-// Logout invalidates user tokens
+// openSession creates a session for a device and issues its first tokens
+func (s *AuthService) openSession(ctx context.Context, userID int32, device DeviceInfo) (string, string, error) {
+	refreshToken, tokenHash, err := util.GenerateRefreshToken()
+	if err != nil {
+		return "", "", err
+	}
+
+	session, err := s.authRepo.CreateSession(ctx, userID, device.Name, device.IPAddress, device.UserAgent, tokenHash, time.Now().Add(util.RefreshTokenDuration))
+	if err != nil {
+		return "", "", err
+	}
+
+	accessToken, err := s.keys.IssueAccessToken(userID, session.ID, util.AccessTokenDuration)
+	if err != nil {
+		return "", "", err
+	}
+	return accessToken, refreshToken, nil
+}
+
+// Logout revokes the session of the access token. The user goes offline once
+// no other session is left.
 func (s *AuthService) Logout(ctx context.Context, accessToken string) error {
-	// Extract user ID from token
-	userID, err := util.ValidateJWT(accessToken)
+	claims, err := s.keys.ParseAccessToken(accessToken)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.authRepo.RevokeSession(ctx, claims.SessionID, claims.UserID); err != nil {
+		return err
+	}
+
+	sessions, err := s.authRepo.ListActiveSessions(ctx, claims.UserID)
 	if err != nil {
 		return err
 	}
+	if len(sessions) > 0 {
+		return nil
+	}
 
 	// Update user status to offline
-	return s.authRepo.UpdateUserStatus(ctx, userID, "offline")
+	return s.authRepo.UpdateUserStatus(ctx, claims.UserID, "offline")
 }
 
-// RefreshToken generates new access token
-func (s *AuthService) RefreshToken(ctx context.Context, refreshToken string) (string, string, error) {
-	// Validate refresh token
-	userID, err := util.ValidateJWT(refreshToken)
+// RefreshToken uses up a refresh token and issues a new access and refresh
+// token for the same session. Presenting a refresh token that was already used
+// means it leaked, so the whole session is revoked.
+func (s *AuthService) RefreshToken(ctx context.Context, refreshToken, ipAddress string) (string, string, error) {
+	tokenHash := util.HashRefreshToken(refreshToken)
+
+	token, err := s.authRepo.UseRefreshToken(ctx, tokenHash)
+	if errors.Is(err, pgx.ErrNoRows) {
+		if used, err := s.authRepo.GetRefreshToken(ctx, tokenHash); err == nil {
+			if session, err := s.authRepo.GetSession(ctx, used.SessionID); err == nil {
+				if _, err := s.authRepo.RevokeSession(ctx, session.ID, session.UserID); err != nil {
+					return "", "", err
+				}
+			}
+		}
+		return "", "", commonErrors.ErrInvalidToken
+	}
 	if err != nil {
-		return "", "", errors.New("invalid refresh token")
+		return "", "", err
+	}
+
+	if time.Now().After(token.ExpiresAt.Time) {
+		return "", "", commonErrors.ErrTokenExpired
+	}
+	session, err := s.authRepo.GetSession(ctx, token.SessionID)
+	if err != nil || session.RevokedAt.Valid {
+		return "", "", commonErrors.ErrInvalidToken
 	}
 
-	// Generate new tokens
-	newAccessToken, err := util.GenerateJWT(userID, 24*time.Hour)
+	newRefreshToken, newTokenHash, err := util.GenerateRefreshToken()
 	if err != nil {
 		return "", "", err
 	}
+	if err := s.authRepo.IssueRefreshToken(ctx, session.ID, newTokenHash, time.Now().Add(util.RefreshTokenDuration), ipAddress); err != nil {
+		return "", "", err
+	}
 
-	newRefreshToken, err := util.GenerateJWT(userID, 30*24*time.Hour)
+	newAccessToken, err := s.keys.IssueAccessToken(session.UserID, session.ID, util.AccessTokenDuration)
 	if err != nil {
 		return "", "", err
 	}
@@ -138,15 +311,96 @@ func (s *AuthService) RefreshToken(ctx context.Context, refreshToken string) (st
 	return newAccessToken, newRefreshToken, nil
 }
 
-// VerifyEmail verifies user email address
-func (s *AuthService) VerifyEmail(ctx context.Context, token string) error {
-	// TODO: Implement email verification logic
-	// 1. Decode token
-	// 2. Extract user ID
-	// 3. Update user verified status
+// ValidateAccessToken returns the user and session of an access token as long
+// as the session was not revoked
+func (s *AuthService) ValidateAccessToken(ctx context.Context, accessToken string) (int32, int32, error) {
+	claims, err := s.keys.ParseAccessToken(accessToken)
+	if err != nil {
+		return 0, 0, commonErrors.ErrInvalidToken
+	}
+
+	session, err := s.authRepo.GetSession(ctx, claims.SessionID)
+	if err != nil || session.RevokedAt.Valid || session.UserID != claims.UserID {
+		return 0, 0, commonErrors.ErrInvalidToken
+	}
+
+	if time.Since(session.LastUsedAt.Time) > sessionTouchInterval {
+		_ = s.authRepo.TouchSession(ctx, session.ID, "")
+	}
+
+	return claims.UserID, claims.SessionID, nil
+}
+
+// JWKS returns the public keys tokens are verified with
+func (s *AuthService) JWKS() []util.JSONWebKey {
+	return s.keys.JWKS()
+}
+
+// ListSessions returns the active sessions of a user, most recently used first
+func (s *AuthService) ListSessions(ctx context.Context, userID int32) ([]repo.Session, error) {
+	return s.authRepo.ListActiveSessions(ctx, userID)
+}
+
+// RevokeSession signs one of the user's devices out
+func (s *AuthService) RevokeSession(ctx context.Context, userID, sessionID int32) error {
+	revoked, err := s.authRepo.RevokeSession(ctx, sessionID, userID)
+	if err != nil {
+		return err
+	}
+	if !revoked {
+		return commonErrors.ErrNotFound
+	}
 	return nil
 }
 
+// RevokeAllOtherSessions signs every device of the user out except the one of
+// currentSessionID and returns how many sessions were revoked
+func (s *AuthService) RevokeAllOtherSessions(ctx context.Context, userID, currentSessionID int32) (int64, error) {
+	return s.authRepo.RevokeOtherSessions(ctx, userID, currentSessionID)
+}
+
+// SendVerificationEmail mails the user a new link to verify their address.
+// Earlier links stop working.
+func (s *AuthService) SendVerificationEmail(ctx context.Context, userID int32) error {
+	user, err := s.authRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return commonErrors.ErrNotFound
+	}
+	if user.IsVerified.Bool {
+		return commonErrors.ErrDuplicate
+	}
+	return s.sendVerificationEmail(ctx, user)
+}
+
+func (s *AuthService) sendVerificationEmail(ctx context.Context, user *repo.User) error {
+	token, tokenHash, err := util.GenerateEmailToken()
+	if err != nil {
+		return err
+	}
+	if err := s.authRepo.IssueEmailToken(ctx, user.ID, util.EmailTokenVerify, user.Email, tokenHash, time.Now().Add(util.EmailVerifyTokenDuration)); err != nil {
+		return err
+	}
+	return s.sendEmail(ctx, user, mailer.TemplateVerifyEmail, mailer.Data{
+		Link: s.mail.Link("verify-email", token),
+	})
+}
+
+// VerifyEmail verifies user email address. The token only counts for the
+// address it was sent to.
+func (s *AuthService) VerifyEmail(ctx context.Context, token string) error {
+	emailToken, err := s.authRepo.UseEmailToken(ctx, util.HashEmailToken(token), util.EmailTokenVerify)
+	if err != nil {
+		return commonErrors.ErrInvalidToken
+	}
+
+	user, err := s.authRepo.GetUserByID(ctx, emailToken.UserID)
+	if err != nil || user.Email != emailToken.Email {
+		return commonErrors.ErrInvalidToken
+	}
+
+	return s.authRepo.MarkVerified(ctx, user.ID)
+}
+
 // ForgotPassword sends password reset email
 func (s *AuthService) ForgotPassword(ctx context.Context, email string) error {
 	// Get user by email
@@ -157,23 +411,31 @@ func (s *AuthService) ForgotPassword(ctx context.Context, email string) error {
 	}
 
 	// Generate reset token
-	resetToken, err := util.GenerateJWT(user.ID, 1*time.Hour)
+	token, tokenHash, err := util.GenerateEmailToken()
 	if err != nil {
 		return err
 	}
+	if err := s.authRepo.IssueEmailToken(ctx, user.ID, util.EmailTokenPasswordReset, user.Email, tokenHash, time.Now().Add(util.PasswordResetTokenDuration)); err != nil {
+		return err
+	}
 
-	// TODO: Send email with reset token
-	_ = resetToken
-
-	return nil
+	return s.sendEmail(ctx, user, mailer.TemplatePasswordReset, mailer.Data{
+		Link: s.mail.Link("reset-password", token),
+	})
 }
 
-// ResetPassword resets user password using token
+// ResetPassword resets user password using token and signs the user out on
+// every device
 func (s *AuthService) ResetPassword(ctx context.Context, token, newPassword string) error {
+	// Check the password first, a rejected one must not use up the token
+	if err := util.ValidatePassword(newPassword); err != nil {
+		return err
+	}
+
 	// Validate token
-	userID, err := util.ValidateJWT(token)
+	emailToken, err := s.authRepo.UseEmailToken(ctx, util.HashEmailToken(token), util.EmailTokenPasswordReset)
 	if err != nil {
-		return errors.New("invalid or expired token")
+		return commonErrors.ErrInvalidToken
 	}
 
 	// Hash new password
@@ -183,7 +445,10 @@ func (s *AuthService) ResetPassword(ctx context.Context, token, newPassword stri
 	}
 
 	// Update password
-	return s.authRepo.UpdatePassword(ctx, userID, string(hashedPassword))
+	if err := s.authRepo.ResetPassword(ctx, emailToken.UserID, string(hashedPassword)); err != nil {
+		return err
+	}
+	return s.authRepo.UpdateUserStatus(ctx, emailToken.UserID, "offline")
 }
 
 // ChangePassword changes user password
@@ -195,10 +460,18 @@ func (s *AuthService) ChangePassword(ctx context.Context, userID int32, oldPassw
 	}
 
 	// Verify old password
-	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(oldPassword))
-	if err != nil {
+	err = s.checkCredential(ctx, user, func() error {
+		if bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(oldPassword)) != nil {
+			return commonErrors.ErrInvalidCredentials
+		}
+		return nil
+	})
+	if errors.Is(err, commonErrors.ErrInvalidCredentials) {
 		return errors.New("incorrect old password")
 	}
+	if err != nil {
+		return err
+	}
 
 	// Hash new password
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
@@ -210,32 +483,240 @@ func (s *AuthService) ChangePassword(ctx context.Context, userID int32, oldPassw
 	return s.authRepo.UpdatePassword(ctx, userID, string(hashedPassword))
 }
 
-// Enable2FA enables two-factor authentication
+// Enable2FA starts a 2FA enrolment with a new secret and backup codes. 2FA
+// only turns on once Verify2FA confirms a code generated from the secret.
 func (s *AuthService) Enable2FA(ctx context.Context, userID int32) (string, string, []string, error) {
+	user, err := s.authRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return "", "", nil, commonErrors.ErrNotFound
+	}
+	if user.Is2faEnabled.Bool {
+		return "", "", nil, commonErrors.ErrDuplicate
+	}
+
 	// Generate 2FA secret
 	secret := util.Generate2FASecret()
+	secretCiphertext, err := s.secrets.Seal(secret)
+	if err != nil {
+		return "", "", nil, err
+	}
 
 	// Generate QR code
-	qrCode := util.Generate2FAQRCode(secret, "Discord")
+	qrCode := util.Generate2FAQRCode(secret, "Discord", user.Username)
 
 	// Generate backup codes
-	backupCodes := util.GenerateBackupCodes(10)
+	backupCodes, codeHashes, err := generateBackupCodes()
+	if err != nil {
+		return "", "", nil, err
+	}
 
-	// TODO: Store secret and backup codes in database
+	err = s.authRepo.StartTOTPEnrolment(ctx, userID, secretCiphertext, codeHashes)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", "", nil, commonErrors.ErrDuplicate
+	}
+	if err != nil {
+		return "", "", nil, err
+	}
 
 	return secret, qrCode, backupCodes, nil
 }
 
-// Verify2FA verifies 2FA code
+// Verify2FA checks a TOTP code of the user. The first valid code confirms a
+// pending enrolment and turns 2FA on.
 func (s *AuthService) Verify2FA(ctx context.Context, userID int32, code string) (bool, error) {
-	// TODO: Get user's 2FA secret from database
-	// Verify code against secret
-	valid := util.Verify2FACode("secret", code)
+	user, err := s.authRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return false, commonErrors.ErrNotFound
+	}
+
+	err = s.checkCredential(ctx, user, func() error {
+		return s.verifySecondFactor(ctx, userID, code, false)
+	})
+	if errors.Is(err, commonErrors.ErrInvalidCredentials) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	confirmed, err := s.authRepo.ConfirmTOTP(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	if confirmed {
+		s.notify(user, mailer.Template2FAEnabled, mailer.Data{Time: time.Now()})
+	}
+	return true, nil
+}
+
+// Disable2FA turns 2FA off after checking a TOTP or backup code
+func (s *AuthService) Disable2FA(ctx context.Context, userID int32, code string) error {
+	user, err := s.authRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return commonErrors.ErrNotFound
+	}
+	if !user.Is2faEnabled.Bool {
+		return commonErrors.ErrNotFound
+	}
+
+	err = s.checkCredential(ctx, user, func() error {
+		return s.verifySecondFactor(ctx, userID, code, true)
+	})
+	if err != nil {
+		return err
+	}
+	if err := s.authRepo.DisableTOTP(ctx, userID); err != nil {
+		return err
+	}
+
+	s.notify(user, mailer.Template2FADisabled, mailer.Data{Time: time.Now()})
+	return nil
+}
+
+// RegenerateBackupCodes replaces the backup codes of a user with 2FA enabled
+// after checking a TOTP code
+func (s *AuthService) RegenerateBackupCodes(ctx context.Context, userID int32, code string) ([]string, error) {
+	user, err := s.authRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, commonErrors.ErrNotFound
+	}
+	if !user.Is2faEnabled.Bool {
+		return nil, commonErrors.ErrNotFound
+	}
+
+	err = s.checkCredential(ctx, user, func() error {
+		return s.verifySecondFactor(ctx, userID, code, false)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	backupCodes, codeHashes, err := generateBackupCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authRepo.ReplaceBackupCodes(ctx, userID, codeHashes); err != nil {
+		return nil, err
+	}
+	return backupCodes, nil
+}
 
-	if valid {
-		// Enable 2FA for user
-		s.authRepo.Enable2FA(ctx, userID, true)
+// Reverify marks a session as recently verified so it may perform sensitive
+// actions. Users with 2FA enabled prove themselves with a TOTP or backup code,
+// everyone else with their password.
+func (s *AuthService) Reverify(ctx context.Context, userID, sessionID int32, password, code string) error {
+	user, err := s.authRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return commonErrors.ErrNotFound
 	}
 
-	return valid, nil
+	err = s.checkCredential(ctx, user, func() error {
+		if user.Is2faEnabled.Bool {
+			return s.verifySecondFactor(ctx, userID, code, true)
+		}
+		if bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)) != nil {
+			return commonErrors.ErrInvalidCredentials
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.authRepo.MarkSessionVerified(ctx, sessionID)
+}
+
+// RequireRecentVerification fails with ErrReverifyRequired unless the session
+// was verified within reverifyWindow
+func (s *AuthService) RequireRecentVerification(ctx context.Context, sessionID int32) error {
+	session, err := s.authRepo.GetSession(ctx, sessionID)
+	if err != nil {
+		return commonErrors.ErrInvalidToken
+	}
+	if time.Since(session.VerifiedAt.Time) > reverifyWindow {
+		return commonErrors.ErrReverifyRequired
+	}
+	return nil
+}
+
+// verifySecondFactor checks a TOTP code, or a backup code if allowBackupCode
+// is set, and uses it up. Callers check that 2FA is enabled unless they are
+// confirming a pending enrolment.
+func (s *AuthService) verifySecondFactor(ctx context.Context, userID int32, code string, allowBackupCode bool) error {
+	if !util.IsTOTPCode(code) {
+		if !allowBackupCode {
+			return commonErrors.ErrInvalidCredentials
+		}
+		used, err := s.authRepo.UseBackupCode(ctx, userID, util.HashBackupCode(code))
+		if err != nil {
+			return err
+		}
+		if !used {
+			return commonErrors.ErrInvalidCredentials
+		}
+		return nil
+	}
+
+	enrolment, err := s.authRepo.GetTOTP(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return commonErrors.ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	secret, err := s.secrets.Open(enrolment.SecretCiphertext)
+	if err != nil {
+		return err
+	}
+	step, valid := util.ValidateTOTP(secret, code, time.Now())
+	if !valid {
+		return commonErrors.ErrInvalidCredentials
+	}
+
+	// A code is accepted once, even within its time step
+	used, err := s.authRepo.UseTOTPStep(ctx, userID, step)
+	if err != nil {
+		return err
+	}
+	if !used {
+		return commonErrors.ErrInvalidCredentials
+	}
+	return nil
+}
+
+// generateBackupCodes returns a new set of backup codes and their hashes
+func generateBackupCodes() ([]string, []string, error) {
+	backupCodes, err := util.GenerateBackupCodes(backupCodeCount)
+	if err != nil {
+		return nil, nil, err
+	}
+	codeHashes := make([]string, len(backupCodes))
+	for i, code := range backupCodes {
+		codeHashes[i] = util.HashBackupCode(code)
+	}
+	return backupCodes, codeHashes, nil
+}
+
+// sendEmail renders an email in the locale of the user and mails it to them
+func (s *AuthService) sendEmail(ctx context.Context, user *repo.User, template string, data mailer.Data) error {
+	locale, err := s.authRepo.GetLocale(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+	data.Username = user.Username
+	return s.mail.Send(ctx, user.Email, locale, template, data)
+}
+
+// notify mails a security notice in the background so the request does not
+// wait for delivery. Failures are only logged.
+func (s *AuthService) notify(user *repo.User, template string, data mailer.Data) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), notifyTimeout)
+		defer cancel()
+
+		if err := s.sendEmail(ctx, user, template, data); err != nil {
+			log.Printf("failed to send %s email to user %d: %v", template, user.ID, err)
+		}
+	}()
 }
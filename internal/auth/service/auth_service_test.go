@@ -0,0 +1,14 @@
+package service
+
+import (
+	"context"
+	"testing"
+)
+
+func TestResetPasswordRejectsShortPassword(t *testing.T) {
+	// Rejected before the token is looked up, so no repository is needed
+	s := &AuthService{}
+	if err := s.ResetPassword(context.Background(), "token", "short"); err == nil {
+		t.Error("expected a password under 8 characters to be rejected")
+	}
+}
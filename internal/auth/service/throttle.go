@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"discord/gen/repo"
+	commonErrors "discord/internal/common/errors"
+	"discord/pkg/mailer"
+	"discord/pkg/throttle"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// accountLoginPolicy throttles failed logins of one account. Unknown
+// usernames are throttled alike so they cannot be told apart.
+var accountLoginPolicy = throttle.Policy{
+	FreeFailures:    3,
+	BaseDelay:       time.Second,
+	MaxDelay:        5 * time.Minute,
+	LockoutFailures: 10,
+	LockoutDuration: 15 * time.Minute,
+	Window:          time.Hour,
+}
+
+// ipLoginPolicy throttles failed logins from one address, whichever accounts
+// they target. It is looser since many users may share an address.
+var ipLoginPolicy = throttle.Policy{
+	FreeFailures:    10,
+	BaseDelay:       time.Second,
+	MaxDelay:        5 * time.Minute,
+	LockoutFailures: 50,
+	LockoutDuration: time.Hour,
+	Window:          2 * time.Hour,
+}
+
+// ThrottledError is returned while earlier failed logins hold back the next
+// attempt. It matches commonErrors.ErrRateLimitExceeded.
+type ThrottledError struct {
+	RetryAfter time.Duration
+}
+
+func (e *ThrottledError) Error() string {
+	return fmt.Sprintf("too many failed login attempts, retry in %ds", e.RetryAfterSeconds())
+}
+
+func (e *ThrottledError) Is(target error) bool {
+	return target == commonErrors.ErrRateLimitExceeded
+}
+
+// RetryAfterSeconds returns RetryAfter rounded up to whole seconds
+func (e *ThrottledError) RetryAfterSeconds() int {
+	return int(math.Ceil(e.RetryAfter.Seconds()))
+}
+
+// loginThrottle counts failed logins per account and per client address
+type loginThrottle struct {
+	accounts *throttle.Limiter
+	ips      *throttle.Limiter
+}
+
+func newLoginThrottle(store throttle.Store) *loginThrottle {
+	return &loginThrottle{
+		accounts: throttle.NewLimiter(store, "login:account:", accountLoginPolicy),
+		ips:      throttle.NewLimiter(store, "login:ip:", ipLoginPolicy),
+	}
+}
+
+// dummyPasswordHash is compared against for unknown users, so a login takes
+// as long whether or not the account exists
+var dummyPasswordHash = sync.OnceValue(func() []byte {
+	hash, err := bcrypt.GenerateFromPassword([]byte("not a real password"), bcrypt.DefaultCost)
+	if err != nil {
+		panic(err)
+	}
+	return hash
+})
+
+// accountKey identifies an account for throttling. Unknown users are keyed
+// by the name they were looked up with.
+func accountKey(user *repo.User, identifier string) string {
+	if user != nil {
+		return strconv.Itoa(int(user.ID))
+	}
+	return "name:" + strings.ToLower(strings.TrimSpace(identifier))
+}
+
+// loginAttempt is a login counted against the address and the account
+// before it is checked. Locked reports whether its failure locks the account.
+type loginAttempt struct {
+	ipAddress string
+	account   string
+	locked    bool
+}
+
+// reserveLogin counts a login attempt of the address and the account as
+// failed up front, so parallel guesses cannot all pass before the first
+// failure is recorded. It fails with a ThrottledError while either has to
+// wait before its next attempt.
+func (s *AuthService) reserveLogin(ctx context.Context, ipAddress, account string) (*loginAttempt, error) {
+	attempt := &loginAttempt{}
+	if ipAddress != "" {
+		r, err := s.throttle.ips.Reserve(ctx, ipAddress)
+		if err != nil {
+			return nil, err
+		}
+		if r.Wait > 0 {
+			return nil, &ThrottledError{RetryAfter: r.Wait}
+		}
+		attempt.ipAddress = ipAddress
+	}
+
+	r, err := s.throttle.accounts.Reserve(ctx, account)
+	if err == nil && r.Wait > 0 {
+		err = &ThrottledError{RetryAfter: r.Wait}
+	}
+	if err != nil {
+		s.releaseLogin(ctx, attempt)
+		return nil, err
+	}
+	attempt.account = account
+	attempt.locked = r.Locked
+	return attempt, nil
+}
+
+// releaseLogin takes back a login attempt that did not fail
+func (s *AuthService) releaseLogin(ctx context.Context, attempt *loginAttempt) {
+	if attempt.ipAddress != "" {
+		if err := s.throttle.ips.Release(ctx, attempt.ipAddress); err != nil {
+			log.Printf("failed to release login attempt from %s: %v", attempt.ipAddress, err)
+		}
+	}
+	if attempt.account != "" {
+		if err := s.throttle.accounts.Release(ctx, attempt.account); err != nil {
+			log.Printf("failed to release login attempt of account %s: %v", attempt.account, err)
+		}
+	}
+}
+
+// loginFailed leaves a failed login counted. When it locks a known account
+// out, the owner is told.
+func (s *AuthService) loginFailed(attempt *loginAttempt, user *repo.User) {
+	if attempt.locked && user != nil {
+		s.notify(user, mailer.TemplateAccountLocked, mailer.Data{
+			IPAddress: attempt.ipAddress,
+			Time:      time.Now(),
+		})
+	}
+}
+
+// loginSucceeded takes back the attempt of the address and forgets the failed
+// logins of the account. Earlier failures of the address stay, or one valid
+// account would reset them.
+func (s *AuthService) loginSucceeded(ctx context.Context, attempt *loginAttempt) {
+	if attempt.ipAddress != "" {
+		if err := s.throttle.ips.Release(ctx, attempt.ipAddress); err != nil {
+			log.Printf("failed to release login attempt from %s: %v", attempt.ipAddress, err)
+		}
+	}
+	if err := s.throttle.accounts.Reset(ctx, attempt.account); err != nil {
+		log.Printf("failed to reset failed logins of account %s: %v", attempt.account, err)
+	}
+}
+
+// checkCredential runs check, which verifies a password or second factor of
+// a signed in user, against the login throttle of their account. Otherwise a
+// stolen access token would allow guessing them without limit.
+func (s *AuthService) checkCredential(ctx context.Context, user *repo.User, check func() error) error {
+	attempt, err := s.reserveLogin(ctx, "", accountKey(user, ""))
+	if err != nil {
+		return err
+	}
+
+	err = check()
+	switch {
+	case err == nil:
+		s.loginSucceeded(ctx, attempt)
+	case errors.Is(err, commonErrors.ErrInvalidCredentials):
+		s.loginFailed(attempt, user)
+	default:
+		s.releaseLogin(ctx, attempt)
+	}
+	return err
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"discord/gen/repo"
+	commonErrors "discord/internal/common/errors"
+	"discord/pkg/throttle"
+)
+
+func TestCheckCredentialThrottles(t *testing.T) {
+	ctx := context.Background()
+	s := &AuthService{throttle: newLoginThrottle(throttle.NewMemoryStore())}
+	user := &repo.User{ID: 1}
+
+	checks := 0
+	wrongCode := func() error {
+		checks++
+		return commonErrors.ErrInvalidCredentials
+	}
+
+	// The free failures and the first delayed one are checked
+	for i := 0; i <= accountLoginPolicy.FreeFailures; i++ {
+		if err := s.checkCredential(ctx, user, wrongCode); !errors.Is(err, commonErrors.ErrInvalidCredentials) {
+			t.Fatalf("attempt %d: expected invalid credentials, got %v", i+1, err)
+		}
+	}
+
+	err := s.checkCredential(ctx, user, wrongCode)
+	var throttled *ThrottledError
+	if !errors.As(err, &throttled) || throttled.RetryAfter <= 0 {
+		t.Fatalf("expected the account to be throttled, got %v", err)
+	}
+	if checks != accountLoginPolicy.FreeFailures+1 {
+		t.Errorf("expected %d checks, got %d", accountLoginPolicy.FreeFailures+1, checks)
+	}
+
+	// Another account is not held back
+	if err := s.checkCredential(ctx, &repo.User{ID: 2}, func() error { return nil }); err != nil {
+		t.Errorf("expected another account to be checked, got %v", err)
+	}
+}
+
+func TestCheckCredentialSuccessResets(t *testing.T) {
+	ctx := context.Background()
+	s := &AuthService{throttle: newLoginThrottle(throttle.NewMemoryStore())}
+	user := &repo.User{ID: 1}
+
+	for i := 0; i < accountLoginPolicy.FreeFailures; i++ {
+		s.checkCredential(ctx, user, func() error { return commonErrors.ErrInvalidCredentials })
+	}
+	if err := s.checkCredential(ctx, user, func() error { return nil }); err != nil {
+		t.Fatal(err)
+	}
+
+	// Failures before the success are forgotten
+	for i := 0; i <= accountLoginPolicy.FreeFailures; i++ {
+		err := s.checkCredential(ctx, user, func() error { return commonErrors.ErrInvalidCredentials })
+		if !errors.Is(err, commonErrors.ErrInvalidCredentials) {
+			t.Fatalf("attempt %d: expected invalid credentials, got %v", i+1, err)
+		}
+	}
+}
+
+func TestCheckCredentialReleasesErrors(t *testing.T) {
+	ctx := context.Background()
+	s := &AuthService{throttle: newLoginThrottle(throttle.NewMemoryStore())}
+	user := &repo.User{ID: 1}
+
+	// Errors other than wrong credentials do not count
+	for i := 0; i < 2*accountLoginPolicy.FreeFailures+2; i++ {
+		if err := s.checkCredential(ctx, user, func() error { return commonErrors.ErrNotFound }); !errors.Is(err, commonErrors.ErrNotFound) {
+			t.Fatalf("attempt %d: expected not found, got %v", i+1, err)
+		}
+	}
+}
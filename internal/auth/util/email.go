@@ -0,0 +1,27 @@
+package util
+
+import "time"
+
+// Purposes of the single use tokens mailed to users
+const (
+	EmailTokenVerify        = "verify_email"
+	EmailTokenPasswordReset = "password_reset"
+)
+
+const (
+	// EmailVerifyTokenDuration is how long an email verification link works
+	EmailVerifyTokenDuration = 24 * time.Hour
+	// PasswordResetTokenDuration is how long a password reset link works
+	PasswordResetTokenDuration = time.Hour
+)
+
+// GenerateEmailToken returns a new opaque token to mail and the hash that is
+// stored for it. The tokens look like refresh tokens.
+func GenerateEmailToken() (string, string, error) {
+	return GenerateRefreshToken()
+}
+
+// HashEmailToken hashes a mailed token for lookup
+func HashEmailToken(token string) string {
+	return HashRefreshToken(token)
+}
@@ -2,88 +2,107 @@ package util
 
 import (
 	"crypto/rand"
-	"encoding/base32"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
-	"github.com/pquerna/otp/totp"
 )
 
-var jwtSecret = []byte("your-secret-key-change-this-in-production")
+// TokenType tells what a token may be used for. A token is only accepted
+// where its type is expected, so an MFA ticket is no bearer token.
+type TokenType string
 
-// Claims represents JWT claims
+const (
+	TokenTypeAccess    TokenType = "access"
+	TokenTypeMFATicket TokenType = "mfa_ticket"
+)
+
+// Audiences of the tokens. Access tokens are for the API, every other token
+// only for the auth service.
+const (
+	AudienceAPI  = "api"
+	AudienceAuth = "auth"
+)
+
+// ScopeUser is the scope of access tokens issued to users
+const ScopeUser = "user"
+
+func (t TokenType) audience() string {
+	if t == TokenTypeAccess {
+		return AudienceAPI
+	}
+	return AudienceAuth
+}
+
+// Claims represents JWT claims. Access tokens carry the id of the session
+// they were issued for.
 type Claims struct {
-	UserID int32 `json:"user_id"`
+	UserID    int32     `json:"user_id"`
+	Type      TokenType `json:"typ"`
+	SessionID int32     `json:"sid,omitempty"`
+	Scope     string    `json:"scope,omitempty"`
 	jwt.RegisteredClaims
 }
 
-// GenerateJWT generates a new JWT token
-func GenerateJWT(userID int32, duration time.Duration) (string, error) {
-	claims := Claims{
-		UserID: userID,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(duration)),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			NotBefore: jwt.NewNumericDate(time.Now()),
-		},
+// Issue signs a token of claims.Type valid for duration
+func (ks *KeySet) Issue(claims Claims, duration time.Duration) (string, error) {
+	now := time.Now()
+	claims.RegisteredClaims = jwt.RegisteredClaims{
+		Issuer:    ks.issuer,
+		Subject:   strconv.Itoa(int(claims.UserID)),
+		Audience:  jwt.ClaimStrings{claims.Type.audience()},
+		ExpiresAt: jwt.NewNumericDate(now.Add(duration)),
+		IssuedAt:  jwt.NewNumericDate(now),
+		NotBefore: jwt.NewNumericDate(now),
+		ID:        GenerateRandomString(16),
 	}
-
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(jwtSecret)
+	return ks.Sign(claims)
 }
 
-// ValidateJWT validates and parses JWT token
-func ValidateJWT(tokenString string) (int32, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return jwtSecret, nil
-	})
+// IssueAccessToken issues an access token for a session
+func (ks *KeySet) IssueAccessToken(userID, sessionID int32, duration time.Duration) (string, error) {
+	return ks.Issue(Claims{
+		UserID:    userID,
+		Type:      TokenTypeAccess,
+		SessionID: sessionID,
+		Scope:     ScopeUser,
+	}, duration)
+}
 
+// Parse validates a token of the expected type and returns its claims
+func (ks *KeySet) Parse(tokenString string, tokenType TokenType) (*Claims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, ks.keyFunc,
+		jwt.WithValidMethods([]string{jwt.SigningMethodEdDSA.Alg(), jwt.SigningMethodRS256.Alg()}),
+		jwt.WithIssuer(ks.issuer),
+		jwt.WithAudience(tokenType.audience()),
+		jwt.WithExpirationRequired(),
+	)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	if claims, ok := token.Claims.(*Claims); ok && token.Valid {
-		return claims.UserID, nil
+	claims, ok := token.Claims.(*Claims)
+	if !ok || !token.Valid {
+		return nil, errors.New("invalid token")
 	}
-
-	return 0, errors.New("invalid token")
+	if claims.Type != tokenType {
+		return nil, fmt.Errorf("expected %s token, got %q", tokenType, claims.Type)
+	}
+	return claims, nil
 }
 
-// Generate2FASecret generates a new TOTP secret
-func Generate2FASecret() string {
-	secret := make([]byte, 20)
-	_, err := rand.Read(secret)
+// ParseAccessToken validates an access token and returns its claims
+func (ks *KeySet) ParseAccessToken(tokenString string) (*Claims, error) {
+	claims, err := ks.Parse(tokenString, TokenTypeAccess)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	return base32.StdEncoding.EncodeToString(secret)
-}
-
-// Generate2FAQRCode generates QR code URL for 2FA setup
-func Generate2FAQRCode(secret, issuer string) string {
-	return fmt.Sprintf("otpauth://totp/%s?secret=%s&issuer=%s", issuer, secret, issuer)
-}
-
-// Verify2FACode verifies TOTP code
-func Verify2FACode(secret, code string) bool {
-	valid := totp.Validate(code, secret)
-	return valid
-}
-
-// GenerateBackupCodes generates backup codes for 2FA
-func GenerateBackupCodes(count int) []string {
-	codes := make([]string, count)
-	for i := 0; i < count; i++ {
-		b := make([]byte, 4)
-		rand.Read(b)
-		codes[i] = fmt.Sprintf("%X-%X", b[:2], b[2:])
+	if claims.SessionID == 0 {
+		return nil, errors.New("token has no session")
 	}
-	return codes
+	return claims, nil
 }
 
 // GenerateRandomString generates a random string of specified length
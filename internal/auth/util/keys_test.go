@@ -0,0 +1,165 @@
+package util
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+)
+
+func newTestKeySet(t *testing.T, ids ...string) *KeySet {
+	t.Helper()
+	keys := make([]*SigningKey, len(ids))
+	for i, id := range ids {
+		key, err := GenerateSigningKey(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys[i] = key
+	}
+	ks, err := NewKeySet("test", keys...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ks
+}
+
+func TestParseAccessToken(t *testing.T) {
+	ks := newTestKeySet(t, "k1")
+
+	token, err := ks.IssueAccessToken(7, 42, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	claims, err := ks.ParseAccessToken(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if claims.UserID != 7 || claims.SessionID != 42 || claims.Scope != ScopeUser {
+		t.Errorf("got %+v, want user 7, session 42 and scope %s", claims, ScopeUser)
+	}
+
+	expired, err := ks.IssueAccessToken(7, 42, -time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ks.ParseAccessToken(expired); err == nil {
+		t.Error("expired token was accepted")
+	}
+}
+
+func TestTokenTypesAreNotInterchangeable(t *testing.T) {
+	ks := newTestKeySet(t, "k1")
+
+	ticket, err := ks.Issue(Claims{UserID: 7, Type: TokenTypeMFATicket}, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ks.ParseAccessToken(ticket); err == nil {
+		t.Error("MFA ticket was accepted as access token")
+	}
+	if _, err := ks.Parse(ticket, TokenTypeMFATicket); err != nil {
+		t.Errorf("MFA ticket was rejected: %v", err)
+	}
+
+	access, err := ks.IssueAccessToken(7, 42, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ks.Parse(access, TokenTypeMFATicket); err == nil {
+		t.Error("access token was accepted as MFA ticket")
+	}
+}
+
+func TestKeyRotation(t *testing.T) {
+	oldKey, err := GenerateSigningKey("old")
+	if err != nil {
+		t.Fatal(err)
+	}
+	newKey, err := GenerateSigningKey("new")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	before, err := NewKeySet("test", oldKey, newKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := before.IssueAccessToken(7, 42, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The new key signs now, tokens of the old key stay valid
+	after, err := NewKeySet("test", newKey, &SigningKey{ID: oldKey.ID, Method: oldKey.Method, public: oldKey.public})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := after.ParseAccessToken(token); err != nil {
+		t.Errorf("token of the retired key was rejected: %v", err)
+	}
+
+	// Once the old key is gone its tokens are rejected
+	retired, err := NewKeySet("test", newKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := retired.ParseAccessToken(token); err == nil {
+		t.Error("token of a removed key was accepted")
+	}
+
+	other := newTestKeySet(t, "new")
+	if _, err := other.ParseAccessToken(token); err == nil {
+		t.Error("token signed by a different key with the same id was accepted")
+	}
+}
+
+func TestRSAKey(t *testing.T) {
+	private, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := ParsePrivateKeyPEM("rsa", pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key.Method.Alg() != "RS256" {
+		t.Errorf("RSA key signs with %s, want RS256", key.Method.Alg())
+	}
+
+	ks, err := NewKeySet("test", key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := ks.IssueAccessToken(7, 42, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ks.ParseAccessToken(token); err != nil {
+		t.Errorf("RS256 token was rejected: %v", err)
+	}
+
+	jwks := ks.JWKS()
+	if len(jwks) != 1 || jwks[0].Kty != "RSA" || jwks[0].Kid != "rsa" || jwks[0].E != "AQAB" {
+		t.Errorf("unexpected JWKS %+v", jwks)
+	}
+}
+
+func TestNewKeySetNeedsSigningKey(t *testing.T) {
+	key, err := GenerateSigningKey("k1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewKeySet("test", &SigningKey{ID: key.ID, Method: key.Method, public: key.public}); err == nil {
+		t.Error("key set without a private signing key was created")
+	}
+	if _, err := NewKeySet("test", key, key); err == nil {
+		t.Error("key set with duplicate key ids was created")
+	}
+}
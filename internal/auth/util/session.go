@@ -0,0 +1,146 @@
+package util
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	// AccessTokenDuration is how long an access token is valid. Revoking a
+	// session takes effect immediately regardless.
+	AccessTokenDuration = 15 * time.Minute
+	// RefreshTokenDuration is how long an unused refresh token is valid
+	RefreshTokenDuration = 30 * 24 * time.Hour
+)
+
+// GenerateRefreshToken returns a new opaque refresh token and the hash that
+// is stored for it
+func GenerateRefreshToken() (string, string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+	return token, HashRefreshToken(token), nil
+}
+
+// HashRefreshToken hashes a refresh token for lookup. The tokens are random,
+// so a plain SHA-256 is enough.
+func HashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// TrustedProxies are the networks of the proxies whose x-forwarded-for
+// header is believed. The zero value trusts no proxy.
+type TrustedProxies struct {
+	nets []*net.IPNet
+}
+
+// ParseTrustedProxies parses proxy addresses, given as single IPs or CIDR
+// ranges
+func ParseTrustedProxies(entries []string) (*TrustedProxies, error) {
+	proxies := &TrustedProxies{}
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid proxy address %q", entry)
+			}
+			bits := 8 * net.IPv6len
+			if ip4 := ip.To4(); ip4 != nil {
+				ip, bits = ip4, 8*net.IPv4len
+			}
+			proxies.nets = append(proxies.nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy range %q: %w", entry, err)
+		}
+		proxies.nets = append(proxies.nets, ipNet)
+	}
+	return proxies, nil
+}
+
+// trusts reports whether an address belongs to a trusted proxy
+func (t *TrustedProxies) trusts(addr string) bool {
+	if t == nil {
+		return false
+	}
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, n := range t.nets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientIP returns the address of the calling client. The x-forwarded-for
+// header is only read when the connection comes from a trusted proxy, and
+// then the last entry not added by a trusted proxy is the client, since
+// anything before it may be forged.
+func (t *TrustedProxies) ClientIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	addr, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		addr = p.Addr.String()
+	}
+	if !t.trusts(addr) {
+		return addr
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return addr
+	}
+	var hops []string
+	for _, header := range md.Get("x-forwarded-for") {
+		hops = append(hops, strings.Split(header, ",")...)
+	}
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if hop == "" {
+			continue
+		}
+		if net.ParseIP(hop) == nil {
+			// Not an address, so whatever came before cannot be trusted
+			return addr
+		}
+		addr = hop
+		if !t.trusts(hop) {
+			break
+		}
+	}
+	return addr
+}
+
+// UserAgent returns the user agent the client sent
+func UserAgent(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if ua := md.Get("user-agent"); len(ua) > 0 {
+		return ua[0]
+	}
+	return ""
+}
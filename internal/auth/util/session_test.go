@@ -0,0 +1,82 @@
+package util
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestGenerateRefreshToken(t *testing.T) {
+	token, hash, err := GenerateRefreshToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hash != HashRefreshToken(token) {
+		t.Error("stored hash does not match the token")
+	}
+	if len(hash) != 64 {
+		t.Errorf("hash has %d characters, want 64", len(hash))
+	}
+
+	other, _, err := GenerateRefreshToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other == token {
+		t.Error("two refresh tokens are equal")
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	proxies, err := ParseTrustedProxies([]string{"10.0.0.0/8", "192.168.1.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		proxies   *TrustedProxies
+		peer      string
+		forwarded []string
+		want      string
+	}{
+		{"direct client", proxies, "203.0.113.7:4000", nil, "203.0.113.7"},
+		{"forged header from a client", proxies, "203.0.113.7:4000", []string{"1.2.3.4"}, "203.0.113.7"},
+		{"no trusted proxies", nil, "10.0.0.2:4000", []string{"1.2.3.4"}, "10.0.0.2"},
+		{"trusted proxy", proxies, "10.0.0.2:4000", []string{"1.2.3.4"}, "1.2.3.4"},
+		{"trusted single address", proxies, "192.168.1.1:4000", []string{"1.2.3.4"}, "1.2.3.4"},
+		{"untrusted single address", proxies, "192.168.1.2:4000", []string{"1.2.3.4"}, "192.168.1.2"},
+		{"forged entry before the client", proxies, "10.0.0.2:4000", []string{"9.9.9.9, 1.2.3.4"}, "1.2.3.4"},
+		{"chain of trusted proxies", proxies, "10.0.0.2:4000", []string{"1.2.3.4, 10.0.0.3"}, "1.2.3.4"},
+		{"repeated headers", proxies, "10.0.0.2:4000", []string{"9.9.9.9", "1.2.3.4"}, "1.2.3.4"},
+		{"only proxies", proxies, "10.0.0.2:4000", []string{"10.0.0.3"}, "10.0.0.3"},
+		{"garbage entry", proxies, "10.0.0.2:4000", []string{"1.2.3.4, unknown"}, "10.0.0.2"},
+		{"trusted proxy without header", proxies, "10.0.0.2:4000", nil, "10.0.0.2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr, err := net.ResolveTCPAddr("tcp", tt.peer)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+			if tt.forwarded != nil {
+				ctx = metadata.NewIncomingContext(ctx, metadata.MD{"x-forwarded-for": tt.forwarded})
+			}
+			if got := tt.proxies.ClientIP(ctx); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestParseTrustedProxies(t *testing.T) {
+	for _, entry := range []string{"proxy", "10.0.0.0/33", ""} {
+		if _, err := ParseTrustedProxies([]string{entry}); err == nil {
+			t.Errorf("expected %q to be rejected", entry)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pquerna/otp/totp"
+)
+
+func TestSecretBox(t *testing.T) {
+	key, err := GenerateSecretBoxKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	box, err := NewSecretBox(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sealed, err := box.Seal("JBSWY3DPEHPK3PXP")
+	if err != nil {
+		t.Fatal(err)
+	}
+	opened, err := box.Open(sealed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opened != "JBSWY3DPEHPK3PXP" {
+		t.Errorf("opened %q", opened)
+	}
+
+	otherKey, _ := GenerateSecretBoxKey()
+	other, _ := NewSecretBox(otherKey)
+	if _, err := other.Open(sealed); err == nil {
+		t.Error("opened with a different key")
+	}
+
+	if _, err := NewSecretBox(key[:16]); err == nil {
+		t.Error("accepted a 16 byte key")
+	}
+}
+
+func TestValidateTOTP(t *testing.T) {
+	secret := Generate2FASecret()
+	now := time.Unix(1700000000, 0)
+	current := now.Unix() / totpPeriod
+
+	tests := []struct {
+		name   string
+		at     time.Time
+		valid  bool
+		offset int64
+	}{
+		{"current step", now, true, 0},
+		{"previous step", now.Add(-totpPeriod * time.Second), true, -1},
+		{"next step", now.Add(totpPeriod * time.Second), true, 1},
+		{"two steps old", now.Add(-2 * totpPeriod * time.Second), false, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := totp.GenerateCodeCustom(secret, tt.at, totpOpts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			step, valid := ValidateTOTP(secret, code, now)
+			if valid != tt.valid {
+				t.Fatalf("valid = %v, want %v", valid, tt.valid)
+			}
+			if valid && step != current+tt.offset {
+				t.Errorf("step = %d, want %d", step, current+tt.offset)
+			}
+		})
+	}
+
+	if _, valid := ValidateTOTP(secret, "12345", now); valid {
+		t.Error("accepted a 5 digit code")
+	}
+}
+
+func TestBackupCodes(t *testing.T) {
+	codes, err := GenerateBackupCodes(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	seen := make(map[string]bool)
+	for _, code := range codes {
+		if len(code) != backupCodeLength+1 || code[backupCodeLength/2] != '-' {
+			t.Errorf("malformed backup code %q", code)
+		}
+		if IsTOTPCode(code) {
+			t.Errorf("backup code %q looks like a TOTP code", code)
+		}
+		if seen[code] {
+			t.Errorf("duplicate backup code %q", code)
+		}
+		seen[code] = true
+	}
+
+	if HashBackupCode("abcde-fghjk") != HashBackupCode("ABCDEFGHJK") {
+		t.Error("hash depends on case or dashes")
+	}
+}
@@ -28,9 +28,12 @@ func (c *ChannelController) CreateChannel(ctx context.Context, req *channelPb.Cr
 	}
 
 	// Basic implementation - using defaults for missing proto fields
+	userID := ctx.Value("user_id").(int32)
+
 	channel, err := c.channelService.CreateChannel(
 		ctx,
 		req.ServerId,
+		userID,
 		req.GetName(),
 		req.GetType(),
 		nil,
@@ -51,7 +54,9 @@ func (c *ChannelController) CreateChannel(ctx context.Context, req *channelPb.Cr
 
 // GetChannel retrieves channel by ID
 func (c *ChannelController) GetChannel(ctx context.Context, req *channelPb.GetChannelRequest) (*channelPb.GetChannelResponse, error) {
-	channel, err := c.channelService.GetChannel(ctx, req.GetChannelId())
+	userID := ctx.Value("user_id").(int32)
+
+	channel, err := c.channelService.GetChannel(ctx, req.GetChannelId(), userID)
 	if err != nil {
 		return nil, commonErrors.ToGRPCError(err)
 	}
@@ -89,7 +94,8 @@ func (c *ChannelController) UpdateChannel(ctx context.Context, req *channelPb.Up
 
 	isNSFW = &req.IsNsfw
 
-	_, err := c.channelService.UpdateChannel(ctx, req.GetChannelId(), name, topic, position, slowmodeDelay, isNSFW)
+	userID := ctx.Value("user_id").(int32)
+	_, err := c.channelService.UpdateChannel(ctx, req.GetChannelId(), userID, name, topic, position, slowmodeDelay, isNSFW)
 	if err != nil {
 		return nil, commonErrors.ToGRPCError(err)
 	}
@@ -101,7 +107,9 @@ func (c *ChannelController) UpdateChannel(ctx context.Context, req *channelPb.Up
 
 // DeleteChannel deletes a channel
 func (c *ChannelController) DeleteChannel(ctx context.Context, req *channelPb.DeleteChannelRequest) (*channelPb.DeleteChannelResponse, error) {
-	err := c.channelService.DeleteChannel(ctx, req.GetChannelId())
+	userID := ctx.Value("user_id").(int32)
+
+	err := c.channelService.DeleteChannel(ctx, req.GetChannelId(), userID)
 	if err != nil {
 		return nil, commonErrors.ToGRPCError(err)
 	}
@@ -113,7 +121,9 @@ func (c *ChannelController) DeleteChannel(ctx context.Context, req *channelPb.De
 
 // GetServerChannels retrieves all channels in a server
 func (c *ChannelController) GetServerChannels(ctx context.Context, req *channelPb.GetServerChannelsRequest) (*channelPb.GetServerChannelsResponse, error) {
-	channels, err := c.channelService.GetServerChannels(ctx, req.GetServerId())
+	userID := ctx.Value("user_id").(int32)
+
+	channels, err := c.channelService.GetServerChannels(ctx, req.GetServerId(), userID)
 	if err != nil {
 		return nil, commonErrors.ToGRPCError(err)
 	}
@@ -137,9 +147,12 @@ func (c *ChannelController) GetServerChannels(ctx context.Context, req *channelP
 
 // CreateCategory creates a new category
 func (c *ChannelController) CreateCategory(ctx context.Context, req *channelPb.CreateCategoryRequest) (*channelPb.CreateCategoryResponse, error) {
+	userID := ctx.Value("user_id").(int32)
+
 	channel, err := c.channelService.CreateChannel(
 		ctx,
 		req.GetServerId(),
+		userID,
 		req.GetName(),
 		"CATEGORY", // Channel type for category
 		nil,
@@ -173,7 +186,8 @@ func (c *ChannelController) UpdateCategory(ctx context.Context, req *channelPb.U
 		position = &p
 	}
 
-	_, err := c.channelService.UpdateChannel(ctx, req.GetCategoryId(), name, nil, position, nil, nil)
+	userID := ctx.Value("user_id").(int32)
+	_, err := c.channelService.UpdateChannel(ctx, req.GetCategoryId(), userID, name, nil, position, nil, nil)
 	if err != nil {
 		return nil, commonErrors.ToGRPCError(err)
 	}
@@ -185,7 +199,9 @@ func (c *ChannelController) UpdateCategory(ctx context.Context, req *channelPb.U
 
 // DeleteCategory deletes a category
 func (c *ChannelController) DeleteCategory(ctx context.Context, req *channelPb.DeleteCategoryRequest) (*channelPb.DeleteCategoryResponse, error) {
-	err := c.channelService.DeleteChannel(ctx, req.GetCategoryId())
+	userID := ctx.Value("user_id").(int32)
+
+	err := c.channelService.DeleteChannel(ctx, req.GetCategoryId(), userID)
 	if err != nil {
 		return nil, commonErrors.ToGRPCError(err)
 	}
@@ -198,7 +214,8 @@ func (c *ChannelController) DeleteCategory(ctx context.Context, req *channelPb.D
 // JoinChannel adds user to a channel (primarily for voice channels)
 func (c *ChannelController) JoinChannel(ctx context.Context, req *channelPb.JoinChannelRequest) (*channelPb.JoinChannelResponse, error) {
 	// Verify channel exists and user has permission to join
-	channel, err := c.channelService.GetChannel(ctx, req.GetChannelId())
+	userID := ctx.Value("user_id").(int32)
+	channel, err := c.channelService.GetChannel(ctx, req.GetChannelId(), userID)
 	if err != nil {
 		return nil, commonErrors.ToGRPCError(err)
 	}
@@ -217,7 +234,8 @@ func (c *ChannelController) JoinChannel(ctx context.Context, req *channelPb.Join
 // LeaveChannel removes user from a channel (primarily for voice channels)
 func (c *ChannelController) LeaveChannel(ctx context.Context, req *channelPb.LeaveChannelRequest) (*channelPb.LeaveChannelResponse, error) {
 	// Verify channel exists
-	_, err := c.channelService.GetChannel(ctx, req.GetChannelId())
+	userID := ctx.Value("user_id").(int32)
+	_, err := c.channelService.GetChannel(ctx, req.GetChannelId(), userID)
 	if err != nil {
 		return nil, commonErrors.ToGRPCError(err)
 	}
@@ -235,15 +253,19 @@ func (c *ChannelController) LeaveChannel(ctx context.Context, req *channelPb.Lea
 // GetChannelMembers retrieves channel members (streaming response)
 func (c *ChannelController) GetChannelMembers(req *channelPb.GetChannelMembersRequest, stream channelPb.ChannelService_GetChannelMembersServer) error {
 	ctx := stream.Context()
+	userID, ok := ctx.Value("user_id").(int32)
+	if !ok {
+		return commonErrors.ToGRPCError(commonErrors.ErrUnauthorized)
+	}
 
 	// Get channel to verify it exists
-	channel, err := c.channelService.GetChannel(ctx, req.GetChannelId())
+	channel, err := c.channelService.GetChannel(ctx, req.GetChannelId(), userID)
 	if err != nil {
 		return commonErrors.ToGRPCError(err)
 	}
 
 	// Get server members with access to this channel
-	members, err := c.channelService.GetChannelMembers(ctx, channel.ServerId, req.GetChannelId())
+	members, err := c.channelService.GetChannelMembers(ctx, channel.ServerId, req.GetChannelId(), userID)
 	if err != nil {
 		return commonErrors.ToGRPCError(err)
 	}
@@ -282,6 +304,7 @@ func (c *ChannelController) UpdateChannelPermissions(ctx context.Context, req *c
 	err := c.channelService.SetChannelPermission(
 		ctx,
 		req.GetChannelId(),
+		ctx.Value("user_id").(int32),
 		roleID,
 		userID,
 		req.GetAllow(),
@@ -298,7 +321,9 @@ func (c *ChannelController) UpdateChannelPermissions(ctx context.Context, req *c
 
 // GetChannelPermissions retrieves channel permissions
 func (c *ChannelController) GetChannelPermissions(ctx context.Context, req *channelPb.GetChannelPermissionsRequest) (*channelPb.GetChannelPermissionsResponse, error) {
-	permissions, err := c.channelService.GetChannelPermissions(ctx, req.GetChannelId())
+	userID := ctx.Value("user_id").(int32)
+
+	permissions, err := c.channelService.GetChannelPermissions(ctx, req.GetChannelId(), userID)
 	if err != nil {
 		return nil, commonErrors.ToGRPCError(err)
 	}
@@ -321,7 +346,9 @@ func (c *ChannelController) GetChannelPermissions(ctx context.Context, req *chan
 
 // UpdateChannelPosition updates channel position
 func (c *ChannelController) UpdateChannelPosition(ctx context.Context, req *channelPb.UpdateChannelPositionRequest) (*channelPb.UpdateChannelPositionResponse, error) {
-	err := c.channelService.UpdateChannelPosition(ctx, req.GetChannelId(), req.GetPosition())
+	userID := ctx.Value("user_id").(int32)
+
+	err := c.channelService.UpdateChannelPosition(ctx, req.GetChannelId(), userID, req.GetPosition())
 	if err != nil {
 		return nil, commonErrors.ToGRPCError(err)
 	}
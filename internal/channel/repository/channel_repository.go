@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"discord/gen/repo"
+	"discord/internal/common/util"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -67,8 +68,27 @@ func (r *ChannelRepository) UpdateChannel(ctx context.Context, params repo.Updat
 
 // DeleteChannel deletes a channel
 func (r *ChannelRepository) DeleteChannel(ctx context.Context, id int32) error {
-	_, err := r.queries.SoftDeleteChannel(ctx, id)
-	return err
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+	channel, err := qtx.SoftDeleteChannel(ctx, id)
+	if err != nil {
+		return err
+	}
+	_, err = qtx.CreateDeletedEntity(ctx, repo.CreateDeletedEntityParams{
+		EntityType: util.DeletedChannel,
+		EntityID:   channel.ID,
+		ScopeType:  util.ScopeServer,
+		ScopeID:    channel.ServerID,
+	})
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
 }
 
 // UpdateChannelPosition updates channel position
@@ -6,29 +6,49 @@ import (
 
 	"discord/gen/proto/schema"
 	"discord/gen/repo"
+	auditService "discord/internal/audit/service"
+	auditUtil "discord/internal/audit/util"
 	channelRepo "discord/internal/channel/repository"
+	"discord/internal/channel/util"
 	commonErrors "discord/internal/common/errors"
+	gatewayUtil "discord/internal/gateway/util"
+	permissionService "discord/internal/permission/service"
 
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type ChannelService struct {
 	channelRepo *channelRepo.ChannelRepository
+	permissions *permissionService.Resolver
+	audit       *auditService.AuditService
 }
 
-func NewChannelService(channelRepo *channelRepo.ChannelRepository) *ChannelService {
+func NewChannelService(channelRepo *channelRepo.ChannelRepository, permissions *permissionService.Resolver, audit *auditService.AuditService) *ChannelService {
 	return &ChannelService{
 		channelRepo: channelRepo,
+		permissions: permissions,
+		audit:       audit,
 	}
 }
 
 // CreateChannel creates a new channel
-func (s *ChannelService) CreateChannel(ctx context.Context, serverID int32, name, channelType string, categoryID *int32, position int32, topic string, isNSFW bool, slowmodeDelay int32) (*schema.Channel, error) {
+func (s *ChannelService) CreateChannel(ctx context.Context, serverID, userID int32, name, channelType string, categoryID *int32, position int32, topic string, isNSFW bool, slowmodeDelay int32) (*schema.Channel, error) {
 	// Validate input
 	if name == "" {
 		return nil, commonErrors.ErrInvalidInput
 	}
 
+	if err := s.permissions.RequireServer(ctx, serverID, userID, util.PermissionManageChannels); err != nil {
+		return nil, err
+	}
+
+	if categoryID != nil {
+		category, err := s.channelRepo.GetChannelByID(ctx, *categoryID)
+		if err != nil || category.ServerID != serverID {
+			return nil, commonErrors.ErrInvalidInput
+		}
+	}
+
 	// Create channel params
 	params := repo.CreateChannelParams{
 		ServerID: serverID,
@@ -53,30 +73,54 @@ func (s *ChannelService) CreateChannel(ctx context.Context, serverID int32, name
 	if err != nil {
 		return nil, err
 	}
+	publishChannel(*channel, gatewayUtil.ChannelCreate)
 
+	s.audit.Record(ctx, auditService.Entry{
+		ServerID:   serverID,
+		UserID:     userID,
+		Action:     auditUtil.ChannelCreate,
+		TargetID:   channel.ID,
+		TargetType: auditUtil.TargetChannel,
+		After:      channel,
+	})
 	return s.toProtoChannel(channel), nil
 }
 
 // GetChannel retrieves channel by ID
-func (s *ChannelService) GetChannel(ctx context.Context, channelID int32) (*schema.Channel, error) {
+func (s *ChannelService) GetChannel(ctx context.Context, channelID, userID int32) (*schema.Channel, error) {
 	channel, err := s.channelRepo.GetChannelByID(ctx, channelID)
 	if err != nil {
 		return nil, commonErrors.ErrNotFound
 	}
 
+	if err := s.permissions.RequireChannel(ctx, channelID, userID, util.PermissionViewChannel); err != nil {
+		return nil, err
+	}
+
 	return s.toProtoChannel(channel), nil
 }
 
-// GetServerChannels retrieves all channels in a server
-func (s *ChannelService) GetServerChannels(ctx context.Context, serverID int32) ([]*schema.Channel, error) {
+// GetServerChannels retrieves the channels in a server the user can view
+func (s *ChannelService) GetServerChannels(ctx context.Context, serverID, userID int32) ([]*schema.Channel, error) {
+	if err := s.permissions.RequireMember(ctx, serverID, userID); err != nil {
+		return nil, err
+	}
+
 	channels, err := s.channelRepo.GetServerChannels(ctx, serverID)
 	if err != nil {
 		return nil, err
 	}
 
-	protoChannels := make([]*schema.Channel, len(channels))
-	for i, channel := range channels {
-		protoChannels[i] = s.toProtoChannel(&channel)
+	protoChannels := make([]*schema.Channel, 0, len(channels))
+	for _, channel := range channels {
+		perms, err := s.permissions.ChannelPermissions(ctx, channel.ID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if !util.CanViewChannel(perms) {
+			continue
+		}
+		protoChannels = append(protoChannels, s.toProtoChannel(&channel))
 	}
 
 	return protoChannels, nil
@@ -113,7 +157,16 @@ func (s *ChannelService) GetChannelsByType(ctx context.Context, serverID int32,
 }
 
 // UpdateChannel updates channel information
-func (s *ChannelService) UpdateChannel(ctx context.Context, channelID int32, name, topic *string, position, slowmodeDelay *int32, isNSFW *bool) (*schema.Channel, error) {
+func (s *ChannelService) UpdateChannel(ctx context.Context, channelID, userID int32, name, topic *string, position, slowmodeDelay *int32, isNSFW *bool) (*schema.Channel, error) {
+	if err := s.permissions.RequireChannel(ctx, channelID, userID, util.PermissionManageChannels); err != nil {
+		return nil, err
+	}
+
+	before, err := s.channelRepo.GetChannelByID(ctx, channelID)
+	if err != nil {
+		return nil, commonErrors.ErrNotFound
+	}
+
 	params := repo.UpdateChannelParams{
 		ID: channelID,
 	}
@@ -142,26 +195,99 @@ func (s *ChannelService) UpdateChannel(ctx context.Context, channelID int32, nam
 	if err != nil {
 		return nil, err
 	}
-
+	publishChannel(*channel, gatewayUtil.ChannelUpdate)
+
+	s.audit.Record(ctx, auditService.Entry{
+		ServerID:   channel.ServerID,
+		UserID:     userID,
+		Action:     auditUtil.ChannelUpdate,
+		TargetID:   channelID,
+		TargetType: auditUtil.TargetChannel,
+		Before:     before,
+		After:      channel,
+	})
 	return s.toProtoChannel(channel), nil
 }
 
 // DeleteChannel deletes a channel
-func (s *ChannelService) DeleteChannel(ctx context.Context, channelID int32) error {
-	return s.channelRepo.DeleteChannel(ctx, channelID)
+func (s *ChannelService) DeleteChannel(ctx context.Context, channelID, userID int32) error {
+	if err := s.permissions.RequireChannel(ctx, channelID, userID, util.PermissionManageChannels); err != nil {
+		return err
+	}
+
+	channel, err := s.channelRepo.GetChannelByID(ctx, channelID)
+	if err != nil {
+		return commonErrors.ErrNotFound
+	}
+
+	if err := s.channelRepo.DeleteChannel(ctx, channelID); err != nil {
+		return err
+	}
+	deleted := *channel
+	deleted.IsDeleted = pgtype.Bool{Bool: true, Valid: true}
+	publishChannel(deleted, gatewayUtil.ChannelDelete)
+
+	s.audit.Record(ctx, auditService.Entry{
+		ServerID:   channel.ServerID,
+		UserID:     userID,
+		Action:     auditUtil.ChannelDelete,
+		TargetID:   channelID,
+		TargetType: auditUtil.TargetChannel,
+		Before:     channel,
+	})
+	return nil
 }
 
 // UpdateChannelPosition updates channel position
-func (s *ChannelService) UpdateChannelPosition(ctx context.Context, channelID int32, position int32) error {
-	return s.channelRepo.UpdateChannelPosition(ctx, channelID, position)
+func (s *ChannelService) UpdateChannelPosition(ctx context.Context, channelID, userID int32, position int32) error {
+	if err := s.permissions.RequireChannel(ctx, channelID, userID, util.PermissionManageChannels); err != nil {
+		return err
+	}
+
+	channel, err := s.channelRepo.GetChannelByID(ctx, channelID)
+	if err != nil {
+		return commonErrors.ErrNotFound
+	}
+
+	if err := s.channelRepo.UpdateChannelPosition(ctx, channelID, position); err != nil {
+		return err
+	}
+
+	s.audit.Record(ctx, auditService.Entry{
+		ServerID:   channel.ServerID,
+		UserID:     userID,
+		Action:     auditUtil.ChannelUpdate,
+		TargetID:   channelID,
+		TargetType: auditUtil.TargetChannel,
+		Before:     map[string]pgtype.Int4{"position": channel.Position},
+		After:      map[string]int32{"position": position},
+	})
+	return nil
 }
 
 // SetChannelPermission sets channel permissions for role or user
-func (s *ChannelService) SetChannelPermission(ctx context.Context, channelID int32, roleID, userID *int32, allowPermissions, denyPermissions int64) error {
+func (s *ChannelService) SetChannelPermission(ctx context.Context, channelID, actorID int32, roleID, userID *int32, allowPermissions, denyPermissions int64) error {
 	if roleID == nil && userID == nil {
 		return errors.New("either role_id or user_id must be provided")
 	}
 
+	if err := s.permissions.RequireChannel(ctx, channelID, actorID, util.PermissionManageRoles); err != nil {
+		return err
+	}
+
+	channel, err := s.channelRepo.GetChannelByID(ctx, channelID)
+	if err != nil {
+		return commonErrors.ErrNotFound
+	}
+
+	// The overwrite being replaced, if any
+	var before *repo.ChannelPermission
+	if roleID != nil {
+		before, _ = s.channelRepo.GetRoleChannelPermissions(ctx, channelID, *roleID)
+	} else {
+		before, _ = s.channelRepo.GetUserChannelPermissions(ctx, channelID, *userID)
+	}
+
 	params := repo.SetChannelPermissionParams{
 		ChannelID:        channelID,
 		AllowPermissions: pgtype.Int8{Int64: allowPermissions, Valid: true},
@@ -176,12 +302,33 @@ func (s *ChannelService) SetChannelPermission(ctx context.Context, channelID int
 		params.UserID = pgtype.Int4{Int32: *userID, Valid: true}
 	}
 
-	_, err := s.channelRepo.SetChannelPermission(ctx, params)
-	return err
+	overwrite, err := s.channelRepo.SetChannelPermission(ctx, params)
+	if err != nil {
+		return err
+	}
+	publishChannel(*channel, gatewayUtil.ChannelUpdate)
+
+	entry := auditService.Entry{
+		ServerID:   channel.ServerID,
+		UserID:     actorID,
+		Action:     auditUtil.OverwriteUpdate,
+		TargetID:   channelID,
+		TargetType: auditUtil.TargetChannel,
+		After:      overwrite,
+	}
+	if before != nil {
+		entry.Before = before
+	}
+	s.audit.Record(ctx, entry)
+	return nil
 }
 
 // GetChannelPermissions retrieves all channel permissions
-func (s *ChannelService) GetChannelPermissions(ctx context.Context, channelID int32) ([]*schema.ChannelPermissionOverwrite, error) {
+func (s *ChannelService) GetChannelPermissions(ctx context.Context, channelID, userID int32) ([]*schema.ChannelPermissionOverwrite, error) {
+	if err := s.permissions.RequireChannel(ctx, channelID, userID, util.PermissionViewChannel); err != nil {
+		return nil, err
+	}
+
 	permissions, err := s.channelRepo.GetChannelPermissions(ctx, channelID)
 	if err != nil {
 		return nil, err
@@ -195,13 +342,58 @@ func (s *ChannelService) GetChannelPermissions(ctx context.Context, channelID in
 	return protoPermissions, nil
 }
 
-// DeleteChannelPermission deletes a channel permission
-func (s *ChannelService) DeleteChannelPermission(ctx context.Context, permissionID int32) error {
-	return s.channelRepo.DeleteChannelPermission(ctx, permissionID)
+// DeleteChannelPermission removes the overwrite of a role or user from a channel
+func (s *ChannelService) DeleteChannelPermission(ctx context.Context, channelID, actorID int32, roleID, userID *int32) error {
+	if roleID == nil && userID == nil {
+		return errors.New("either role_id or user_id must be provided")
+	}
+
+	if err := s.permissions.RequireChannel(ctx, channelID, actorID, util.PermissionManageRoles); err != nil {
+		return err
+	}
+
+	channel, err := s.channelRepo.GetChannelByID(ctx, channelID)
+	if err != nil {
+		return commonErrors.ErrNotFound
+	}
+
+	var before *repo.ChannelPermission
+	if roleID != nil {
+		before, err = s.channelRepo.GetRoleChannelPermissions(ctx, channelID, *roleID)
+	} else {
+		before, err = s.channelRepo.GetUserChannelPermissions(ctx, channelID, *userID)
+	}
+	if err != nil {
+		return commonErrors.ErrNotFound
+	}
+
+	if roleID != nil {
+		err = s.channelRepo.DeleteRoleChannelPermissions(ctx, channelID, *roleID)
+	} else {
+		err = s.channelRepo.DeleteUserChannelPermissions(ctx, channelID, *userID)
+	}
+	if err != nil {
+		return err
+	}
+	publishChannel(*channel, gatewayUtil.ChannelUpdate)
+
+	s.audit.Record(ctx, auditService.Entry{
+		ServerID:   channel.ServerID,
+		UserID:     actorID,
+		Action:     auditUtil.OverwriteDelete,
+		TargetID:   channelID,
+		TargetType: auditUtil.TargetChannel,
+		Before:     before,
+	})
+	return nil
 }
 
 // GetChannelMembers gets members who have access to a channel
-func (s *ChannelService) GetChannelMembers(ctx context.Context, serverID, channelID int32) ([]ChannelMemberInfo, error) {
+func (s *ChannelService) GetChannelMembers(ctx context.Context, serverID, channelID, userID int32) ([]ChannelMemberInfo, error) {
+	if err := s.permissions.RequireChannel(ctx, channelID, userID, util.PermissionViewChannel); err != nil {
+		return nil, err
+	}
+
 	// Get server members
 	members, err := s.channelRepo.GetServerMembers(ctx, serverID, 1000, 0)
 	if err != nil {
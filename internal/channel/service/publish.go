@@ -0,0 +1,16 @@
+package service
+
+import (
+	"discord/gen/proto/schema"
+	gatewayPb "discord/gen/proto/service/gateway"
+	"discord/gen/repo"
+	gatewayUtil "discord/internal/gateway/util"
+	syncUtil "discord/internal/sync/util"
+	"discord/pkg/pubsub"
+)
+
+// publishChannel sends a channel event to everyone subscribed to the channel's
+// server. Sessions re-check which channels their user can view on each one.
+func publishChannel(channel repo.Channel, event func(*schema.Channel) *gatewayPb.GatewayEvent) {
+	pubsub.Get().Publish(gatewayUtil.ServerTopic(channel.ServerID), event(syncUtil.ConvertChannel(channel)))
+}
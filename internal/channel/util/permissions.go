@@ -33,8 +33,36 @@ const (
 	PermissionManageRoles          int64 = 1 << 28 // 0x10000000
 	PermissionManageWebhooks       int64 = 1 << 29 // 0x20000000
 	PermissionManageEmojisStickers int64 = 1 << 30 // 0x40000000
+	PermissionModerateMembers      int64 = 1 << 31 // 0x80000000
 )
 
+// AllPermissions is every permission bit, granted to owners and administrators
+const AllPermissions int64 = ^int64(0)
+
+// DefaultEveryonePermissions are granted to the @everyone role of a new server
+const DefaultEveryonePermissions = PermissionCreateInvite |
+	PermissionAddReactions |
+	PermissionStream |
+	PermissionViewChannel |
+	PermissionSendMessages |
+	PermissionEmbedLinks |
+	PermissionAttachFiles |
+	PermissionReadMessageHistory |
+	PermissionMentionEveryone |
+	PermissionUseExternalEmojis |
+	PermissionConnect |
+	PermissionSpeak |
+	PermissionUseVAD |
+	PermissionChangeNickname
+
+// TimeoutRestrictedPermissions are withheld from a member while they are timed out
+const TimeoutRestrictedPermissions = PermissionAddReactions |
+	PermissionSendMessages |
+	PermissionSendTTSMessages |
+	PermissionSpeak |
+	PermissionPrioritySpeaker |
+	PermissionStream
+
 // HasPermission checks if the permission bits contain a specific permission
 func HasPermission(permissions, permission int64) bool {
 	return (permissions & permission) == permission
@@ -59,24 +87,52 @@ func CalculatePermissions(basePermissions, allowOverwrites, denyOverwrites int64
 	return permissions
 }
 
-// CalculateChannelPermissions calculates permissions for a user in a channel
-// considering role permissions and channel overwrites
-func CalculateChannelPermissions(basePermissions int64, roleOverwrites map[int32]*ChannelOverwrite, userOverwrite *ChannelOverwrite) int64 {
+// CalculateBasePermissions combines the @everyone role with the member's roles.
+// Administrator grants every permission.
+func CalculateBasePermissions(everyonePermissions int64, rolePermissions ...int64) int64 {
+	permissions := everyonePermissions
+	for _, rolePerms := range rolePermissions {
+		permissions |= rolePerms
+	}
+
+	if HasPermission(permissions, PermissionAdministrator) {
+		return AllPermissions
+	}
+
+	return permissions
+}
+
+// CalculateChannelPermissions applies channel overwrites to base permissions
+// in Discord order: the @everyone overwrite, then the member's role overwrites
+// combined (allow wins over deny between roles), then the member overwrite.
+// Administrators bypass overwrites, and losing VIEW_CHANNEL drops everything.
+func CalculateChannelPermissions(basePermissions int64, everyoneOverwrite *ChannelOverwrite, roleOverwrites map[int32]*ChannelOverwrite, userOverwrite *ChannelOverwrite) int64 {
+	if HasPermission(basePermissions, PermissionAdministrator) {
+		return AllPermissions
+	}
+
 	permissions := basePermissions
 
-	// Apply role overwrites
+	// Apply @everyone overwrite
+	if everyoneOverwrite != nil {
+		permissions = CalculatePermissions(permissions, everyoneOverwrite.Allow, everyoneOverwrite.Deny)
+	}
+
+	// Apply role overwrites as one combined overwrite
+	var allow, deny int64
 	for _, overwrite := range roleOverwrites {
-		permissions = CalculatePermissions(permissions, overwrite.Allow, overwrite.Deny)
+		allow |= overwrite.Allow
+		deny |= overwrite.Deny
 	}
+	permissions = CalculatePermissions(permissions, allow, deny)
 
 	// Apply user overwrite (highest priority)
 	if userOverwrite != nil {
 		permissions = CalculatePermissions(permissions, userOverwrite.Allow, userOverwrite.Deny)
 	}
 
-	// Administrator has all permissions
-	if HasPermission(basePermissions, PermissionAdministrator) {
-		return ^int64(0) // All bits set to 1
+	if !CanViewChannel(permissions) {
+		return 0
 	}
 
 	return permissions
@@ -161,6 +217,7 @@ func GetPermissionNames(permissions int64) []string {
 		PermissionManageRoles:          "Manage Roles",
 		PermissionManageWebhooks:       "Manage Webhooks",
 		PermissionManageEmojisStickers: "Manage Emojis and Stickers",
+		PermissionModerateMembers:      "Moderate Members",
 	}
 
 	for perm, name := range permissionMap {
@@ -0,0 +1,104 @@
+package util
+
+import "testing"
+
+func TestCalculateBasePermissions(t *testing.T) {
+	tests := []struct {
+		name     string
+		everyone int64
+		roles    []int64
+		want     int64
+	}{
+		{"everyone only", PermissionViewChannel, nil, PermissionViewChannel},
+		{"roles add to everyone", PermissionViewChannel, []int64{PermissionKickMembers, PermissionBanMembers}, PermissionViewChannel | PermissionKickMembers | PermissionBanMembers},
+		{"administrator role grants all", PermissionViewChannel, []int64{PermissionAdministrator}, AllPermissions},
+		{"administrator on everyone grants all", PermissionAdministrator, nil, AllPermissions},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CalculateBasePermissions(tt.everyone, tt.roles...); got != tt.want {
+				t.Errorf("expected %#x, got %#x", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCalculateChannelPermissions(t *testing.T) {
+	const base = PermissionViewChannel | PermissionSendMessages | PermissionAddReactions
+
+	tests := []struct {
+		name     string
+		base     int64
+		everyone *ChannelOverwrite
+		roles    map[int32]*ChannelOverwrite
+		member   *ChannelOverwrite
+		want     int64
+	}{
+		{
+			name: "no overwrites",
+			base: base,
+			want: base,
+		},
+		{
+			name:     "everyone deny",
+			base:     base,
+			everyone: &ChannelOverwrite{Deny: PermissionSendMessages},
+			want:     PermissionViewChannel | PermissionAddReactions,
+		},
+		{
+			name:     "role allow beats everyone deny",
+			base:     base,
+			everyone: &ChannelOverwrite{Deny: PermissionSendMessages},
+			roles:    map[int32]*ChannelOverwrite{2: {Allow: PermissionSendMessages}},
+			want:     base,
+		},
+		{
+			name:  "allow wins over deny between roles",
+			base:  base,
+			roles: map[int32]*ChannelOverwrite{2: {Deny: PermissionSendMessages}, 3: {Allow: PermissionSendMessages}},
+			want:  base,
+		},
+		{
+			name:   "member deny beats role allow",
+			base:   base,
+			roles:  map[int32]*ChannelOverwrite{2: {Allow: PermissionManageMessages}},
+			member: &ChannelOverwrite{Deny: PermissionManageMessages | PermissionSendMessages},
+			want:   PermissionViewChannel | PermissionAddReactions,
+		},
+		{
+			name:     "member allow beats everyone and role deny",
+			base:     base,
+			everyone: &ChannelOverwrite{Deny: PermissionSendMessages},
+			roles:    map[int32]*ChannelOverwrite{2: {Deny: PermissionSendMessages}},
+			member:   &ChannelOverwrite{Allow: PermissionSendMessages},
+			want:     base,
+		},
+		{
+			name:     "losing view channel drops everything",
+			base:     base,
+			everyone: &ChannelOverwrite{Deny: PermissionViewChannel},
+			want:     0,
+		},
+		{
+			name:     "role restores view channel",
+			base:     base,
+			everyone: &ChannelOverwrite{Deny: PermissionViewChannel},
+			roles:    map[int32]*ChannelOverwrite{2: {Allow: PermissionViewChannel}},
+			want:     base,
+		},
+		{
+			name:     "administrator bypasses overwrites",
+			base:     PermissionAdministrator,
+			everyone: &ChannelOverwrite{Deny: PermissionViewChannel},
+			member:   &ChannelOverwrite{Deny: AllPermissions},
+			want:     AllPermissions,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CalculateChannelPermissions(tt.base, tt.everyone, tt.roles, tt.member); got != tt.want {
+				t.Errorf("expected %#x, got %#x", tt.want, got)
+			}
+		})
+	}
+}
@@ -22,6 +22,14 @@ var (
 	ErrUserBanned         = errors.New("user is banned")
 	ErrRateLimitExceeded  = errors.New("rate limit exceeded")
 	ErrPermissionDenied   = errors.New("permission denied")
+	ErrSyncExpired        = errors.New("sync state expired, full resync required")
+	ErrSessionInvalid     = errors.New("session invalid, identify again")
+	ErrSlowConsumer       = errors.New("stream fell behind, reconnect and resync")
+	ErrMemberTimedOut     = errors.New("member is timed out")
+	ErrConflict           = errors.New("resource was changed concurrently")
+	ErrBlocked            = errors.New("user is blocked")
+	ErrDMsRestricted      = errors.New("user does not accept direct messages from you")
+	ErrReverifyRequired   = errors.New("verify your identity again to continue")
 )
 
 // ToGRPCError converts application error to gRPC status error
@@ -35,7 +43,8 @@ func ToGRPCError(err error) error {
 		return status.Error(codes.NotFound, err.Error())
 	case errors.Is(err, ErrUnauthorized):
 		return status.Error(codes.Unauthenticated, err.Error())
-	case errors.Is(err, ErrForbidden), errors.Is(err, ErrPermissionDenied):
+	case errors.Is(err, ErrForbidden), errors.Is(err, ErrPermissionDenied), errors.Is(err, ErrMemberTimedOut),
+		errors.Is(err, ErrBlocked), errors.Is(err, ErrDMsRestricted):
 		return status.Error(codes.PermissionDenied, err.Error())
 	case errors.Is(err, ErrInvalidInput):
 		return status.Error(codes.InvalidArgument, err.Error())
@@ -47,6 +56,12 @@ func ToGRPCError(err error) error {
 		return status.Error(codes.Unauthenticated, err.Error())
 	case errors.Is(err, ErrRateLimitExceeded):
 		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, ErrSyncExpired), errors.Is(err, ErrSessionInvalid), errors.Is(err, ErrReverifyRequired):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, ErrSlowConsumer):
+		return status.Error(codes.Unavailable, err.Error())
+	case errors.Is(err, ErrConflict):
+		return status.Error(codes.Aborted, err.Error())
 	default:
 		return status.Error(codes.Internal, "internal server error")
 	}
@@ -1,6 +1,9 @@
 package errors
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // ValidationError represents input validation errors
 type ValidationError struct {
@@ -71,3 +74,23 @@ func NewPermissionError(action, resource string) *PermissionError {
 		Resource: resource,
 	}
 }
+
+// TimeoutError tells a timed out member until when they cannot communicate
+type TimeoutError struct {
+	Until time.Time
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("%s until %s", ErrMemberTimedOut, e.Until.UTC().Format(time.RFC3339))
+}
+
+func (e *TimeoutError) Unwrap() error {
+	return ErrMemberTimedOut
+}
+
+// NewTimeoutError creates a new timeout error
+func NewTimeoutError(until time.Time) *TimeoutError {
+	return &TimeoutError{
+		Until: until,
+	}
+}
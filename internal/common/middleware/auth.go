@@ -4,7 +4,6 @@ import (
 	"context"
 	"strings"
 
-	"discord/internal/auth/util"
 	"discord/internal/common/errors"
 
 	"google.golang.org/grpc"
@@ -13,8 +12,17 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-// AuthInterceptor validates JWT tokens
-func AuthInterceptor() grpc.UnaryServerInterceptor {
+// SessionValidator resolves the user and session of an access token, failing
+// once the session was revoked, and tells whether a session was verified
+// recently enough for sensitive actions
+type SessionValidator interface {
+	ValidateAccessToken(ctx context.Context, accessToken string) (int32, int32, error)
+	RequireRecentVerification(ctx context.Context, sessionID int32) error
+}
+
+// AuthInterceptor validates access tokens and puts the user and session id
+// into the context
+func AuthInterceptor(sessions SessionValidator) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
 		req interface{},
@@ -40,18 +48,26 @@ func AuthInterceptor() grpc.UnaryServerInterceptor {
 			return nil, status.Error(codes.Unauthenticated, "invalid authorization format")
 		}
 
-		userID, err := util.ValidateJWT(token)
+		userID, sessionID, err := sessions.ValidateAccessToken(ctx, token)
 		if err != nil {
 			return nil, status.Error(codes.Unauthenticated, "invalid or expired token")
 		}
 
+		if isSensitiveEndpoint(info.FullMethod) {
+			if err := sessions.RequireRecentVerification(ctx, sessionID); err != nil {
+				return nil, errors.ToGRPCError(err)
+			}
+		}
+
 		ctx = context.WithValue(ctx, "user_id", userID)
+		ctx = context.WithValue(ctx, "session_id", sessionID)
 
 		return handler(ctx, req)
 	}
 }
 
-func StreamAuthInterceptor() grpc.StreamServerInterceptor {
+// StreamAuthInterceptor is the streaming counterpart of AuthInterceptor
+func StreamAuthInterceptor(sessions SessionValidator) grpc.StreamServerInterceptor {
 	return func(
 		srv interface{},
 		ss grpc.ServerStream,
@@ -77,12 +93,13 @@ func StreamAuthInterceptor() grpc.StreamServerInterceptor {
 			return status.Error(codes.Unauthenticated, "invalid authorization format")
 		}
 
-		userID, err := util.ValidateJWT(token)
+		userID, sessionID, err := sessions.ValidateAccessToken(ss.Context(), token)
 		if err != nil {
 			return status.Error(codes.Unauthenticated, "invalid or expired token")
 		}
 
 		newCtx := context.WithValue(ss.Context(), "user_id", userID)
+		newCtx = context.WithValue(newCtx, "session_id", sessionID)
 
 		wrapped := &WrappedServerStream{
 			ServerStream: ss,
@@ -96,10 +113,16 @@ func StreamAuthInterceptor() grpc.StreamServerInterceptor {
 // isPublicEndpoint checks if endpoint requires authentication
 func isPublicEndpoint(method string) bool {
 	publicEndpoints := []string{
-		"/service.auth.AuthService/Register",
-		"/service.auth.AuthService/Login",
-		"/service.auth.AuthService/ForgotPassword",
-		"/service.auth.AuthService/ResetPassword",
+		"/protoservice.auth.AuthService/Register",
+		"/protoservice.auth.AuthService/Login",
+		"/protoservice.auth.AuthService/LoginMFA",
+		"/protoservice.auth.AuthService/RefreshToken",
+		"/protoservice.auth.AuthService/ForgotPassword",
+		"/protoservice.auth.AuthService/ResetPassword",
+		"/protoservice.auth.AuthService/VerifyEmail",
+		"/protoservice.auth.AuthService/GetJWKS",
+		// The gateway authenticates in-band with Identify or Resume
+		"/protoservice.gateway.GatewayService/Gateway",
 	}
 
 	for _, endpoint := range publicEndpoints {
@@ -110,6 +133,24 @@ func isPublicEndpoint(method string) bool {
 	return false
 }
 
+// isSensitiveEndpoint checks if endpoint requires the session to have been
+// verified recently, see AuthService.Reverify
+func isSensitiveEndpoint(method string) bool {
+	sensitiveEndpoints := []string{
+		"/protoservice.auth.AuthService/ChangePassword",
+		"/protoservice.user.UserService/DeleteUser",
+		"/protoservice.server.ServerService/TransferOwnership",
+		"/protoservice.server.ServerService/DeleteServer",
+	}
+
+	for _, endpoint := range sensitiveEndpoints {
+		if method == endpoint {
+			return true
+		}
+	}
+	return false
+}
+
 // WrappedServerStream wraps grpc.ServerStream with a custom context
 type WrappedServerStream struct {
 	grpc.ServerStream
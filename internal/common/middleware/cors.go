@@ -19,7 +19,7 @@ func CORSInterceptor() grpc.UnaryServerInterceptor {
 		md := metadata.Pairs(
 			"Access-Control-Allow-Origin", "*",
 			"Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS",
-			"Access-Control-Allow-Headers", "Content-Type, Authorization",
+			"Access-Control-Allow-Headers", "Content-Type, Authorization, X-Audit-Log-Reason",
 			"Access-Control-Max-Age", "86400",
 		)
 		
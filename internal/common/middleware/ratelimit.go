@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	"strconv"
 	"sync"
 	"time"
 
@@ -10,21 +11,32 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// ClientIPResolver tells the address of the client behind a request, taking
+// trusted proxies into account
+type ClientIPResolver interface {
+	ClientIP(ctx context.Context) string
+}
+
 type rateLimiter struct {
-	requests map[string][]time.Time
-	mu       sync.RWMutex
-	limit    int
-	window   time.Duration
+	requests  map[string][]time.Time
+	mu        sync.Mutex
+	limit     int
+	window    time.Duration
+	lastSweep time.Time
 }
 
-var limiter = &rateLimiter{
-	requests: make(map[string][]time.Time),
-	limit:    100, // 100 requests
-	window:   time.Minute,
+func newRateLimiter(limit int, window time.Duration) *rateLimiter {
+	return &rateLimiter{
+		requests: make(map[string][]time.Time),
+		limit:    limit,
+		window:   window,
+	}
 }
 
-// RateLimitInterceptor implements rate limiting per user/IP
-func RateLimitInterceptor() grpc.UnaryServerInterceptor {
+// RateLimitInterceptor implements rate limiting per user, or per client
+// address for anonymous requests
+func RateLimitInterceptor(clients ClientIPResolver) grpc.UnaryServerInterceptor {
+	limiter := newRateLimiter(100, time.Minute) // 100 requests a minute
 	return func(
 		ctx context.Context,
 		req interface{},
@@ -32,9 +44,9 @@ func RateLimitInterceptor() grpc.UnaryServerInterceptor {
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
 		// Get user identifier (IP or user ID)
-		identifier := getUserIdentifier(ctx)
+		identifier := getUserIdentifier(ctx, clients)
 
-		if !limiter.allow(identifier) {
+		if !limiter.allow(identifier, time.Now()) {
 			return nil, status.Error(codes.ResourceExhausted, "rate limit exceeded")
 		}
 
@@ -42,13 +54,22 @@ func RateLimitInterceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
-func (rl *rateLimiter) allow(identifier string) bool {
+func (rl *rateLimiter) allow(identifier string, now time.Time) bool {
 	rl.mu.Lock()
 	defer rl.mu.Unlock()
 
-	now := time.Now()
 	cutoff := now.Add(-rl.window)
 
+	// Forget identifiers without recent requests once a window
+	if now.Sub(rl.lastSweep) >= rl.window {
+		for key, requests := range rl.requests {
+			if !requests[len(requests)-1].After(cutoff) {
+				delete(rl.requests, key)
+			}
+		}
+		rl.lastSweep = now
+	}
+
 	// Get requests for this identifier
 	requests := rl.requests[identifier]
 
@@ -62,6 +83,7 @@ func (rl *rateLimiter) allow(identifier string) bool {
 
 	// Check if limit exceeded
 	if len(valid) >= rl.limit {
+		rl.requests[identifier] = valid
 		return false
 	}
 
@@ -72,42 +94,17 @@ func (rl *rateLimiter) allow(identifier string) bool {
 	return true
 }
 
-func getUserIdentifier(ctx context.Context) string {
+// getUserIdentifier keys authenticated requests by user and anonymous ones by
+// client address. It relies on AuthInterceptor running first.
+func getUserIdentifier(ctx context.Context, clients ClientIPResolver) string {
 	// Try to get user ID from context
 	if userID, ok := ctx.Value("user_id").(int32); ok {
-		return string(rune(userID))
+		return "user:" + strconv.Itoa(int(userID))
 	}
 
 	// Otherwise use IP or default
+	if ip := clients.ClientIP(ctx); ip != "" {
+		return "ip:" + ip
+	}
 	return "anonymous"
 }
-
-// Cleanup old entries periodically
-func init() {
-	go func() {
-		ticker := time.NewTicker(time.Minute)
-		defer ticker.Stop()
-
-		for range ticker.C {
-			limiter.mu.Lock()
-			now := time.Now()
-			cutoff := now.Add(-limiter.window)
-
-			for key, requests := range limiter.requests {
-				valid := []time.Time{}
-				for _, t := range requests {
-					if t.After(cutoff) {
-						valid = append(valid, t)
-					}
-				}
-
-				if len(valid) == 0 {
-					delete(limiter.requests, key)
-				} else {
-					limiter.requests[key] = valid
-				}
-			}
-			limiter.mu.Unlock()
-		}
-	}()
-}
@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+type fixedClientIP string
+
+func (ip fixedClientIP) ClientIP(context.Context) string { return string(ip) }
+
+func TestRateLimiterAllow(t *testing.T) {
+	rl := newRateLimiter(2, time.Minute)
+	now := time.Now()
+
+	if !rl.allow("a", now) || !rl.allow("a", now) {
+		t.Fatal("expected requests within the limit to be allowed")
+	}
+	if rl.allow("a", now) {
+		t.Error("expected a request over the limit to be refused")
+	}
+	if !rl.allow("b", now) {
+		t.Error("expected another identifier to have its own limit")
+	}
+	if !rl.allow("a", now.Add(time.Minute)) {
+		t.Error("expected requests to be allowed again after the window")
+	}
+}
+
+func TestRateLimiterForgetsIdle(t *testing.T) {
+	rl := newRateLimiter(2, time.Minute)
+	now := time.Now()
+	rl.allow("idle", now)
+	rl.allow("busy", now.Add(45*time.Second))
+
+	rl.allow("other", now.Add(90*time.Second))
+	if _, ok := rl.requests["idle"]; ok {
+		t.Error("expected an identifier without recent requests to be forgotten")
+	}
+	if _, ok := rl.requests["busy"]; !ok {
+		t.Error("expected an active identifier to be kept")
+	}
+}
+
+func TestGetUserIdentifier(t *testing.T) {
+	ctx := context.Background()
+	if got := getUserIdentifier(ctx, fixedClientIP("1.2.3.4")); got != "ip:1.2.3.4" {
+		t.Errorf("expected anonymous requests keyed by client address, got %q", got)
+	}
+	if got := getUserIdentifier(ctx, fixedClientIP("")); got != "anonymous" {
+		t.Errorf("expected %q without an address, got %q", "anonymous", got)
+	}
+	ctx = context.WithValue(ctx, "user_id", int32(7))
+	if got := getUserIdentifier(ctx, fixedClientIP("1.2.3.4")); got != "user:7" {
+		t.Errorf("expected authenticated requests keyed by user, got %q", got)
+	}
+}
@@ -0,0 +1,18 @@
+package util
+
+// Entity types recorded in the deleted_entities log
+const (
+	DeletedMessage       = "message"
+	DeletedDirectMessage = "direct_message"
+	DeletedFriend        = "friend"
+	DeletedServer        = "server"
+	DeletedChannel       = "channel"
+	DeletedRole          = "role"
+)
+
+// Scopes decide which users can see a deleted_entities row
+const (
+	ScopeUser    = "user"
+	ScopeServer  = "server"
+	ScopeChannel = "channel"
+)
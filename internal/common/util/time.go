@@ -1,6 +1,7 @@
 package util
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
@@ -74,3 +75,17 @@ func IsExpired(expiresAt time.Time) bool {
 func AddDuration(duration time.Duration) time.Time {
 	return time.Now().Add(duration)
 }
+
+// RunPeriodic calls fn every interval until ctx is done
+func RunPeriodic(ctx context.Context, interval time.Duration, fn func(ctx context.Context)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			fn(ctx)
+		}
+	}
+}
@@ -8,62 +8,67 @@ import (
 	schema "discord/gen/proto/schema"
 	"discord/gen/proto/service/dm"
 	"discord/gen/repo"
+	commonErrors "discord/internal/common/errors"
 	"discord/internal/dm/service"
+	dmUtil "discord/internal/dm/util"
 	"discord/pkg/pubsub"
-
-	"github.com/jackc/pgx/v5/pgxpool"
 	"google.golang.org/grpc"
 )
 
 type DMController struct {
 	dm.UnimplementedDirectMessageServiceServer
 	service *service.MessageService
-	db      *pgxpool.Pool
 }
 
-func NewDMController(db *pgxpool.Pool) *DMController {
-	return &DMController{
-		service: service.NewMessageService(),
-		db:      db,
+func NewDMController(messageService *service.MessageService) *dm.DirectMessageServiceServer {
+	controller := &DMController{
+		service: messageService,
 	}
+	var grpcController dm.DirectMessageServiceServer = controller
+	return &grpcController
 }
 
 // Helper function to convert repo.Message to schema.Message
 func messageToProto(msg repo.Message) *schema.Message {
 	return &schema.Message{
-		Id:        msg.ID,
-		ChannelId: int32(msg.ReceiverID.Int32), // For DMs, receiver_id is stored as channel_id
-		SenderId:  msg.SenderID,
-		Content:   msg.Content,
-		IsEdited:  msg.IsEdited.Bool,
-		CreatedAt: msg.CreatedAt.Time.Unix() * 1000,
+		Id:          msg.ID,
+		ChannelId:   int32(msg.ReceiverID.Int32), // For DMs, receiver_id is stored as channel_id
+		DmChannelId: msg.DmChannelID.Int32,
+		SenderId:    msg.SenderID,
+		Content:     msg.Content,
+		IsEdited:    msg.IsEdited.Bool,
+		CreatedAt:   msg.CreatedAt.Time.Unix() * 1000,
 	}
 }
 
+// groupDMToProto converts a group DM with its participants to schema.DMChannel
+func groupDMToProto(group service.GroupDM) *schema.DMChannel {
+	return dmUtil.ConvertDMChannelToProto(group.DmChannel, group.ParticipantIDs)
+}
+
 // ==================== MESSAGE OPERATIONS ====================
 
-// SendMessage sends a direct message
+// SendMessage sends a direct message from the caller. The sender_id of the
+// request is ignored.
 func (c *DMController) SendMessage(ctx context.Context, req *dm.SendMessageRequest) (*dm.SendMessageResponse, error) {
-	if req == nil {
-		return &dm.SendMessageResponse{Success: false}, fmt.Errorf("request cannot be nil")
-	}
-
-	if req.ReceiverId == 0 {
-		return &dm.SendMessageResponse{Success: false}, fmt.Errorf("receiver_id is required")
+	senderID, ok := ctx.Value("user_id").(int32)
+	if !ok {
+		return nil, commonErrors.ToGRPCError(commonErrors.ErrUnauthorized)
 	}
 
-	if req.Content == "" {
-		return &dm.SendMessageResponse{Success: false}, fmt.Errorf("content cannot be empty")
+	if req.ReceiverId == 0 || req.Content == "" {
+		return &dm.SendMessageResponse{Success: false}, commonErrors.ToGRPCError(commonErrors.ErrInvalidInput)
 	}
 
-	message, err := c.service.SendMessage(ctx, req.ReceiverId, req.SenderId, req.Content, req.ReplyToMessageId, false)
+	message, delivery, err := c.service.SendMessage(ctx, req.ReceiverId, senderID, req.Content, req.ReplyToMessageId, false)
 	if err != nil {
-		return &dm.SendMessageResponse{Success: false}, fmt.Errorf("failed to send message: %w", err)
+		return &dm.SendMessageResponse{Success: false}, commonErrors.ToGRPCError(err)
 	}
 
 	return &dm.SendMessageResponse{
-		Success: true,
-		Message: messageToProto(message),
+		Success:          true,
+		Message:          messageToProto(message),
+		IsMessageRequest: delivery == dmUtil.DeliveryRequest,
 	}, nil
 }
 
@@ -388,6 +393,280 @@ func (c *DMController) SearchMessages(ctx context.Context, req *dm.SearchMessage
 		TotalResults: int32(len(results)),
 	}, nil
 }
+
+// ==================== MESSAGE REQUESTS ====================
+
+// ListMessageRequests lists the pending message requests of the caller
+func (c *DMController) ListMessageRequests(ctx context.Context, req *dm.ListMessageRequestsRequest) (*dm.ListMessageRequestsResponse, error) {
+	userID, ok := ctx.Value("user_id").(int32)
+	if !ok {
+		return nil, commonErrors.ToGRPCError(commonErrors.ErrUnauthorized)
+	}
+
+	requests, err := c.service.GetMessageRequests(ctx, userID)
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	protoRequests := make([]*dm.MessageRequest, 0, len(requests))
+	for _, request := range requests {
+		protoRequest := &dm.MessageRequest{
+			SenderId:  request.SenderID,
+			CreatedAt: request.CreatedAt.Time.Unix(),
+			UpdatedAt: request.UpdatedAt.Time.Unix(),
+		}
+		if request.MessageID.Valid {
+			protoRequest.LastMessage = &schema.Message{
+				Id:        request.MessageID.Int32,
+				ChannelId: request.RecipientID,
+				SenderId:  request.SenderID,
+				Content:   request.MessageContent.String,
+				CreatedAt: request.MessageCreatedAt.Time.Unix() * 1000,
+			}
+		}
+		protoRequests = append(protoRequests, protoRequest)
+	}
+
+	return &dm.ListMessageRequestsResponse{Requests: protoRequests}, nil
+}
+
+// AcceptMessageRequest moves a conversation from the caller's message requests into their DMs
+func (c *DMController) AcceptMessageRequest(ctx context.Context, req *dm.AcceptMessageRequestRequest) (*dm.AcceptMessageRequestResponse, error) {
+	userID, ok := ctx.Value("user_id").(int32)
+	if !ok {
+		return nil, commonErrors.ToGRPCError(commonErrors.ErrUnauthorized)
+	}
+
+	if req.SenderId == 0 {
+		return &dm.AcceptMessageRequestResponse{Success: false}, commonErrors.ToGRPCError(commonErrors.ErrInvalidInput)
+	}
+
+	if err := c.service.AcceptMessageRequest(ctx, userID, req.SenderId); err != nil {
+		return &dm.AcceptMessageRequestResponse{Success: false}, commonErrors.ToGRPCError(err)
+	}
+
+	return &dm.AcceptMessageRequestResponse{Success: true}, nil
+}
+
+// IgnoreMessageRequest hides a message request of the caller
+func (c *DMController) IgnoreMessageRequest(ctx context.Context, req *dm.IgnoreMessageRequestRequest) (*dm.IgnoreMessageRequestResponse, error) {
+	userID, ok := ctx.Value("user_id").(int32)
+	if !ok {
+		return nil, commonErrors.ToGRPCError(commonErrors.ErrUnauthorized)
+	}
+
+	if req.SenderId == 0 {
+		return &dm.IgnoreMessageRequestResponse{Success: false}, commonErrors.ToGRPCError(commonErrors.ErrInvalidInput)
+	}
+
+	if err := c.service.IgnoreMessageRequest(ctx, userID, req.SenderId); err != nil {
+		return &dm.IgnoreMessageRequestResponse{Success: false}, commonErrors.ToGRPCError(err)
+	}
+
+	return &dm.IgnoreMessageRequestResponse{Success: true}, nil
+}
+
+// ==================== GROUP DMS ====================
+
+// CreateGroupDM opens a group DM owned by the caller
+func (c *DMController) CreateGroupDM(ctx context.Context, req *dm.CreateGroupDMRequest) (*dm.CreateGroupDMResponse, error) {
+	userID, ok := ctx.Value("user_id").(int32)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found in context")
+	}
+
+	if len(req.RecipientIds) == 0 {
+		return nil, fmt.Errorf("recipient_ids is required")
+	}
+
+	group, err := c.service.CreateGroupDM(ctx, userID, req.RecipientIds, req.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create group DM: %w", err)
+	}
+
+	return &dm.CreateGroupDMResponse{DmChannel: groupDMToProto(group)}, nil
+}
+
+// GetDMChannels lists the caller's DM channels with their unread counts
+func (c *DMController) GetDMChannels(ctx context.Context, req *dm.GetDMChannelsRequest) (*dm.GetDMChannelsResponse, error) {
+	userID, ok := ctx.Value("user_id").(int32)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found in context")
+	}
+
+	rows, err := c.service.GetDMChannels(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DM channels: %w", err)
+	}
+
+	channels := make([]*schema.DMChannel, len(rows))
+	for i, row := range rows {
+		channels[i] = dmUtil.ConvertDMChannelRowToProto(row)
+	}
+
+	return &dm.GetDMChannelsResponse{DmChannels: channels}, nil
+}
+
+// UpdateGroupDM renames a group DM or changes its icon
+func (c *DMController) UpdateGroupDM(ctx context.Context, req *dm.UpdateGroupDMRequest) (*dm.UpdateGroupDMResponse, error) {
+	userID, ok := ctx.Value("user_id").(int32)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found in context")
+	}
+
+	if req.DmChannelId == 0 {
+		return nil, fmt.Errorf("dm_channel_id is required")
+	}
+
+	group, err := c.service.UpdateGroupDM(ctx, userID, req.DmChannelId, req.Name, req.Icon)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update group DM: %w", err)
+	}
+
+	return &dm.UpdateGroupDMResponse{DmChannel: groupDMToProto(group)}, nil
+}
+
+// AddGroupDMRecipient adds one of the caller's friends to a group DM
+func (c *DMController) AddGroupDMRecipient(ctx context.Context, req *dm.AddGroupDMRecipientRequest) (*dm.AddGroupDMRecipientResponse, error) {
+	userID, ok := ctx.Value("user_id").(int32)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found in context")
+	}
+
+	if req.DmChannelId == 0 || req.UserId == 0 {
+		return nil, fmt.Errorf("dm_channel_id and user_id are required")
+	}
+
+	group, err := c.service.AddGroupDMRecipient(ctx, userID, req.DmChannelId, req.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add recipient: %w", err)
+	}
+
+	return &dm.AddGroupDMRecipientResponse{DmChannel: groupDMToProto(group)}, nil
+}
+
+// RemoveGroupDMRecipient removes a participant from a group DM owned by the caller
+func (c *DMController) RemoveGroupDMRecipient(ctx context.Context, req *dm.RemoveGroupDMRecipientRequest) (*dm.RemoveGroupDMRecipientResponse, error) {
+	userID, ok := ctx.Value("user_id").(int32)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found in context")
+	}
+
+	if req.DmChannelId == 0 || req.UserId == 0 {
+		return nil, fmt.Errorf("dm_channel_id and user_id are required")
+	}
+
+	group, err := c.service.RemoveGroupDMRecipient(ctx, userID, req.DmChannelId, req.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove recipient: %w", err)
+	}
+
+	return &dm.RemoveGroupDMRecipientResponse{DmChannel: groupDMToProto(group)}, nil
+}
+
+// TransferGroupDMOwnership hands a group DM owned by the caller to another participant
+func (c *DMController) TransferGroupDMOwnership(ctx context.Context, req *dm.TransferGroupDMOwnershipRequest) (*dm.TransferGroupDMOwnershipResponse, error) {
+	userID, ok := ctx.Value("user_id").(int32)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found in context")
+	}
+
+	if req.DmChannelId == 0 || req.NewOwnerId == 0 {
+		return nil, fmt.Errorf("dm_channel_id and new_owner_id are required")
+	}
+
+	group, err := c.service.TransferGroupDMOwnership(ctx, userID, req.DmChannelId, req.NewOwnerId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to transfer ownership: %w", err)
+	}
+
+	return &dm.TransferGroupDMOwnershipResponse{DmChannel: groupDMToProto(group)}, nil
+}
+
+// LeaveGroupDM removes the caller from a group DM
+func (c *DMController) LeaveGroupDM(ctx context.Context, req *dm.LeaveGroupDMRequest) (*dm.LeaveGroupDMResponse, error) {
+	userID, ok := ctx.Value("user_id").(int32)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found in context")
+	}
+
+	if req.DmChannelId == 0 {
+		return &dm.LeaveGroupDMResponse{Success: false}, fmt.Errorf("dm_channel_id is required")
+	}
+
+	if err := c.service.LeaveGroupDM(ctx, userID, req.DmChannelId); err != nil {
+		return &dm.LeaveGroupDMResponse{Success: false}, fmt.Errorf("failed to leave group DM: %w", err)
+	}
+
+	return &dm.LeaveGroupDMResponse{Success: true}, nil
+}
+
+// SendGroupDMMessage posts a message to a group DM
+func (c *DMController) SendGroupDMMessage(ctx context.Context, req *dm.SendGroupDMMessageRequest) (*dm.SendGroupDMMessageResponse, error) {
+	userID, ok := ctx.Value("user_id").(int32)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found in context")
+	}
+
+	if req.DmChannelId == 0 {
+		return nil, fmt.Errorf("dm_channel_id is required")
+	}
+
+	if req.Content == "" {
+		return nil, fmt.Errorf("content cannot be empty")
+	}
+
+	message, err := c.service.SendGroupDMMessage(ctx, userID, req.DmChannelId, req.Content, req.ReplyToMessageId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send message: %w", err)
+	}
+
+	return &dm.SendGroupDMMessageResponse{Message: messageToProto(message)}, nil
+}
+
+// GetGroupDMMessages retrieves the messages of a group DM, newest first
+func (c *DMController) GetGroupDMMessages(ctx context.Context, req *dm.GetGroupDMMessagesRequest) (*dm.GetGroupDMMessagesResponse, error) {
+	userID, ok := ctx.Value("user_id").(int32)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found in context")
+	}
+
+	if req.DmChannelId == 0 {
+		return nil, fmt.Errorf("dm_channel_id is required")
+	}
+
+	messages, err := c.service.GetGroupDMMessages(ctx, userID, req.DmChannelId, req.Limit, req.BeforeMessageId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get messages: %w", err)
+	}
+
+	protoMessages := make([]*schema.Message, len(messages))
+	for i, message := range messages {
+		protoMessages[i] = messageToProto(message)
+	}
+
+	return &dm.GetGroupDMMessagesResponse{Messages: protoMessages}, nil
+}
+
+// AckDMChannel marks a DM channel as read up to a message
+func (c *DMController) AckDMChannel(ctx context.Context, req *dm.AckDMChannelRequest) (*dm.AckDMChannelResponse, error) {
+	userID, ok := ctx.Value("user_id").(int32)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found in context")
+	}
+
+	if req.DmChannelId == 0 || req.MessageId == 0 {
+		return nil, fmt.Errorf("dm_channel_id and message_id are required")
+	}
+
+	lastRead, err := c.service.AckDMChannel(ctx, userID, req.DmChannelId, req.MessageId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to ack DM channel: %w", err)
+	}
+
+	return &dm.AckDMChannelResponse{LastReadMessageId: lastRead}, nil
+}
+
 func (c *DMController) SendTyping(stream grpc.BidiStreamingServer[dm.SendTypingRequest, dm.SendTypingResponse]) error {
 	pub := pubsub.Get()
 	ctx := stream.Context()
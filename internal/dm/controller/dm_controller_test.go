@@ -5,18 +5,24 @@ import (
 	"discord/config"
 	dmPb "discord/gen/proto/service/dm"
 	"discord/gen/repo"
+	commonErrors "discord/internal/common/errors"
+	"discord/internal/dm/repository"
+	"discord/internal/dm/service"
+	userUtil "discord/internal/user/util"
 	"testing"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-func load(t *testing.T) *DMController {
+func load(t *testing.T) dmPb.DirectMessageServiceServer {
 	err := config.InitDB()
 	if err != nil {
 		assert.Error(t, err)
 	}
-	return NewDMController(config.DB)
+	return *NewDMController(service.NewMessageService(repository.NewDMRepository(config.DB)))
 }
 
 func createrUser(t *testing.T, ctx context.Context, name string, email string, password string) int {
@@ -52,8 +58,7 @@ func TestCreateDM(t *testing.T) {
 	defer DeleteUser(t, ctx, id1)
 	id2 := createrUser(t, ctx, "test2", "test2", "test2")
 	defer DeleteUser(t, ctx, id2)
-	_, e := f.SendMessage(ctx, &dmPb.SendMessageRequest{
-		SenderId:         int32(id1),
+	_, e := f.SendMessage(asUser(ctx, id1), &dmPb.SendMessageRequest{
 		ReceiverId:       int32(id2),
 		ReplyToMessageId: nil,
 		Content:          "Hello World",
@@ -80,3 +85,278 @@ func TestCreateDM(t *testing.T) {
 	assert.NotNil(t, msg)
 	assert.Equal(t, "Hello World", msg[0].Content)
 }
+
+// loadWithDB is load for tests that cannot run without a database
+func loadWithDB(t *testing.T) dmPb.DirectMessageServiceServer {
+	f := load(t)
+	if config.DB == nil {
+		t.Skip("database not available")
+	}
+	return f
+}
+
+func asUser(ctx context.Context, id int) context.Context {
+	return context.WithValue(ctx, "user_id", int32(id))
+}
+
+func setDMPrivacy(t *testing.T, ctx context.Context, id int, privacy string) {
+	defaults := userUtil.DefaultSettings(int32(id))
+	_, err := repo.New(config.DB).UpsertUserSettings(ctx, repo.UpsertUserSettingsParams{
+		UserID:                   int32(id),
+		Theme:                    defaults.Theme,
+		Locale:                   defaults.Locale,
+		ShowCurrentActivity:      defaults.ShowCurrentActivity,
+		DmPrivacy:                privacy,
+		FriendRequestPrivacy:     defaults.FriendRequestPrivacy,
+		ExplicitContentFilter:    defaults.ExplicitContentFilter,
+		EnableNotifications:      defaults.EnableNotifications,
+		DefaultNotificationLevel: defaults.DefaultNotificationLevel,
+	})
+	assert.NoError(t, err)
+}
+
+func send(f dmPb.DirectMessageServiceServer, ctx context.Context, from, to int, content string) (*dmPb.SendMessageResponse, error) {
+	return f.SendMessage(asUser(ctx, from), &dmPb.SendMessageRequest{
+		ReceiverId: int32(to),
+		Content:    content,
+	})
+}
+
+func TestSendMessageBlocked(t *testing.T) {
+	ctx := context.Background()
+	f := loadWithDB(t)
+	id1 := createrUser(t, ctx, "dm_blocker", "dm_blocker", "test")
+	defer DeleteUser(t, ctx, id1)
+	id2 := createrUser(t, ctx, "dm_blocked", "dm_blocked", "test")
+	defer DeleteUser(t, ctx, id2)
+
+	_, err := repo.New(config.DB).BlockUser(ctx, repo.BlockUserParams{
+		UserID:   int32(id1),
+		FriendID: int32(id2),
+	})
+	assert.NoError(t, err)
+
+	// Blocks apply in both directions
+	_, err = send(f, ctx, id2, id1, "hello")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = send(f, ctx, id1, id2, "hello")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestSendMessageFriendsOnly(t *testing.T) {
+	ctx := context.Background()
+	f := loadWithDB(t)
+	id1 := createrUser(t, ctx, "dm_sender", "dm_sender", "test")
+	defer DeleteUser(t, ctx, id1)
+	id2 := createrUser(t, ctx, "dm_private", "dm_private", "test")
+	defer DeleteUser(t, ctx, id2)
+	setDMPrivacy(t, ctx, id2, userUtil.DMPrivacyFriends)
+
+	_, err := send(f, ctx, id1, id2, "hello")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	queries := repo.New(config.DB)
+	_, err = queries.CreateFriend(ctx, repo.CreateFriendParams{UserID: int32(id1), FriendID: int32(id2)})
+	assert.NoError(t, err)
+	_, err = queries.AcceptFriendRequest(ctx, repo.AcceptFriendRequestParams{UserID: int32(id1), FriendID: int32(id2)})
+	assert.NoError(t, err)
+	defer DeleteFriendship(t, ctx, id1, id2)
+
+	res, err := send(f, ctx, id1, id2, "hello friend")
+	assert.NoError(t, err)
+	assert.False(t, res.IsMessageRequest)
+}
+
+func TestSendMessageServerMembersOnly(t *testing.T) {
+	ctx := context.Background()
+	f := loadWithDB(t)
+	id1 := createrUser(t, ctx, "dm_member", "dm_member", "test")
+	defer DeleteUser(t, ctx, id1)
+	id2 := createrUser(t, ctx, "dm_owner", "dm_owner", "test")
+	defer DeleteUser(t, ctx, id2)
+	setDMPrivacy(t, ctx, id2, userUtil.DMPrivacyServerMembers)
+
+	_, err := send(f, ctx, id1, id2, "hello")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	queries := repo.New(config.DB)
+	server, err := queries.CreateServer(ctx, repo.CreateServerParams{Name: "dm_test", OwnerID: int32(id2)})
+	assert.NoError(t, err)
+	defer queries.HardDeleteServer(ctx, server.ID)
+	for _, id := range []int{id1, id2} {
+		_, err = queries.AddServerMember(ctx, repo.AddServerMemberParams{ServerID: server.ID, UserID: int32(id)})
+		assert.NoError(t, err)
+	}
+
+	res, err := send(f, ctx, id1, id2, "hello member")
+	assert.NoError(t, err)
+	assert.True(t, res.IsMessageRequest)
+
+	// The recipient turned DMs off for this server
+	_, err = queries.UpsertUserServerSettings(ctx, repo.UpsertUserServerSettingsParams{
+		UserID:   int32(id2),
+		ServerID: server.ID,
+		AllowDms: false,
+	})
+	assert.NoError(t, err)
+	_, err = send(f, ctx, id1, id2, "hello again")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestSendMessageAsCaller(t *testing.T) {
+	f := load(t)
+
+	// The sender comes from the authenticated context, never the request
+	_, err := f.SendMessage(context.Background(), &dmPb.SendMessageRequest{SenderId: 1, ReceiverId: 2, Content: "hello"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestMessageRequests(t *testing.T) {
+	ctx := context.Background()
+	f := loadWithDB(t)
+	id1 := createrUser(t, ctx, "dm_stranger", "dm_stranger", "test")
+	defer DeleteUser(t, ctx, id1)
+	id2 := createrUser(t, ctx, "dm_recipient", "dm_recipient", "test")
+	defer DeleteUser(t, ctx, id2)
+	id3 := createrUser(t, ctx, "dm_spammer", "dm_spammer", "test")
+	defer DeleteUser(t, ctx, id3)
+
+	res, err := send(f, ctx, id1, id2, "hi there")
+	assert.NoError(t, err)
+	assert.True(t, res.IsMessageRequest)
+	_, err = send(f, ctx, id3, id2, "buy now")
+	assert.NoError(t, err)
+
+	list, err := f.ListMessageRequests(asUser(ctx, id2), &dmPb.ListMessageRequestsRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, list.Requests, 2)
+
+	_, err = f.AcceptMessageRequest(asUser(ctx, id2), &dmPb.AcceptMessageRequestRequest{SenderId: int32(id1)})
+	assert.NoError(t, err)
+	_, err = f.IgnoreMessageRequest(asUser(ctx, id2), &dmPb.IgnoreMessageRequestRequest{SenderId: int32(id3)})
+	assert.NoError(t, err)
+
+	list, err = f.ListMessageRequests(asUser(ctx, id2), &dmPb.ListMessageRequestsRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, list.Requests)
+
+	// Accepted conversations go straight to the DMs in both directions
+	res, err = send(f, ctx, id1, id2, "thanks")
+	assert.NoError(t, err)
+	assert.False(t, res.IsMessageRequest)
+	res, err = send(f, ctx, id2, id1, "welcome")
+	assert.NoError(t, err)
+	assert.False(t, res.IsMessageRequest)
+
+	// Ignored senders are not told
+	res, err = send(f, ctx, id3, id2, "buy now!")
+	assert.NoError(t, err)
+	assert.True(t, res.IsMessageRequest)
+}
+
+func TestReplyAcceptsMessageRequest(t *testing.T) {
+	ctx := context.Background()
+	f := loadWithDB(t)
+	id1 := createrUser(t, ctx, "dm_asker", "dm_asker", "test")
+	defer DeleteUser(t, ctx, id1)
+	id2 := createrUser(t, ctx, "dm_replier", "dm_replier", "test")
+	defer DeleteUser(t, ctx, id2)
+	// Privacy settings of the original sender do not block the reply
+	setDMPrivacy(t, ctx, id1, userUtil.DMPrivacyServerMembers)
+
+	res, err := send(f, ctx, id1, id2, "question")
+	assert.NoError(t, err)
+	assert.True(t, res.IsMessageRequest)
+
+	res, err = send(f, ctx, id2, id1, "answer")
+	assert.NoError(t, err)
+	assert.False(t, res.IsMessageRequest)
+
+	list, err := f.ListMessageRequests(asUser(ctx, id2), &dmPb.ListMessageRequestsRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, list.Requests)
+}
+
+func befriend(t *testing.T, ctx context.Context, uid, fid int) {
+	queries := repo.New(config.DB)
+	_, err := queries.CreateFriend(ctx, repo.CreateFriendParams{UserID: int32(uid), FriendID: int32(fid)})
+	assert.NoError(t, err)
+	_, err = queries.AcceptFriendRequest(ctx, repo.AcceptFriendRequestParams{UserID: int32(uid), FriendID: int32(fid)})
+	assert.NoError(t, err)
+}
+
+func TestGroupDM(t *testing.T) {
+	ctx := context.Background()
+	f := loadWithDB(t)
+	owner := createrUser(t, ctx, "gdm_owner", "gdm_owner", "test")
+	defer DeleteUser(t, ctx, owner)
+	id2 := createrUser(t, ctx, "gdm_member", "gdm_member", "test")
+	defer DeleteUser(t, ctx, id2)
+	id3 := createrUser(t, ctx, "gdm_late", "gdm_late", "test")
+	defer DeleteUser(t, ctx, id3)
+	stranger := createrUser(t, ctx, "gdm_stranger", "gdm_stranger", "test")
+	defer DeleteUser(t, ctx, stranger)
+	befriend(t, ctx, owner, id2)
+	defer DeleteFriendship(t, ctx, owner, id2)
+	befriend(t, ctx, id2, id3)
+	defer DeleteFriendship(t, ctx, id2, id3)
+
+	// Only friends can be added
+	_, err := f.CreateGroupDM(asUser(ctx, owner), &dmPb.CreateGroupDMRequest{RecipientIds: []int32{int32(id2), int32(stranger)}})
+	assert.ErrorIs(t, err, commonErrors.ErrPermissionDenied)
+
+	created, err := f.CreateGroupDM(asUser(ctx, owner), &dmPb.CreateGroupDMRequest{RecipientIds: []int32{int32(id2)}, Name: "trip"})
+	assert.NoError(t, err)
+	channelID := created.DmChannel.Id
+	assert.Equal(t, []int32{int32(owner), int32(id2)}, created.DmChannel.ParticipantIds)
+	assert.Equal(t, int32(owner), created.DmChannel.OwnerId)
+
+	// Any participant adds their friends, only the owner renames
+	_, err = f.AddGroupDMRecipient(asUser(ctx, id2), &dmPb.AddGroupDMRecipientRequest{DmChannelId: channelID, UserId: int32(id3)})
+	assert.NoError(t, err)
+	name := "road trip"
+	_, err = f.UpdateGroupDM(asUser(ctx, id2), &dmPb.UpdateGroupDMRequest{DmChannelId: channelID, Name: &name})
+	assert.ErrorIs(t, err, commonErrors.ErrPermissionDenied)
+	updated, err := f.UpdateGroupDM(asUser(ctx, owner), &dmPb.UpdateGroupDMRequest{DmChannelId: channelID, Name: &name})
+	assert.NoError(t, err)
+	assert.Equal(t, name, updated.DmChannel.Name)
+
+	sent, err := f.SendGroupDMMessage(asUser(ctx, owner), &dmPb.SendGroupDMMessageRequest{DmChannelId: channelID, Content: "hi all"})
+	assert.NoError(t, err)
+	_, err = f.SendGroupDMMessage(asUser(ctx, owner), &dmPb.SendGroupDMMessageRequest{DmChannelId: channelID, Content: "anyone?"})
+	assert.NoError(t, err)
+
+	list, err := f.GetDMChannels(asUser(ctx, id3), &dmPb.GetDMChannelsRequest{})
+	assert.NoError(t, err)
+	if assert.Len(t, list.DmChannels, 1) {
+		assert.Equal(t, int32(2), list.DmChannels[0].UnreadCount)
+	}
+	_, err = f.AckDMChannel(asUser(ctx, id3), &dmPb.AckDMChannelRequest{DmChannelId: channelID, MessageId: sent.Message.Id})
+	assert.NoError(t, err)
+	list, err = f.GetDMChannels(asUser(ctx, id3), &dmPb.GetDMChannelsRequest{})
+	assert.NoError(t, err)
+	if assert.Len(t, list.DmChannels, 1) {
+		assert.Equal(t, int32(1), list.DmChannels[0].UnreadCount)
+	}
+
+	// Strangers cannot see the group DM
+	_, err = f.GetGroupDMMessages(asUser(ctx, stranger), &dmPb.GetGroupDMMessagesRequest{DmChannelId: channelID})
+	assert.ErrorIs(t, err, commonErrors.ErrNotFound)
+
+	// The owner leaving passes ownership on
+	_, err = f.LeaveGroupDM(asUser(ctx, owner), &dmPb.LeaveGroupDMRequest{DmChannelId: channelID})
+	assert.NoError(t, err)
+	list, err = f.GetDMChannels(asUser(ctx, id2), &dmPb.GetDMChannelsRequest{})
+	assert.NoError(t, err)
+	if assert.Len(t, list.DmChannels, 1) {
+		assert.Equal(t, int32(id2), list.DmChannels[0].OwnerId)
+		assert.Equal(t, []int32{int32(id2), int32(id3)}, list.DmChannels[0].ParticipantIds)
+	}
+
+	_, err = f.RemoveGroupDMRecipient(asUser(ctx, id2), &dmPb.RemoveGroupDMRecipientRequest{DmChannelId: channelID, UserId: int32(id3)})
+	assert.NoError(t, err)
+	_, err = f.LeaveGroupDM(asUser(ctx, id2), &dmPb.LeaveGroupDMRequest{DmChannelId: channelID})
+	assert.NoError(t, err)
+	_, err = repo.New(config.DB).GetDMChannel(ctx, channelID)
+	assert.Error(t, err)
+}
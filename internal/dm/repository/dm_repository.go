@@ -3,7 +3,10 @@ package repository
 import (
 	"context"
 	"discord/gen/repo"
+	userUtil "discord/internal/user/util"
+	"errors"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -75,8 +78,20 @@ func (r *DMRepository) UpdateMessage(ctx context.Context, messageID int32, conte
 }
 
 func (r *DMRepository) DeleteMessage(ctx context.Context, messageID int32) error {
-	_, err := r.queries.SoftDeleteChatMessage(ctx, messageID)
-	return err
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+	if _, err := qtx.CreateMessageDeletedEntities(ctx, []int32{messageID}); err != nil {
+		return err
+	}
+	if _, err := qtx.SoftDeleteChatMessage(ctx, messageID); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
 }
 
 func (r *DMRepository) SearchDMMessages(ctx context.Context, userID1, userID2 int32, query string, limit, offset int32) ([]repo.Message, error) {
@@ -221,3 +236,250 @@ func (r *DMRepository) SearchMessages(ctx context.Context, channelID int32, quer
 		Offset:     offset,
 	})
 }
+
+// IsBlockedBetween reports whether either user blocked the other
+func (r *DMRepository) IsBlockedBetween(ctx context.Context, userA, userB int32) (bool, error) {
+	return r.queries.IsBlockedBetween(ctx, repo.IsBlockedBetweenParams{UserA: userA, UserB: userB})
+}
+
+func (r *DMRepository) AreFriends(ctx context.Context, userA, userB int32) (bool, error) {
+	return r.queries.AreFriends(ctx, repo.AreFriendsParams{UserA: userA, UserB: userB})
+}
+
+// GetDMPrivacy returns the dm_privacy setting of a user, falling back to the
+// default for users who never saved their settings
+func (r *DMRepository) GetDMPrivacy(ctx context.Context, userID int32) (string, error) {
+	settings, err := r.queries.GetUserSettings(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return userUtil.DefaultSettings(userID).DmPrivacy, nil
+	}
+	return settings.DmPrivacy, err
+}
+
+// SharesServerAllowingDMs reports whether the users share a server whose
+// members the recipient accepts DMs from
+func (r *DMRepository) SharesServerAllowingDMs(ctx context.Context, senderID, recipientID int32) (bool, error) {
+	return r.queries.SharesServerAllowingDMs(ctx, repo.SharesServerAllowingDMsParams{
+		SenderID:    senderID,
+		RecipientID: recipientID,
+	})
+}
+
+func (r *DMRepository) HasAcceptedMessageRequest(ctx context.Context, userA, userB int32) (bool, error) {
+	return r.queries.HasAcceptedMessageRequest(ctx, repo.HasAcceptedMessageRequestParams{UserA: userA, UserB: userB})
+}
+
+func (r *DMRepository) GetMessageRequest(ctx context.Context, senderID, recipientID int32) (repo.DmMessageRequest, error) {
+	return r.queries.GetMessageRequest(ctx, repo.GetMessageRequestParams{
+		SenderID:    senderID,
+		RecipientID: recipientID,
+	})
+}
+
+// OpenMessageRequest opens a pending request, keeping the status of one that exists
+func (r *DMRepository) OpenMessageRequest(ctx context.Context, senderID, recipientID int32) (repo.DmMessageRequest, error) {
+	return r.queries.UpsertMessageRequest(ctx, repo.UpsertMessageRequestParams{
+		SenderID:    senderID,
+		RecipientID: recipientID,
+	})
+}
+
+func (r *DMRepository) ListMessageRequests(ctx context.Context, recipientID int32) ([]repo.ListMessageRequestsRow, error) {
+	return r.queries.ListMessageRequests(ctx, recipientID)
+}
+
+func (r *DMRepository) SetMessageRequestStatus(ctx context.Context, senderID, recipientID int32, status string) (repo.DmMessageRequest, error) {
+	return r.queries.SetMessageRequestStatus(ctx, repo.SetMessageRequestStatusParams{
+		SenderID:    senderID,
+		RecipientID: recipientID,
+		Status:      status,
+	})
+}
+
+// CreateGroupDM creates a group DM owned by ownerID and returns it with the
+// ids of its participants, owner first
+func (r *DMRepository) CreateGroupDM(ctx context.Context, ownerID int32, name string, recipientIDs []int32) (repo.DmChannel, []int32, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return repo.DmChannel{}, nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+	channel, err := qtx.CreateDMChannel(ctx, repo.CreateDMChannelParams{
+		Name:    pgtype.Text{String: name, Valid: name != ""},
+		OwnerID: pgtype.Int4{Int32: ownerID, Valid: true},
+	})
+	if err != nil {
+		return repo.DmChannel{}, nil, err
+	}
+
+	participantIDs := append([]int32{ownerID}, recipientIDs...)
+	for _, userID := range participantIDs {
+		if _, err := qtx.AddDMParticipant(ctx, repo.AddDMParticipantParams{
+			DmChannelID: channel.ID,
+			UserID:      userID,
+		}); err != nil {
+			return repo.DmChannel{}, nil, err
+		}
+	}
+	return channel, participantIDs, tx.Commit(ctx)
+}
+
+func (r *DMRepository) GetDMChannel(ctx context.Context, dmChannelID int32) (repo.DmChannel, error) {
+	return r.queries.GetDMChannel(ctx, dmChannelID)
+}
+
+// GetDMParticipantIDs returns the participants of a DM channel in join order
+func (r *DMRepository) GetDMParticipantIDs(ctx context.Context, dmChannelID int32) ([]int32, error) {
+	participants, err := r.queries.GetDMParticipants(ctx, dmChannelID)
+	if err != nil {
+		return nil, err
+	}
+	userIDs := make([]int32, len(participants))
+	for i, participant := range participants {
+		userIDs[i] = participant.UserID
+	}
+	return userIDs, nil
+}
+
+func (r *DMRepository) ListUserDMChannels(ctx context.Context, userID int32) ([]repo.ListUserDMChannelsRow, error) {
+	return r.queries.ListUserDMChannels(ctx, userID)
+}
+
+// AddDMParticipant adds a user to a DM channel. Messages sent before they
+// joined do not count as unread. It reports false if they already were a participant.
+func (r *DMRepository) AddDMParticipant(ctx context.Context, channel repo.DmChannel, userID int32) (bool, error) {
+	_, err := r.queries.AddDMParticipant(ctx, repo.AddDMParticipantParams{
+		DmChannelID:       channel.ID,
+		UserID:            userID,
+		LastReadMessageID: channel.LastMessageID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// RemoveDMParticipant removes a user from a DM channel and returns the
+// remaining participants. Ownership passes to the longest standing participant
+// when the owner leaves, and the channel is deleted once nobody is left.
+// It returns pgx.ErrNoRows if the user was no participant.
+func (r *DMRepository) RemoveDMParticipant(ctx context.Context, dmChannelID, userID int32) (repo.DmChannel, []int32, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return repo.DmChannel{}, nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+	channel, err := qtx.GetDMChannel(ctx, dmChannelID)
+	if err != nil {
+		return repo.DmChannel{}, nil, err
+	}
+	removed, err := qtx.RemoveDMParticipant(ctx, repo.RemoveDMParticipantParams{
+		DmChannelID: dmChannelID,
+		UserID:      userID,
+	})
+	if err != nil {
+		return repo.DmChannel{}, nil, err
+	}
+	if removed == 0 {
+		return repo.DmChannel{}, nil, pgx.ErrNoRows
+	}
+
+	participants, err := qtx.GetDMParticipants(ctx, dmChannelID)
+	if err != nil {
+		return repo.DmChannel{}, nil, err
+	}
+	remaining := make([]int32, len(participants))
+	for i, participant := range participants {
+		remaining[i] = participant.UserID
+	}
+
+	if len(remaining) == 0 {
+		if err := qtx.DeleteDMChannel(ctx, dmChannelID); err != nil {
+			return repo.DmChannel{}, nil, err
+		}
+	} else if channel.OwnerID.Int32 == userID {
+		channel, err = qtx.SetDMChannelOwner(ctx, repo.SetDMChannelOwnerParams{
+			ID:      dmChannelID,
+			OwnerID: pgtype.Int4{Int32: remaining[0], Valid: true},
+		})
+		if err != nil {
+			return repo.DmChannel{}, nil, err
+		}
+	}
+	return channel, remaining, tx.Commit(ctx)
+}
+
+func (r *DMRepository) SetDMChannelOwner(ctx context.Context, dmChannelID, ownerID int32) (repo.DmChannel, error) {
+	return r.queries.SetDMChannelOwner(ctx, repo.SetDMChannelOwnerParams{
+		ID:      dmChannelID,
+		OwnerID: pgtype.Int4{Int32: ownerID, Valid: true},
+	})
+}
+
+// UpdateDMChannel sets the name and icon of a DM channel; empty values clear them
+func (r *DMRepository) UpdateDMChannel(ctx context.Context, dmChannelID int32, name, icon string) (repo.DmChannel, error) {
+	return r.queries.UpdateDMChannel(ctx, repo.UpdateDMChannelParams{
+		ID:   dmChannelID,
+		Name: pgtype.Text{String: name, Valid: name != ""},
+		Icon: pgtype.Text{String: icon, Valid: icon != ""},
+	})
+}
+
+// CreateDMChannelMessage stores a message of a DM channel and makes it the
+// channel's latest message
+func (r *DMRepository) CreateDMChannelMessage(ctx context.Context, dmChannelID, senderID int32, content string, replyToMessageID *int32) (repo.Message, error) {
+	var replyTo pgtype.Int4
+	if replyToMessageID != nil {
+		replyTo = pgtype.Int4{Int32: *replyToMessageID, Valid: true}
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return repo.Message{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+	message, err := qtx.CreateDMChannelMessage(ctx, repo.CreateDMChannelMessageParams{
+		DmChannelID:      pgtype.Int4{Int32: dmChannelID, Valid: true},
+		SenderID:         senderID,
+		Content:          content,
+		MessageType:      pgtype.Text{String: "default", Valid: true},
+		ReplyToMessageID: replyTo,
+	})
+	if err != nil {
+		return repo.Message{}, err
+	}
+	if err := qtx.TouchDMChannel(ctx, repo.TouchDMChannelParams{
+		ID:            dmChannelID,
+		LastMessageID: pgtype.Int4{Int32: message.ID, Valid: true},
+		LastMessageAt: message.CreatedAt,
+	}); err != nil {
+		return repo.Message{}, err
+	}
+	return message, tx.Commit(ctx)
+}
+
+func (r *DMRepository) GetDMChannelMessages(ctx context.Context, dmChannelID int32, beforeMessageID *int32, limit int32) ([]repo.Message, error) {
+	params := repo.GetDMChannelMessagesParams{
+		DmChannelID: pgtype.Int4{Int32: dmChannelID, Valid: true},
+		ResultLimit: limit,
+	}
+	if beforeMessageID != nil {
+		params.BeforeID = pgtype.Int4{Int32: *beforeMessageID, Valid: true}
+	}
+	return r.queries.GetDMChannelMessages(ctx, params)
+}
+
+// AckDMChannel moves the read marker of a participant forward to messageID
+func (r *DMRepository) AckDMChannel(ctx context.Context, dmChannelID, userID, messageID int32) (repo.DmParticipant, error) {
+	return r.queries.AckDMChannel(ctx, repo.AckDMChannelParams{
+		MessageID:   messageID,
+		DmChannelID: dmChannelID,
+		UserID:      userID,
+	})
+}
@@ -4,11 +4,16 @@ import (
 	"context"
 	"errors"
 
-	"discord/config"
 	"discord/gen/repo"
 	commonErrors "discord/internal/common/errors"
 	"discord/internal/dm/repository"
+	dmUtil "discord/internal/dm/util"
+	gatewayUtil "discord/internal/gateway/util"
+	messageUtil "discord/internal/message/util"
+	userUtil "discord/internal/user/util"
 	"discord/pkg/pubsub"
+
+	"github.com/jackc/pgx/v5"
 )
 
 type MessageService struct {
@@ -16,27 +21,395 @@ type MessageService struct {
 	pubsub      *pubsub.PubSub
 }
 
-func NewMessageService() *MessageService {
-	db := config.GetWriteDb()
+func NewMessageService(messageRepo *repository.DMRepository) *MessageService {
 	return &MessageService{
-		messageRepo: repository.NewDMRepository(db),
+		messageRepo: messageRepo,
 		pubsub:      pubsub.Get(),
 	}
 }
 
-func (s *MessageService) SendMessage(ctx context.Context, reciver_id, senderID int32, content string, replyToMessageID *int32, mentionEveryone bool) (repo.Message, error) {
-	if content == "" {
-		return repo.Message{}, commonErrors.ErrInvalidInput
+// SendMessage stores a direct message if the recipient's privacy settings and
+// blocks allow it. Messages from users who are not friends are held in the
+// recipient's message requests until they accept.
+func (s *MessageService) SendMessage(ctx context.Context, reciver_id, senderID int32, content string, replyToMessageID *int32, mentionEveryone bool) (repo.Message, dmUtil.Delivery, error) {
+	if content == "" || reciver_id == senderID {
+		return repo.Message{}, 0, commonErrors.ErrInvalidInput
+	}
+
+	delivery, err := s.checkDelivery(ctx, senderID, reciver_id)
+	if err != nil {
+		return repo.Message{}, 0, err
 	}
 
 	if replyToMessageID != nil {
 		_, err := s.messageRepo.GetChatMessageByID(ctx, *replyToMessageID)
 		if err != nil {
+			return repo.Message{}, 0, errors.New("reply message not found")
+		}
+	}
+
+	if delivery == dmUtil.DeliveryRequest {
+		if _, err := s.messageRepo.OpenMessageRequest(ctx, senderID, reciver_id); err != nil {
+			return repo.Message{}, 0, commonErrors.ErrInternalServer
+		}
+	}
+
+	message, err := s.messageRepo.CreateDMMessage(ctx, reciver_id, senderID, content, "default", replyToMessageID, mentionEveryone)
+	return message, delivery, err
+}
+
+// checkDelivery decides where a message from sender to recipient ends up.
+// Replying to a message request accepts it.
+func (s *MessageService) checkDelivery(ctx context.Context, senderID, recipientID int32) (dmUtil.Delivery, error) {
+	var relation dmUtil.DMRelation
+	var err error
+
+	if relation.Blocked, err = s.messageRepo.IsBlockedBetween(ctx, senderID, recipientID); err != nil {
+		return 0, commonErrors.ErrInternalServer
+	}
+	if relation.Blocked {
+		return dmUtil.CheckDelivery(relation)
+	}
+
+	if relation.Friends, err = s.messageRepo.AreFriends(ctx, senderID, recipientID); err != nil {
+		return 0, commonErrors.ErrInternalServer
+	}
+	if !relation.Friends {
+		incoming, err := s.messageRepo.GetMessageRequest(ctx, recipientID, senderID)
+		if err == nil && incoming.Status != dmUtil.RequestAccepted {
+			if _, err := s.messageRepo.SetMessageRequestStatus(ctx, recipientID, senderID, dmUtil.RequestAccepted); err != nil {
+				return 0, commonErrors.ErrInternalServer
+			}
+			relation.Accepted = true
+		} else if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return 0, commonErrors.ErrInternalServer
+		}
+	}
+	if !relation.Friends && !relation.Accepted {
+		if relation.Accepted, err = s.messageRepo.HasAcceptedMessageRequest(ctx, senderID, recipientID); err != nil {
+			return 0, commonErrors.ErrInternalServer
+		}
+	}
+
+	if relation.DMPrivacy, err = s.messageRepo.GetDMPrivacy(ctx, recipientID); err != nil {
+		return 0, commonErrors.ErrInternalServer
+	}
+	if relation.DMPrivacy == userUtil.DMPrivacyServerMembers {
+		if relation.SharesServer, err = s.messageRepo.SharesServerAllowingDMs(ctx, senderID, recipientID); err != nil {
+			return 0, commonErrors.ErrInternalServer
+		}
+	}
+
+	return dmUtil.CheckDelivery(relation)
+}
+
+// GetMessageRequests lists the pending message requests of a user
+func (s *MessageService) GetMessageRequests(ctx context.Context, userID int32) ([]repo.ListMessageRequestsRow, error) {
+	return s.messageRepo.ListMessageRequests(ctx, userID)
+}
+
+// AcceptMessageRequest moves the conversation with the sender into the user's DMs
+func (s *MessageService) AcceptMessageRequest(ctx context.Context, userID, senderID int32) error {
+	return s.setMessageRequestStatus(ctx, userID, senderID, dmUtil.RequestAccepted)
+}
+
+// IgnoreMessageRequest hides the request. The sender is not told and may keep
+// sending messages, which stay out of the user's inbox.
+func (s *MessageService) IgnoreMessageRequest(ctx context.Context, userID, senderID int32) error {
+	return s.setMessageRequestStatus(ctx, userID, senderID, dmUtil.RequestIgnored)
+}
+
+func (s *MessageService) setMessageRequestStatus(ctx context.Context, userID, senderID int32, status string) error {
+	_, err := s.messageRepo.SetMessageRequestStatus(ctx, senderID, userID, status)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return commonErrors.ErrNotFound
+	}
+	if err != nil {
+		return commonErrors.ErrInternalServer
+	}
+	return nil
+}
+
+// GroupDM is a group DM channel with its participants in join order
+type GroupDM struct {
+	repo.DmChannel
+	ParticipantIDs []int32
+}
+
+// CreateGroupDM opens a group DM owned by ownerID. Recipients must be friends
+// of the owner.
+func (s *MessageService) CreateGroupDM(ctx context.Context, ownerID int32, recipientIDs []int32, name string) (GroupDM, error) {
+	recipients := make([]int32, 0, len(recipientIDs))
+	for _, recipientID := range recipientIDs {
+		if recipientID != ownerID && !dmUtil.ContainsParticipant(recipients, recipientID) {
+			recipients = append(recipients, recipientID)
+		}
+	}
+	if len(recipients) == 0 || !dmUtil.ValidateGroupDMSize(len(recipients)+1) {
+		return GroupDM{}, commonErrors.ErrInvalidInput
+	}
+	if name != "" && !dmUtil.ValidateDMChannelName(name) {
+		return GroupDM{}, commonErrors.ErrInvalidInput
+	}
+
+	for _, recipientID := range recipients {
+		if err := s.checkGroupRecipient(ctx, ownerID, recipientID); err != nil {
+			return GroupDM{}, err
+		}
+	}
+
+	channel, participantIDs, err := s.messageRepo.CreateGroupDM(ctx, ownerID, name, recipients)
+	if err != nil {
+		return GroupDM{}, commonErrors.ErrInternalServer
+	}
+
+	group := GroupDM{DmChannel: channel, ParticipantIDs: participantIDs}
+	s.publishGroupDM(group)
+	return group, nil
+}
+
+// checkGroupRecipient allows adding only friends who did not block the adder
+func (s *MessageService) checkGroupRecipient(ctx context.Context, adderID, userID int32) error {
+	blocked, err := s.messageRepo.IsBlockedBetween(ctx, adderID, userID)
+	if err != nil {
+		return commonErrors.ErrInternalServer
+	}
+	if blocked {
+		return commonErrors.ErrBlocked
+	}
+
+	friends, err := s.messageRepo.AreFriends(ctx, adderID, userID)
+	if err != nil {
+		return commonErrors.ErrInternalServer
+	}
+	if !friends {
+		return commonErrors.ErrPermissionDenied
+	}
+	return nil
+}
+
+// GetDMChannels lists the DM channels of a user, most recently active first,
+// with their unread counts
+func (s *MessageService) GetDMChannels(ctx context.Context, userID int32) ([]repo.ListUserDMChannelsRow, error) {
+	return s.messageRepo.ListUserDMChannels(ctx, userID)
+}
+
+// getGroupDM loads a group DM the user participates in. Group DMs of others
+// are reported as not found.
+func (s *MessageService) getGroupDM(ctx context.Context, dmChannelID, userID int32) (GroupDM, error) {
+	channel, err := s.messageRepo.GetDMChannel(ctx, dmChannelID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return GroupDM{}, commonErrors.ErrNotFound
+	}
+	if err != nil {
+		return GroupDM{}, commonErrors.ErrInternalServer
+	}
+
+	participantIDs, err := s.messageRepo.GetDMParticipantIDs(ctx, dmChannelID)
+	if err != nil {
+		return GroupDM{}, commonErrors.ErrInternalServer
+	}
+	if !channel.IsGroup.Bool || !dmUtil.ContainsParticipant(participantIDs, userID) {
+		return GroupDM{}, commonErrors.ErrNotFound
+	}
+	return GroupDM{DmChannel: channel, ParticipantIDs: participantIDs}, nil
+}
+
+func (g GroupDM) permissions(userID int32) dmUtil.DMPermissions {
+	return dmUtil.GetDefaultDMPermissions(g.OwnerID.Valid && g.OwnerID.Int32 == userID)
+}
+
+// UpdateGroupDM renames a group DM or changes its icon. Nil values are left
+// unchanged and empty ones clear the name or icon.
+func (s *MessageService) UpdateGroupDM(ctx context.Context, userID, dmChannelID int32, name, icon *string) (GroupDM, error) {
+	group, err := s.getGroupDM(ctx, dmChannelID, userID)
+	if err != nil {
+		return GroupDM{}, err
+	}
+	if !group.permissions(userID).CanManageChannel {
+		return GroupDM{}, commonErrors.ErrPermissionDenied
+	}
+
+	newName, newIcon := group.Name.String, group.Icon.String
+	if name != nil {
+		if *name != "" && !dmUtil.ValidateDMChannelName(*name) {
+			return GroupDM{}, commonErrors.ErrInvalidInput
+		}
+		newName = *name
+	}
+	if icon != nil {
+		if len(*icon) > 255 {
+			return GroupDM{}, commonErrors.ErrInvalidInput
+		}
+		newIcon = *icon
+	}
+
+	group.DmChannel, err = s.messageRepo.UpdateDMChannel(ctx, dmChannelID, newName, newIcon)
+	if err != nil {
+		return GroupDM{}, commonErrors.ErrInternalServer
+	}
+	s.publishGroupDM(group)
+	return group, nil
+}
+
+// AddGroupDMRecipient lets a participant add one of their friends
+func (s *MessageService) AddGroupDMRecipient(ctx context.Context, userID, dmChannelID, recipientID int32) (GroupDM, error) {
+	group, err := s.getGroupDM(ctx, dmChannelID, userID)
+	if err != nil {
+		return GroupDM{}, err
+	}
+	if !group.permissions(userID).CanAddParticipants {
+		return GroupDM{}, commonErrors.ErrPermissionDenied
+	}
+	if dmUtil.ContainsParticipant(group.ParticipantIDs, recipientID) {
+		return GroupDM{}, commonErrors.ErrDuplicate
+	}
+	if !dmUtil.ValidateGroupDMSize(len(group.ParticipantIDs) + 1) {
+		return GroupDM{}, commonErrors.ErrInvalidInput
+	}
+	if err := s.checkGroupRecipient(ctx, userID, recipientID); err != nil {
+		return GroupDM{}, err
+	}
+
+	added, err := s.messageRepo.AddDMParticipant(ctx, group.DmChannel, recipientID)
+	if err != nil {
+		return GroupDM{}, commonErrors.ErrInternalServer
+	}
+	if !added {
+		return GroupDM{}, commonErrors.ErrDuplicate
+	}
+
+	group.ParticipantIDs = append(group.ParticipantIDs, recipientID)
+	s.publishGroupDM(group)
+	return group, nil
+}
+
+// RemoveGroupDMRecipient lets the owner remove another participant
+func (s *MessageService) RemoveGroupDMRecipient(ctx context.Context, userID, dmChannelID, recipientID int32) (GroupDM, error) {
+	group, err := s.getGroupDM(ctx, dmChannelID, userID)
+	if err != nil {
+		return GroupDM{}, err
+	}
+	if !group.permissions(userID).CanRemoveParticipants {
+		return GroupDM{}, commonErrors.ErrPermissionDenied
+	}
+	if recipientID == userID {
+		return GroupDM{}, commonErrors.ErrInvalidInput
+	}
+	if !dmUtil.ContainsParticipant(group.ParticipantIDs, recipientID) {
+		return GroupDM{}, commonErrors.ErrNotFound
+	}
+
+	return s.removeParticipant(ctx, dmChannelID, recipientID)
+}
+
+// LeaveGroupDM removes the user from a group DM. An owner who leaves passes
+// ownership to the longest standing participant.
+func (s *MessageService) LeaveGroupDM(ctx context.Context, userID, dmChannelID int32) error {
+	if _, err := s.getGroupDM(ctx, dmChannelID, userID); err != nil {
+		return err
+	}
+	_, err := s.removeParticipant(ctx, dmChannelID, userID)
+	return err
+}
+
+func (s *MessageService) removeParticipant(ctx context.Context, dmChannelID, userID int32) (GroupDM, error) {
+	channel, remaining, err := s.messageRepo.RemoveDMParticipant(ctx, dmChannelID, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return GroupDM{}, commonErrors.ErrNotFound
+	}
+	if err != nil {
+		return GroupDM{}, commonErrors.ErrInternalServer
+	}
+
+	group := GroupDM{DmChannel: channel, ParticipantIDs: remaining}
+	s.publishGroupDMRemoval(dmChannelID, userID)
+	s.publishGroupDM(group)
+	return group, nil
+}
+
+// TransferGroupDMOwnership hands a group DM over to another participant
+func (s *MessageService) TransferGroupDMOwnership(ctx context.Context, userID, dmChannelID, newOwnerID int32) (GroupDM, error) {
+	group, err := s.getGroupDM(ctx, dmChannelID, userID)
+	if err != nil {
+		return GroupDM{}, err
+	}
+	if !group.permissions(userID).CanManageChannel {
+		return GroupDM{}, commonErrors.ErrPermissionDenied
+	}
+	if newOwnerID == userID || !dmUtil.ContainsParticipant(group.ParticipantIDs, newOwnerID) {
+		return GroupDM{}, commonErrors.ErrInvalidInput
+	}
+
+	group.DmChannel, err = s.messageRepo.SetDMChannelOwner(ctx, dmChannelID, newOwnerID)
+	if err != nil {
+		return GroupDM{}, commonErrors.ErrInternalServer
+	}
+	s.publishGroupDM(group)
+	return group, nil
+}
+
+// SendGroupDMMessage posts a message to a group DM and marks the group DM as
+// read for the sender
+func (s *MessageService) SendGroupDMMessage(ctx context.Context, userID, dmChannelID int32, content string, replyToMessageID *int32) (repo.Message, error) {
+	if content == "" {
+		return repo.Message{}, commonErrors.ErrInvalidInput
+	}
+
+	group, err := s.getGroupDM(ctx, dmChannelID, userID)
+	if err != nil {
+		return repo.Message{}, err
+	}
+
+	if replyToMessageID != nil {
+		reply, err := s.messageRepo.GetChatMessageByID(ctx, *replyToMessageID)
+		if err != nil || reply.DmChannelID.Int32 != dmChannelID {
 			return repo.Message{}, errors.New("reply message not found")
 		}
 	}
 
-	return s.messageRepo.CreateDMMessage(ctx, reciver_id, senderID, content, "default", replyToMessageID, mentionEveryone)
+	message, err := s.messageRepo.CreateDMChannelMessage(ctx, dmChannelID, userID, content, replyToMessageID)
+	if err != nil {
+		return repo.Message{}, commonErrors.ErrInternalServer
+	}
+	if _, err := s.messageRepo.AckDMChannel(ctx, dmChannelID, userID, message.ID); err != nil {
+		return repo.Message{}, commonErrors.ErrInternalServer
+	}
+
+	s.publishToParticipants(group.ParticipantIDs, gatewayUtil.MessageCreate(messageUtil.ConvertMessageToProto(message)))
+	return message, nil
+}
+
+// GetGroupDMMessages pages through the messages of a group DM, newest first
+func (s *MessageService) GetGroupDMMessages(ctx context.Context, userID, dmChannelID, limit int32, beforeMessageID *int32) ([]repo.Message, error) {
+	if _, err := s.getGroupDM(ctx, dmChannelID, userID); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = 50
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	return s.messageRepo.GetDMChannelMessages(ctx, dmChannelID, beforeMessageID, limit)
+}
+
+// AckDMChannel marks a DM channel as read up to messageID and returns the
+// user's read marker, which never moves backwards
+func (s *MessageService) AckDMChannel(ctx context.Context, userID, dmChannelID, messageID int32) (int32, error) {
+	if messageID <= 0 {
+		return 0, commonErrors.ErrInvalidInput
+	}
+
+	participant, err := s.messageRepo.AckDMChannel(ctx, dmChannelID, userID, messageID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, commonErrors.ErrNotFound
+	}
+	if err != nil {
+		return 0, commonErrors.ErrInternalServer
+	}
+	return participant.LastReadMessageID.Int32, nil
 }
 
 func (s *MessageService) GetMessage(ctx context.Context, messageID int32) (repo.Message, error) {
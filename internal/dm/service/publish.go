@@ -0,0 +1,28 @@
+package service
+
+import (
+	"discord/gen/proto/schema"
+	gatewayPb "discord/gen/proto/service/gateway"
+	dmUtil "discord/internal/dm/util"
+	gatewayUtil "discord/internal/gateway/util"
+)
+
+// publishToParticipants sends a gateway event to each of the given users
+func (s *MessageService) publishToParticipants(userIDs []int32, event *gatewayPb.GatewayEvent) {
+	for _, userID := range userIDs {
+		s.pubsub.Publish(gatewayUtil.DMTopic(userID), event)
+	}
+}
+
+// publishGroupDM sends a DM_CHANNEL_UPDATE with the group DM to its participants
+func (s *MessageService) publishGroupDM(group GroupDM) {
+	s.publishToParticipants(group.ParticipantIDs, gatewayUtil.DMChannelUpdate(dmUtil.ConvertDMChannelToProto(group.DmChannel, group.ParticipantIDs)))
+}
+
+// publishGroupDMRemoval tells a user who is no longer part of a group DM to drop it
+func (s *MessageService) publishGroupDMRemoval(dmChannelID, userID int32) {
+	s.publishToParticipants([]int32{userID}, gatewayUtil.DMChannelUpdate(&schema.DMChannel{
+		Id:        dmChannelID,
+		IsDeleted: true,
+	}))
+}
@@ -0,0 +1,53 @@
+package util
+
+import (
+	"discord/gen/proto/schema"
+	"discord/gen/repo"
+)
+
+// ConvertDMChannelToProto converts a DM channel and its participants to proto format
+func ConvertDMChannelToProto(channel repo.DmChannel, participantIDs []int32) *schema.DMChannel {
+	pbChannel := &schema.DMChannel{
+		Id:             channel.ID,
+		ParticipantIds: participantIDs,
+		Name:           channel.Name.String,
+		Icon:           channel.Icon.String,
+		OwnerId:        channel.OwnerID.Int32,
+		LastMessageId:  channel.LastMessageID.Int32,
+		CreatedAt:      channel.CreatedAt.Time.Unix(),
+	}
+
+	if channel.LastMessageAt.Valid {
+		pbChannel.LastMessageAt = channel.LastMessageAt.Time.Unix()
+	}
+
+	return pbChannel
+}
+
+// ConvertDMChannelRowToProto converts a DM channel as listed for one user,
+// including their read marker and unread count
+func ConvertDMChannelRowToProto(row repo.ListUserDMChannelsRow) *schema.DMChannel {
+	pbChannel := ConvertDMChannelToProto(repo.DmChannel{
+		ID:            row.ID,
+		Name:          row.Name,
+		Icon:          row.Icon,
+		OwnerID:       row.OwnerID,
+		IsGroup:       row.IsGroup,
+		LastMessageID: row.LastMessageID,
+		LastMessageAt: row.LastMessageAt,
+		CreatedAt:     row.CreatedAt,
+	}, row.ParticipantIds)
+	pbChannel.LastReadMessageId = row.LastReadMessageID.Int32
+	pbChannel.UnreadCount = row.UnreadCount
+	return pbChannel
+}
+
+// ContainsParticipant reports whether userID is one of the participants
+func ContainsParticipant(participantIDs []int32, userID int32) bool {
+	for _, id := range participantIDs {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,53 @@
+package util
+
+import (
+	commonErrors "discord/internal/common/errors"
+	userUtil "discord/internal/user/util"
+)
+
+// Statuses of a message request
+const (
+	RequestPending  = "pending"
+	RequestAccepted = "accepted"
+	RequestIgnored  = "ignored"
+)
+
+// Delivery is where a direct message ends up for its recipient
+type Delivery int
+
+const (
+	DeliveryDirect  Delivery = iota // In the recipient's DMs
+	DeliveryRequest                 // In the recipient's message requests
+)
+
+// DMRelation is what the delivery rules know about a sender and a recipient
+type DMRelation struct {
+	Blocked      bool   // Either user blocked the other
+	Friends      bool   // The users are friends
+	Accepted     bool   // One of them accepted a message request from the other
+	SharesServer bool   // They share a server the recipient allows DMs from
+	DMPrivacy    string // The recipient's dm_privacy setting
+}
+
+// CheckDelivery applies the recipient's DM privacy to a message. Blocks win
+// over everything; friends and accepted conversations are delivered directly.
+// Everyone else is held as a message request if the privacy setting lets
+// them message the recipient at all.
+func CheckDelivery(relation DMRelation) (Delivery, error) {
+	if relation.Blocked {
+		return 0, commonErrors.ErrBlocked
+	}
+	if relation.Friends || relation.Accepted {
+		return DeliveryDirect, nil
+	}
+
+	switch relation.DMPrivacy {
+	case userUtil.DMPrivacyFriends:
+		return 0, commonErrors.ErrDMsRestricted
+	case userUtil.DMPrivacyServerMembers:
+		if !relation.SharesServer {
+			return 0, commonErrors.ErrDMsRestricted
+		}
+	}
+	return DeliveryRequest, nil
+}
@@ -0,0 +1,37 @@
+package util
+
+import (
+	"testing"
+
+	commonErrors "discord/internal/common/errors"
+	userUtil "discord/internal/user/util"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckDelivery(t *testing.T) {
+	tests := []struct {
+		name     string
+		relation DMRelation
+		delivery Delivery
+		err      error
+	}{
+		{"blocked friends", DMRelation{Blocked: true, Friends: true, DMPrivacy: userUtil.DMPrivacyEveryone}, 0, commonErrors.ErrBlocked},
+		{"friends with friends only", DMRelation{Friends: true, DMPrivacy: userUtil.DMPrivacyFriends}, DeliveryDirect, nil},
+		{"accepted with friends only", DMRelation{Accepted: true, DMPrivacy: userUtil.DMPrivacyFriends}, DeliveryDirect, nil},
+		{"stranger with friends only", DMRelation{DMPrivacy: userUtil.DMPrivacyFriends}, 0, commonErrors.ErrDMsRestricted},
+		{"stranger with server members", DMRelation{DMPrivacy: userUtil.DMPrivacyServerMembers}, 0, commonErrors.ErrDMsRestricted},
+		{"server member", DMRelation{SharesServer: true, DMPrivacy: userUtil.DMPrivacyServerMembers}, DeliveryRequest, nil},
+		{"stranger with everyone", DMRelation{DMPrivacy: userUtil.DMPrivacyEveryone}, DeliveryRequest, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delivery, err := CheckDelivery(tt.relation)
+			assert.ErrorIs(t, err, tt.err)
+			if tt.err == nil {
+				assert.Equal(t, tt.delivery, delivery)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	friendPb "discord/gen/proto/service/friend"
 	commonErrors "discord/internal/common/errors"
 	friendService "discord/internal/friend/service"
+	"discord/pkg/pubsub"
 )
 
 type FriendController struct {
@@ -156,7 +157,9 @@ func (c *FriendController) SearchFriends(ctx context.Context, req *friendPb.Sear
 }
 
 func (c *FriendController) StreamFriendUpdates(req *friendPb.StreamFriendUpdatesRequest, stream friendPb.FriendService_StreamFriendUpdatesServer) error {
-	ch := friendService.Stream(req.GetUserId())
+	// A client too slow to keep up is disconnected rather than silently
+	// missing friend requests
+	ch := friendService.Stream(req.GetUserId(), pubsub.WithPolicy(pubsub.Disconnect))
 	defer ch.Close()
 	for data := range ch.Receive() {
 		update, ok := data.(*schema.Friend)
@@ -168,5 +171,8 @@ func (c *FriendController) StreamFriendUpdates(req *friendPb.StreamFriendUpdates
 			return commonErrors.ToGRPCError(err)
 		}
 	}
+	if ch.Err() != nil {
+		return commonErrors.ToGRPCError(commonErrors.ErrSlowConsumer)
+	}
 	return nil
 }
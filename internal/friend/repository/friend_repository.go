@@ -107,11 +107,29 @@ func (r *FriendRepository) ToggleFavorite(ctx context.Context, userID, friendID
 
 // DeleteFriendship deletes a bidirectional friendship
 func (r *FriendRepository) DeleteFriendship(ctx context.Context, userID, friendID int32) error {
-	_, err := r.queries.SoftDeleteFriendship(ctx, repo.SoftDeleteFriendshipParams{
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+	// Record both directions in the deletion log before they are soft deleted
+	_, err = qtx.CreateFriendshipDeletedEntities(ctx, repo.CreateFriendshipDeletedEntitiesParams{
 		UserID:   userID,
 		FriendID: friendID,
 	})
-	return err
+	if err != nil {
+		return err
+	}
+	_, err = qtx.SoftDeleteFriendship(ctx, repo.SoftDeleteFriendshipParams{
+		UserID:   userID,
+		FriendID: friendID,
+	})
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
 }
 
 // BlockUser blocks a user
@@ -240,7 +240,7 @@ func (s *FriendService) BlockUser(ctx context.Context, userID, targetID int32) e
 		IsDeleted: true,
 		Status:    "removed",
 	})
-	return s.friendRepo.CreateFriendship(ctx, userID, targetID, "blocked")
+	return s.friendRepo.BlockUser(ctx, userID, targetID)
 }
 
 // UnblockUser unblocks a user
@@ -15,8 +15,8 @@ func (s *FriendService) publishToFriend(id int32, friend *schema.Friend) {
 	s.pubsub.Publish(topic, friend)
 }
 
-func Stream(id int32) *pubsub.Channel {
+func Stream(id int32, opts ...pubsub.Option) *pubsub.Channel {
 	ps := pubsub.Get()
-	ch := ps.Subscribe(Topic(id))
+	ch := ps.Subscribe(Topic(id), opts...)
 	return ch
 }
@@ -0,0 +1,150 @@
+package controller
+
+import (
+	"errors"
+	"io"
+
+	gatewayPb "discord/gen/proto/service/gateway"
+	commonErrors "discord/internal/common/errors"
+	gatewayService "discord/internal/gateway/service"
+)
+
+type GatewayController struct {
+	gatewayPb.UnimplementedGatewayServiceServer
+	gatewayService *gatewayService.GatewayService
+}
+
+func NewGatewayController(gatewayService *gatewayService.GatewayService) *gatewayPb.GatewayServiceServer {
+	controller := &GatewayController{
+		gatewayService: gatewayService,
+	}
+	var grpcController gatewayPb.GatewayServiceServer = controller
+	return &grpcController
+}
+
+// Gateway runs one real-time session: the client sends IDENTIFY, receives
+// READY and then every event of the session until it disconnects. A client
+// that reconnects with RESUME instead gets the events it missed, then RESUMED.
+func (c *GatewayController) Gateway(stream gatewayPb.GatewayService_GatewayServer) error {
+	ctx := stream.Context()
+
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	var (
+		session *gatewayService.Session
+		conn    <-chan struct{}
+		sent    int64
+	)
+	switch {
+	case req.GetIdentify() != nil:
+		userID, err := c.gatewayService.Identify(ctx, req.GetIdentify().GetToken())
+		if err != nil {
+			return commonErrors.ToGRPCError(err)
+		}
+
+		var ready *gatewayPb.Ready
+		session, conn, ready, err = c.gatewayService.Connect(ctx, userID)
+		if err != nil {
+			return commonErrors.ToGRPCError(err)
+		}
+		defer c.gatewayService.Detach(session, conn)
+
+		// READY is dispatched like any other event so that it can be replayed
+		readyEvent := session.Dispatch(&gatewayPb.GatewayEvent{
+			Type:    gatewayPb.GatewayEventType_READY,
+			Payload: &gatewayPb.GatewayEvent_Ready{Ready: ready},
+		})
+		if err := stream.Send(readyEvent); err != nil {
+			return err
+		}
+		sent = readyEvent.Sequence
+	case req.GetResume() != nil:
+		resume := req.GetResume()
+		userID, err := c.gatewayService.Identify(ctx, resume.GetToken())
+		if err != nil {
+			return commonErrors.ToGRPCError(err)
+		}
+
+		var missed []*gatewayPb.GatewayEvent
+		session, conn, missed, err = c.gatewayService.Resume(userID, resume.GetSessionId(), resume.GetLastSequence())
+		if errors.Is(err, commonErrors.ErrSessionInvalid) {
+			return stream.Send(&gatewayPb.GatewayEvent{Type: gatewayPb.GatewayEventType_INVALID_SESSION})
+		}
+		if err != nil {
+			return commonErrors.ToGRPCError(err)
+		}
+		defer c.gatewayService.Detach(session, conn)
+
+		sent = resume.GetLastSequence()
+		for _, event := range missed {
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+			sent = event.Sequence
+		}
+		err = stream.Send(&gatewayPb.GatewayEvent{Type: gatewayPb.GatewayEventType_RESUMED})
+		if err != nil {
+			return err
+		}
+	default:
+		return commonErrors.ToGRPCError(commonErrors.ErrInvalidInput)
+	}
+
+	// Client messages are read on their own goroutine so that only this one
+	// calls Send
+	heartbeats := make(chan struct{}, 1)
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			if req.GetHeartbeat() != nil {
+				select {
+				case heartbeats <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-conn:
+			// Another stream resumed the session
+			return nil
+		case <-session.Done():
+			return stream.Send(&gatewayPb.GatewayEvent{Type: gatewayPb.GatewayEventType_INVALID_SESSION})
+		case err := <-recvErr:
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		case <-heartbeats:
+			err := stream.Send(&gatewayPb.GatewayEvent{Type: gatewayPb.GatewayEventType_HEARTBEAT_ACK})
+			if err != nil {
+				return err
+			}
+		case <-session.Notify():
+			events, ok := session.Since(sent)
+			if !ok {
+				// The stream fell further behind than the replay buffer reaches
+				c.gatewayService.Invalidate(session)
+				return stream.Send(&gatewayPb.GatewayEvent{Type: gatewayPb.GatewayEventType_INVALID_SESSION})
+			}
+			for _, event := range events {
+				if err := stream.Send(event); err != nil {
+					return err
+				}
+				sent = event.Sequence
+			}
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+
+	"discord/gen/repo"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type GatewayRepository struct {
+	db      *pgxpool.Pool
+	queries *repo.Queries
+}
+
+func NewGatewayRepository(db *pgxpool.Pool) *GatewayRepository {
+	return &GatewayRepository{
+		db:      db,
+		queries: repo.New(db),
+	}
+}
+
+// GetUser retrieves the user opening the gateway
+func (r *GatewayRepository) GetUser(ctx context.Context, userID int32) (repo.User, error) {
+	return r.queries.GetUserByID(ctx, userID)
+}
+
+// GetUserServers retrieves the servers a user is a member of
+func (r *GatewayRepository) GetUserServers(ctx context.Context, userID int32) ([]repo.Server, error) {
+	return r.queries.GetUserServers(ctx, userID)
+}
+
+// GetServerChannels retrieves all channels of a server
+func (r *GatewayRepository) GetServerChannels(ctx context.Context, serverID int32) ([]repo.Channel, error) {
+	return r.queries.GetServerChannels(ctx, serverID)
+}
+
+// GetFriends retrieves a user's accepted friendships
+func (r *GatewayRepository) GetFriends(ctx context.Context, userID int32) ([]repo.Friend, error) {
+	return r.queries.GetAcceptedFriends(ctx, userID)
+}
+
+// GetDMChannels retrieves the DM channels of a user with their unread counts
+func (r *GatewayRepository) GetDMChannels(ctx context.Context, userID int32) ([]repo.ListUserDMChannelsRow, error) {
+	return r.queries.ListUserDMChannels(ctx, userID)
+}
+
+// GetReadStates retrieves the read states of a user in the channels
+func (r *GatewayRepository) GetReadStates(ctx context.Context, userID int32, channelIDs []int32) ([]repo.ListChannelReadStatesRow, error) {
+	return r.queries.ListChannelReadStates(ctx, repo.ListChannelReadStatesParams{
+		UserID:     userID,
+		ChannelIds: channelIDs,
+	})
+}
+
+// GetPresences retrieves the presences of several users
+func (r *GatewayRepository) GetPresences(ctx context.Context, userIDs []int32) ([]repo.UserPresence, error) {
+	return r.queries.GetMultipleUserPresences(ctx, userIDs)
+}
@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"discord/gen/proto/schema"
+	gatewayPb "discord/gen/proto/service/gateway"
+	authService "discord/internal/auth/service"
+	channelUtil "discord/internal/channel/util"
+	commonErrors "discord/internal/common/errors"
+	dmUtil "discord/internal/dm/util"
+	friendUtil "discord/internal/friend/util"
+	gatewayRepo "discord/internal/gateway/repository"
+	"discord/internal/gateway/util"
+	permissionService "discord/internal/permission/service"
+	readStateUtil "discord/internal/readstate/util"
+	syncUtil "discord/internal/sync/util"
+)
+
+// SessionResumeTimeout is how long a session without a stream can be resumed
+const SessionResumeTimeout = 2 * time.Minute
+
+type GatewayService struct {
+	gatewayRepo *gatewayRepo.GatewayRepository
+	permissions *permissionService.Resolver
+	auth        *authService.AuthService
+
+	mu           sync.Mutex
+	sessions     map[string]*Session
+	onDisconnect []func(userID int32)
+}
+
+func NewGatewayService(gatewayRepo *gatewayRepo.GatewayRepository, permissions *permissionService.Resolver, auth *authService.AuthService) *GatewayService {
+	return &GatewayService{
+		gatewayRepo: gatewayRepo,
+		permissions: permissions,
+		auth:        auth,
+		sessions:    make(map[string]*Session),
+	}
+}
+
+// Identify authenticates a gateway connection and returns the user id. Tokens
+// of revoked sessions are rejected.
+func (s *GatewayService) Identify(ctx context.Context, token string) (int32, error) {
+	if token == "" {
+		return 0, commonErrors.ErrUnauthorized
+	}
+	userID, _, err := s.auth.ValidateAccessToken(ctx, token)
+	if err != nil {
+		return 0, commonErrors.ErrInvalidToken
+	}
+	return userID, nil
+}
+
+// Connect builds the READY payload for a user and opens a session subscribed
+// to every topic whose events the user may see. The session is attached to
+// the calling stream; the returned channel is closed once another stream
+// resumes it.
+func (s *GatewayService) Connect(ctx context.Context, userID int32) (*Session, <-chan struct{}, *gatewayPb.Ready, error) {
+	user, err := s.gatewayRepo.GetUser(ctx, userID)
+	if err != nil {
+		return nil, nil, nil, commonErrors.ErrNotFound
+	}
+
+	servers, err := s.gatewayRepo.GetUserServers(ctx, userID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var channels []*schema.Channel
+	serverIDs := make([]int32, len(servers))
+	visible := make(map[int32][]int32, len(servers))
+	for i, server := range servers {
+		serverIDs[i] = server.ID
+		visible[server.ID] = []int32{}
+		serverChannels, err := s.gatewayRepo.GetServerChannels(ctx, server.ID)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		for _, channel := range serverChannels {
+			perms, err := s.permissions.ChannelPermissions(ctx, channel.ID, userID)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			if channelUtil.CanViewChannel(perms) {
+				channels = append(channels, syncUtil.ConvertChannel(channel))
+				visible[server.ID] = append(visible[server.ID], channel.ID)
+			}
+		}
+	}
+
+	friendships, err := s.gatewayRepo.GetFriends(ctx, userID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	friends := make([]*schema.Friend, len(friendships))
+	friendIDs := make([]int32, len(friendships))
+	for i, friendship := range friendships {
+		friends[i] = friendUtil.ConvertFriendToProto(friendship)
+		friendIDs[i] = friendship.FriendID
+		if friendship.FriendID == userID {
+			friendIDs[i] = friendship.UserID
+		}
+	}
+
+	var presences []*schema.UserPresence
+	if len(friendIDs) > 0 {
+		rows, err := s.gatewayRepo.GetPresences(ctx, friendIDs)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		presences = make([]*schema.UserPresence, len(rows))
+		for i, row := range rows {
+			presences[i] = util.ConvertPresence(row)
+			// Invisible users look offline to everyone else
+			if presences[i].Status == schema.UserStatus_INVISIBLE {
+				presences[i].Status = schema.UserStatus_OFFLINE
+			}
+		}
+	}
+
+	dmRows, err := s.gatewayRepo.GetDMChannels(ctx, userID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	dmChannels := make([]*schema.DMChannel, len(dmRows))
+	for i, row := range dmRows {
+		dmChannels[i] = dmUtil.ConvertDMChannelRowToProto(row)
+	}
+
+	channelIDs := make([]int32, len(channels))
+	for i, channel := range channels {
+		channelIDs[i] = channel.Id
+	}
+	var readStates []*schema.ReadState
+	if len(channelIDs) > 0 {
+		rows, err := s.gatewayRepo.GetReadStates(ctx, userID, channelIDs)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		readStates = readStateUtil.ConvertReadStatesToProto(rows)
+	}
+
+	session := newSession(userID, visible, func(ctx context.Context, serverID int32) ([]int32, error) {
+		return s.permissions.ChannelsWithPermission(ctx, serverID, userID, channelUtil.PermissionViewChannel)
+	})
+	conn := session.attach()
+
+	s.mu.Lock()
+	s.sessions[session.ID] = session
+	s.mu.Unlock()
+
+	ready := &gatewayPb.Ready{
+		SessionId:        session.ID,
+		User:             syncUtil.ConvertUser(user),
+		Servers:          syncUtil.ConvertServers(servers),
+		Channels:         channels,
+		Friends:          friends,
+		Presences:        presences,
+		DmChannels:       dmChannels,
+		ReadStates:       readStates,
+		ServerReadStates: readStateUtil.ServerReadStates(serverIDs, readStates),
+	}
+	return session, conn, ready, nil
+}
+
+// Resume reattaches a user's session to the calling stream and returns the
+// events dispatched after lastSequence. A session that expired, belongs to
+// someone else or no longer holds all of the missed events is invalid; in the
+// last case it is also closed, since the client has to identify again anyway.
+func (s *GatewayService) Resume(userID int32, sessionID string, lastSequence int64) (*Session, <-chan struct{}, []*gatewayPb.GatewayEvent, error) {
+	s.mu.Lock()
+	session, ok := s.sessions[sessionID]
+	s.mu.Unlock()
+	if !ok || session.UserID != userID {
+		return nil, nil, nil, commonErrors.ErrSessionInvalid
+	}
+
+	missed, ok := session.Since(lastSequence)
+	if !ok {
+		s.Invalidate(session)
+		return nil, nil, nil, commonErrors.ErrSessionInvalid
+	}
+	return session, session.attach(), missed, nil
+}
+
+// OnDisconnect registers fn to run once the last session of a user expired
+func (s *GatewayService) OnDisconnect(fn func(userID int32)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.onDisconnect = append(s.onDisconnect, fn)
+}
+
+// Detach releases a session from a stream that ended. Events keep being
+// buffered until the session is resumed or SessionResumeTimeout passes.
+func (s *GatewayService) Detach(session *Session, conn <-chan struct{}) {
+	if !session.detach(conn) {
+		return
+	}
+	time.AfterFunc(SessionResumeTimeout, func() {
+		if session.expired(SessionResumeTimeout) {
+			s.Invalidate(session)
+			s.disconnected(session.UserID)
+		}
+	})
+}
+
+// disconnected runs the disconnect hooks unless the user still has a session
+func (s *GatewayService) disconnected(userID int32) {
+	s.mu.Lock()
+	for _, session := range s.sessions {
+		if session.UserID == userID {
+			s.mu.Unlock()
+			return
+		}
+	}
+	hooks := s.onDisconnect
+	s.mu.Unlock()
+
+	for _, fn := range hooks {
+		fn(userID)
+	}
+}
+
+// Invalidate forgets a session and closes it, ending any stream attached to it
+func (s *GatewayService) Invalidate(session *Session) {
+	s.mu.Lock()
+	if s.sessions[session.ID] == session {
+		delete(s.sessions, session.ID)
+	}
+	s.mu.Unlock()
+	session.Close()
+}
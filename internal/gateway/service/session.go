@@ -0,0 +1,441 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log"
+	"sync"
+	"time"
+
+	"discord/gen/proto/schema"
+	gatewayPb "discord/gen/proto/service/gateway"
+	friendService "discord/internal/friend/service"
+	"discord/internal/gateway/util"
+	userService "discord/internal/user/service"
+	"discord/pkg/pubsub"
+)
+
+// ReplayBufferSize is how many dispatched events a session keeps for resuming
+const ReplayBufferSize = 500
+
+// resolveTimeout bounds the permission lookup of a subscription change
+const resolveTimeout = 10 * time.Second
+
+// VisibleChannelsFunc returns the channels of a server the session's user can
+// view
+type VisibleChannelsFunc func(ctx context.Context, serverID int32) ([]int32, error)
+
+// Session is one gateway session. It merges the pubsub topics of a user into
+// a single stream of typed events, numbers them in dispatch order and keeps
+// the latest ones so that a reconnecting client can resume without loss.
+// A session outlives the stream it was opened on until it expires.
+//
+// The session follows membership, channel and role events to subscribe to
+// the servers the user joins and the channels they gain VIEW_CHANNEL in, and
+// to drop the ones they lose.
+type Session struct {
+	ID     string
+	UserID int32
+
+	mu         sync.Mutex
+	sequence   int64
+	buffer     []*gatewayPb.GatewayEvent
+	notify     chan struct{}
+	conn       chan struct{}
+	detachedAt time.Time
+
+	subs      []*pubsub.Channel
+	done      chan struct{}
+	closeOnce sync.Once
+
+	topicsMu        sync.Mutex
+	topics          map[string]*pubsub.Channel
+	servers         map[int32]map[int32]struct{} // server id -> visible channel ids
+	visibleChannels VisibleChannelsFunc
+}
+
+// Subscriptions block publishers briefly instead of dropping: the session
+// moves events into its replay buffer as fast as they arrive.
+// servers maps each server of the user to the channels they can view in it.
+func newSession(userID int32, servers map[int32][]int32, visibleChannels VisibleChannelsFunc) *Session {
+	s := &Session{
+		ID:              newSessionID(),
+		UserID:          userID,
+		notify:          make(chan struct{}, 1),
+		done:            make(chan struct{}),
+		topics:          make(map[string]*pubsub.Channel),
+		servers:         make(map[int32]map[int32]struct{}),
+		visibleChannels: visibleChannels,
+	}
+
+	s.forward(userService.StreamUser(userID, pubsub.WithPolicy(pubsub.Block)), func(data interface{}) *gatewayPb.GatewayEvent {
+		user, ok := data.(*schema.User)
+		if !ok {
+			return nil
+		}
+		return util.UserUpdate(user)
+	})
+	s.forward(userService.StreamUserSettings(userID, pubsub.WithPolicy(pubsub.Block)), func(data interface{}) *gatewayPb.GatewayEvent {
+		settings, ok := data.(*schema.UserSettings)
+		if !ok {
+			return nil
+		}
+		return util.UserSettingsUpdate(settings)
+	})
+	s.forward(userService.StreamUserFriendUpdates(userID, pubsub.WithPolicy(pubsub.Block)), func(data interface{}) *gatewayPb.GatewayEvent {
+		user, ok := data.(*schema.User)
+		if !ok {
+			return nil
+		}
+		// Invisible users look offline to everyone else
+		if user.Status == "invisible" {
+			user = &schema.User{Id: user.Id, Username: user.Username, Status: "offline"}
+		}
+		return util.PresenceUpdate(user)
+	})
+	s.forward(friendService.Stream(userID, pubsub.WithPolicy(pubsub.Block)), func(data interface{}) *gatewayPb.GatewayEvent {
+		friend, ok := data.(*schema.Friend)
+		if !ok {
+			return nil
+		}
+		return util.FriendUpdate(friend)
+	})
+
+	s.topicsMu.Lock()
+	defer s.topicsMu.Unlock()
+	s.subscribe(util.DMTopic(userID), s.dispatch)
+	s.subscribe(util.UserTopic(userID), s.userEvent)
+	for serverID, channelIDs := range servers {
+		s.joinLocked(serverID, channelIDs)
+	}
+	return s
+}
+
+// Notify is signalled whenever new events were dispatched
+func (s *Session) Notify() <-chan struct{} {
+	return s.notify
+}
+
+// Sequence returns the sequence number of the latest dispatched event
+func (s *Session) Sequence() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sequence
+}
+
+// Dispatch numbers an event and appends it to the replay buffer, evicting the
+// oldest event once the buffer is full. Published events are shared between
+// sessions, so the sequence is set on a copy.
+func (s *Session) Dispatch(event *gatewayPb.GatewayEvent) *gatewayPb.GatewayEvent {
+	s.mu.Lock()
+	s.sequence++
+	dispatched := &gatewayPb.GatewayEvent{
+		Sequence: s.sequence,
+		Type:     event.Type,
+		Payload:  event.Payload,
+	}
+	if len(s.buffer) == ReplayBufferSize {
+		s.buffer[0] = nil
+		s.buffer = s.buffer[1:]
+	}
+	s.buffer = append(s.buffer, dispatched)
+	s.mu.Unlock()
+
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+	return dispatched
+}
+
+// Since returns the dispatched events after the given sequence number. It
+// returns false when some of them were already evicted from the replay buffer
+// or the sequence was never dispatched, in which case the client must resync.
+func (s *Session) Since(sequence int64) ([]*gatewayPb.GatewayEvent, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if sequence < 0 || sequence > s.sequence {
+		return nil, false
+	}
+	if sequence == s.sequence {
+		return nil, true
+	}
+	oldest := s.buffer[0].Sequence
+	if sequence < oldest-1 {
+		return nil, false
+	}
+	missed := s.buffer[sequence-oldest+1:]
+	events := make([]*gatewayPb.GatewayEvent, len(missed))
+	copy(events, missed)
+	return events, true
+}
+
+// attach marks a stream as the receiver of the session. A stream that was
+// still attached is superseded: the returned channel of its attach is closed.
+func (s *Session) attach() <-chan struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.conn != nil {
+		close(s.conn)
+	}
+	s.conn = make(chan struct{})
+	return s.conn
+}
+
+// detach releases the session from a stream. It reports false when another
+// stream has attached since.
+func (s *Session) detach(conn <-chan struct{}) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.conn == nil || (<-chan struct{})(s.conn) != conn {
+		return false
+	}
+	s.conn = nil
+	s.detachedAt = time.Now()
+	return true
+}
+
+// expired reports whether the session has had no stream for the given time
+func (s *Session) expired(timeout time.Duration) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.conn == nil && time.Since(s.detachedAt) >= timeout
+}
+
+// Done is closed once the session is closed
+func (s *Session) Done() <-chan struct{} {
+	return s.done
+}
+
+// Close unsubscribes the session from all of its topics
+func (s *Session) Close() {
+	s.closeOnce.Do(func() {
+		close(s.done)
+		for _, sub := range s.subs {
+			sub.Close()
+		}
+		s.topicsMu.Lock()
+		for topic, sub := range s.topics {
+			sub.Close()
+			delete(s.topics, topic)
+		}
+		s.topicsMu.Unlock()
+	})
+}
+
+func (s *Session) dispatch(event *gatewayPb.GatewayEvent) {
+	s.Dispatch(event)
+}
+
+// userEvent handles events addressed to the user: joining a server
+// subscribes the session to it before the join is dispatched
+func (s *Session) userEvent(event *gatewayPb.GatewayEvent) {
+	if member := event.GetMember(); member.GetOperation() == util.MemberJoin && member.GetUserId() == s.UserID {
+		s.join(member.GetServerId())
+	}
+	s.Dispatch(event)
+}
+
+// serverEvent returns the handler of a server topic. Events that can change
+// which channels the user can view make the session re-check them; leaving
+// the server unsubscribes from it once the leave was dispatched.
+func (s *Session) serverEvent(serverID int32) func(event *gatewayPb.GatewayEvent) {
+	return func(event *gatewayPb.GatewayEvent) {
+		switch event.Type {
+		case gatewayPb.GatewayEventType_MEMBER_UPDATE:
+			member := event.GetMember()
+			if member.GetUserId() != s.UserID {
+				break
+			}
+			switch member.GetOperation() {
+			case util.MemberLeave, util.MemberKick, util.MemberBan:
+				s.Dispatch(event)
+				s.leave(serverID)
+				return
+			case util.MemberRoles:
+				s.refresh(serverID)
+			}
+		case gatewayPb.GatewayEventType_ROLE_UPDATE:
+			s.refresh(serverID)
+		case gatewayPb.GatewayEventType_CHANNEL_CREATE,
+			gatewayPb.GatewayEventType_CHANNEL_UPDATE,
+			gatewayPb.GatewayEventType_CHANNEL_DELETE:
+			// Channels the user cannot view are not revealed to them; one
+			// they just lost sight of is still announced so it can be dropped
+			channelID := event.GetChannel().GetId()
+			visible := s.canView(serverID, channelID)
+			s.refresh(serverID)
+			if !visible && !s.canView(serverID, channelID) {
+				return
+			}
+		}
+		s.Dispatch(event)
+	}
+}
+
+// channelEvent returns the handler of a channel topic, which only dispatches
+// while the user can view the channel
+func (s *Session) channelEvent(serverID, channelID int32) func(event *gatewayPb.GatewayEvent) {
+	return func(event *gatewayPb.GatewayEvent) {
+		if s.canView(serverID, channelID) {
+			s.Dispatch(event)
+		}
+	}
+}
+
+// canView reports whether the user could view the channel at the last check
+func (s *Session) canView(serverID, channelID int32) bool {
+	s.topicsMu.Lock()
+	defer s.topicsMu.Unlock()
+	_, ok := s.servers[serverID][channelID]
+	return ok
+}
+
+// join subscribes to a server the user became a member of
+func (s *Session) join(serverID int32) {
+	s.topicsMu.Lock()
+	_, joined := s.servers[serverID]
+	s.topicsMu.Unlock()
+	if joined {
+		return
+	}
+
+	channelIDs, ok := s.resolve(serverID)
+	if !ok {
+		return
+	}
+	s.topicsMu.Lock()
+	defer s.topicsMu.Unlock()
+	if _, joined := s.servers[serverID]; !joined {
+		s.joinLocked(serverID, channelIDs)
+	}
+}
+
+func (s *Session) joinLocked(serverID int32, channelIDs []int32) {
+	s.subscribe(util.ServerTopic(serverID), s.serverEvent(serverID))
+	s.servers[serverID] = make(map[int32]struct{}, len(channelIDs))
+	s.setChannelsLocked(serverID, channelIDs)
+}
+
+// leave unsubscribes from a server and its channels
+func (s *Session) leave(serverID int32) {
+	s.topicsMu.Lock()
+	defer s.topicsMu.Unlock()
+	if _, ok := s.servers[serverID]; !ok {
+		return
+	}
+	s.setChannelsLocked(serverID, nil)
+	delete(s.servers, serverID)
+	s.unsubscribe(util.ServerTopic(serverID))
+}
+
+// refresh re-checks which channels of a server the user can view
+func (s *Session) refresh(serverID int32) {
+	channelIDs, ok := s.resolve(serverID)
+	if !ok {
+		return
+	}
+	s.topicsMu.Lock()
+	defer s.topicsMu.Unlock()
+	if _, joined := s.servers[serverID]; joined {
+		s.setChannelsLocked(serverID, channelIDs)
+	}
+}
+
+// setChannelsLocked subscribes to the channels of a server that became
+// visible and unsubscribes from the ones that no longer are
+func (s *Session) setChannelsLocked(serverID int32, channelIDs []int32) {
+	visible := make(map[int32]struct{}, len(channelIDs))
+	for _, channelID := range channelIDs {
+		visible[channelID] = struct{}{}
+	}
+	current := s.servers[serverID]
+	for channelID := range current {
+		if _, ok := visible[channelID]; !ok {
+			delete(current, channelID)
+			s.unsubscribe(util.ChannelTopic(channelID))
+		}
+	}
+	for channelID := range visible {
+		if _, ok := current[channelID]; !ok {
+			current[channelID] = struct{}{}
+			s.subscribe(util.ChannelTopic(channelID), s.channelEvent(serverID, channelID))
+		}
+	}
+}
+
+// resolve looks up the channels of a server the user can view. A failed
+// lookup leaves the subscriptions as they are.
+func (s *Session) resolve(serverID int32) ([]int32, bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), resolveTimeout)
+	defer cancel()
+	channelIDs, err := s.visibleChannels(ctx, serverID)
+	if err != nil {
+		log.Printf("gateway: failed to resolve channels of server %d for user %d: %v", serverID, s.UserID, err)
+		return nil, false
+	}
+	return channelIDs, true
+}
+
+// subscribe forwards the gateway events of a topic to handle. The caller
+// holds topicsMu.
+func (s *Session) subscribe(topic string, handle func(event *gatewayPb.GatewayEvent)) {
+	select {
+	case <-s.done:
+		return
+	default:
+	}
+	if _, ok := s.topics[topic]; ok {
+		return
+	}
+	ch := pubsub.Get().Subscribe(topic, pubsub.WithPolicy(pubsub.Block))
+	s.topics[topic] = ch
+	go func() {
+		for data := range ch.Receive() {
+			select {
+			case <-s.done:
+				continue
+			default:
+			}
+			if event, ok := data.(*gatewayPb.GatewayEvent); ok {
+				handle(event)
+			}
+		}
+	}()
+}
+
+// unsubscribe closes the subscription of a topic. The caller holds topicsMu.
+func (s *Session) unsubscribe(topic string) {
+	if ch, ok := s.topics[topic]; ok {
+		ch.Close()
+		delete(s.topics, topic)
+	}
+}
+
+// forward pumps a subscription into the session, converting each value with
+// toEvent and skipping values it returns nil for. Once the session is closed
+// it keeps draining until Close has shut the subscription.
+func (s *Session) forward(ch *pubsub.Channel, toEvent func(data interface{}) *gatewayPb.GatewayEvent) {
+	s.subs = append(s.subs, ch)
+	go func() {
+		for data := range ch.Receive() {
+			select {
+			case <-s.done:
+				continue
+			default:
+			}
+			if event := toEvent(data); event != nil {
+				s.Dispatch(event)
+			}
+		}
+	}()
+}
+
+// newSessionID generates a random session ID
+func newSessionID() string {
+	bytes := make([]byte, 16)
+	_, _ = rand.Read(bytes)
+	return hex.EncodeToString(bytes)
+}
@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"discord/gen/proto/schema"
+	gatewayPb "discord/gen/proto/service/gateway"
+	"discord/internal/gateway/util"
+	"discord/pkg/pubsub"
+)
+
+// waitFor polls cond until it holds or a second has passed
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func (s *Session) subscribed(topic string) bool {
+	s.topicsMu.Lock()
+	defer s.topicsMu.Unlock()
+	_, ok := s.topics[topic]
+	return ok
+}
+
+func (s *Session) last() *gatewayPb.GatewayEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.buffer[len(s.buffer)-1]
+}
+
+func memberEvent(serverID, userID int32, operation string) *gatewayPb.GatewayEvent {
+	return util.MemberUpdate(&schema.ServerMember{ServerId: serverID, UserId: userID, Operation: &operation})
+}
+
+func TestSessionFollowsVisibility(t *testing.T) {
+	const (
+		userID  = 9001
+		server  = 9101
+		joined  = 9102
+		general = 9201
+		hidden  = 9202
+		lounge  = 9203
+	)
+	var mu sync.Mutex
+	visible := map[int32][]int32{server: {general}, joined: {lounge}}
+	setVisible := func(serverID int32, channelIDs ...int32) {
+		mu.Lock()
+		defer mu.Unlock()
+		visible[serverID] = channelIDs
+	}
+	s := newSession(userID, map[int32][]int32{server: {general}}, func(ctx context.Context, serverID int32) ([]int32, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		return visible[serverID], nil
+	})
+	defer s.Close()
+	ps := pubsub.Get()
+
+	if !s.subscribed(util.ChannelTopic(general)) || s.subscribed(util.ChannelTopic(hidden)) {
+		t.Fatal("expected to start subscribed to the visible channel only")
+	}
+
+	// A channel the user cannot view is not announced
+	ps.Publish(util.ServerTopic(server), util.ChannelCreate(&schema.Channel{Id: hidden, ServerId: server}))
+	ps.Publish(util.ServerTopic(server), util.ChannelCreate(&schema.Channel{Id: general, ServerId: server}))
+	waitFor(t, "the visible channel create", func() bool { return s.Sequence() == 1 })
+	if s.last().GetChannel().GetId() != general {
+		t.Errorf("dispatched %v", s.last())
+	}
+
+	// Gaining VIEW_CHANNEL subscribes to the channel
+	setVisible(server, general, hidden)
+	ps.Publish(util.ServerTopic(server), util.RoleUpdate(&schema.Role{ServerId: server}))
+	waitFor(t, "the hidden channel subscription", func() bool { return s.subscribed(util.ChannelTopic(hidden)) })
+
+	// Losing it unsubscribes again, announcing the update that hid the channel
+	setVisible(server, general)
+	ps.Publish(util.ServerTopic(server), util.ChannelUpdate(&schema.Channel{Id: hidden, ServerId: server}))
+	waitFor(t, "the hidden channel unsubscription", func() bool { return !s.subscribed(util.ChannelTopic(hidden)) })
+	waitFor(t, "the channel update", func() bool { return s.Sequence() == 3 })
+
+	// Joining subscribes to the server and its visible channels
+	ps.Publish(util.UserTopic(userID), memberEvent(joined, userID, util.MemberJoin))
+	waitFor(t, "the joined server", func() bool { return s.subscribed(util.ChannelTopic(lounge)) })
+	if !s.subscribed(util.ServerTopic(joined)) {
+		t.Error("expected the joined server's topic")
+	}
+
+	// Other members' updates leave the subscriptions alone
+	ps.Publish(util.ServerTopic(server), memberEvent(server, userID+1, util.MemberKick))
+	waitFor(t, "the other member's kick", func() bool { return s.Sequence() == 5 })
+	if !s.subscribed(util.ServerTopic(server)) {
+		t.Error("unsubscribed because of another member")
+	}
+
+	// Being kicked unsubscribes from the server and its channels
+	ps.Publish(util.ServerTopic(server), memberEvent(server, userID, util.MemberKick))
+	waitFor(t, "the kick", func() bool { return !s.subscribed(util.ServerTopic(server)) })
+	if s.subscribed(util.ChannelTopic(general)) {
+		t.Error("still subscribed to a channel of the server left")
+	}
+	if s.last().GetMember().GetOperation() != util.MemberKick {
+		t.Errorf("expected the kick to be dispatched, got %v", s.last())
+	}
+}
+
+// bareSession returns a session without subscriptions
+func bareSession(userID int32) *Session {
+	return &Session{
+		ID:      newSessionID(),
+		UserID:  userID,
+		notify:  make(chan struct{}, 1),
+		done:    make(chan struct{}),
+		topics:  make(map[string]*pubsub.Channel),
+		servers: make(map[int32]map[int32]struct{}),
+	}
+}
+
+func dispatchN(s *Session, n int) {
+	for i := 0; i < n; i++ {
+		s.Dispatch(&gatewayPb.GatewayEvent{Type: gatewayPb.GatewayEventType_MESSAGE_CREATE})
+	}
+}
+
+func TestDispatch(t *testing.T) {
+	s := bareSession(1)
+	event := &gatewayPb.GatewayEvent{Type: gatewayPb.GatewayEventType_MESSAGE_CREATE}
+
+	first := s.Dispatch(event)
+	second := s.Dispatch(event)
+	if first.Sequence != 1 || second.Sequence != 2 || s.Sequence() != 2 {
+		t.Errorf("expected sequences 1 and 2, got %d and %d", first.Sequence, second.Sequence)
+	}
+	if event.Sequence != 0 {
+		t.Error("the published event was modified")
+	}
+	select {
+	case <-s.Notify():
+	default:
+		t.Error("expected a notification")
+	}
+
+	// The buffer keeps the latest ReplayBufferSize events
+	dispatchN(s, ReplayBufferSize)
+	if len(s.buffer) != ReplayBufferSize {
+		t.Fatalf("expected %d buffered events, got %d", ReplayBufferSize, len(s.buffer))
+	}
+	if oldest := s.buffer[0].Sequence; oldest != 3 {
+		t.Errorf("expected the oldest buffered event to be 3, got %d", oldest)
+	}
+}
+
+func TestSince(t *testing.T) {
+	const dispatched = ReplayBufferSize + 10
+	oldest := int64(dispatched - ReplayBufferSize + 1)
+
+	tests := []struct {
+		name       string
+		dispatched int
+		sequence   int64
+		ok         bool
+		missed     int
+	}{
+		{"nothing dispatched", 0, 0, true, 0},
+		{"up to date", 5, 5, true, 0},
+		{"missed some", 5, 2, true, 3},
+		{"missed all", 5, 0, true, 5},
+		{"negative sequence", 5, -1, false, 0},
+		{"sequence never dispatched", 5, 6, false, 0},
+		{"resume from just before the oldest buffered", dispatched, oldest - 1, true, ReplayBufferSize},
+		{"resume from the oldest buffered", dispatched, oldest, true, ReplayBufferSize - 1},
+		{"oldest missed event evicted", dispatched, oldest - 2, false, 0},
+		{"resume too old", dispatched, 0, false, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := bareSession(1)
+			dispatchN(s, tt.dispatched)
+
+			events, ok := s.Since(tt.sequence)
+			if ok != tt.ok {
+				t.Fatalf("expected ok %v, got %v", tt.ok, ok)
+			}
+			if len(events) != tt.missed {
+				t.Fatalf("expected %d missed events, got %d", tt.missed, len(events))
+			}
+			for i, event := range events {
+				if event.Sequence != tt.sequence+int64(i)+1 {
+					t.Errorf("event %d has sequence %d", i, event.Sequence)
+				}
+			}
+		})
+	}
+}
+
+func TestResume(t *testing.T) {
+	gateway := &GatewayService{sessions: make(map[string]*Session)}
+	session := bareSession(1)
+	gateway.sessions[session.ID] = session
+	dispatchN(session, 3)
+
+	if _, _, _, err := gateway.Resume(2, session.ID, 3); err == nil {
+		t.Error("resumed another user's session")
+	}
+	if _, _, _, err := gateway.Resume(1, "unknown", 3); err == nil {
+		t.Error("resumed an unknown session")
+	}
+
+	_, conn, missed, err := gateway.Resume(1, session.ID, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(missed) != 2 {
+		t.Errorf("expected 2 missed events, got %d", len(missed))
+	}
+	gateway.Detach(session, conn)
+
+	// Resuming from before the replay buffer invalidates the session
+	dispatchN(session, ReplayBufferSize)
+	if _, _, _, err := gateway.Resume(1, session.ID, 1); err == nil {
+		t.Fatal("resumed from an evicted sequence")
+	}
+	select {
+	case <-session.Done():
+	default:
+		t.Error("expected the session to be closed")
+	}
+	if _, ok := gateway.sessions[session.ID]; ok {
+		t.Error("expected the session to be forgotten")
+	}
+}
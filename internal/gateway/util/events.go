@@ -0,0 +1,193 @@
+package util
+
+import (
+	"strconv"
+	"strings"
+
+	"discord/gen/proto/schema"
+	gatewayPb "discord/gen/proto/service/gateway"
+	"discord/gen/repo"
+)
+
+// topicPrefix namespaces the gateway's pubsub topics, keeping them apart from
+// the topics other packages publish on
+const topicPrefix = "gateway:"
+
+// ChannelTopic is the pubsub topic gateway events of a channel are published on
+func ChannelTopic(channelID int32) string {
+	return topicPrefix + "channel:" + strconv.Itoa(int(channelID))
+}
+
+// DMTopic is the pubsub topic gateway events of a user's DM channels are
+// published on. Group DM membership changes while a session is open, so
+// events are addressed to each participant rather than to the channel.
+func DMTopic(userID int32) string {
+	return topicPrefix + "dm:" + strconv.Itoa(int(userID))
+}
+
+// ServerTopic is the pubsub topic gateway events of a server are published on
+func ServerTopic(serverID int32) string {
+	return topicPrefix + "server:" + strconv.Itoa(int(serverID))
+}
+
+// UserTopic is the pubsub topic gateway events addressed to one user are
+// published on, such as the user joining a server they are not subscribed to
+func UserTopic(userID int32) string {
+	return topicPrefix + "user:" + strconv.Itoa(int(userID))
+}
+
+// Operations carried by a MEMBER_UPDATE. Sessions follow the join, leave,
+// kick, ban and roles operations of their own user to keep their server and
+// channel subscriptions in step with what the user can see.
+const (
+	MemberJoin       = "join"
+	MemberLeave      = "leave"
+	MemberKick       = "kick"
+	MemberBan        = "ban"
+	MemberUnban      = "unban"
+	MemberRoles      = "roles"
+	MemberTimeout    = "timeout"
+	MemberTimeoutEnd = "timeout_end"
+)
+
+// MessageCreate builds a MESSAGE_CREATE event
+func MessageCreate(message *schema.Message) *gatewayPb.GatewayEvent {
+	return &gatewayPb.GatewayEvent{
+		Type:    gatewayPb.GatewayEventType_MESSAGE_CREATE,
+		Payload: &gatewayPb.GatewayEvent_Message{Message: message},
+	}
+}
+
+// MessageUpdate builds a MESSAGE_UPDATE event
+func MessageUpdate(message *schema.Message) *gatewayPb.GatewayEvent {
+	return &gatewayPb.GatewayEvent{
+		Type:    gatewayPb.GatewayEventType_MESSAGE_UPDATE,
+		Payload: &gatewayPb.GatewayEvent_Message{Message: message},
+	}
+}
+
+// MessageDelete builds a MESSAGE_DELETE event
+func MessageDelete(channelID int32, messageIDs ...int32) *gatewayPb.GatewayEvent {
+	return &gatewayPb.GatewayEvent{
+		Type: gatewayPb.GatewayEventType_MESSAGE_DELETE,
+		Payload: &gatewayPb.GatewayEvent_MessageDelete{MessageDelete: &gatewayPb.MessageDelete{
+			ChannelId:  channelID,
+			MessageIds: messageIDs,
+		}},
+	}
+}
+
+// FriendUpdate builds a FRIEND_UPDATE event
+func FriendUpdate(friend *schema.Friend) *gatewayPb.GatewayEvent {
+	return &gatewayPb.GatewayEvent{
+		Type:    gatewayPb.GatewayEventType_FRIEND_UPDATE,
+		Payload: &gatewayPb.GatewayEvent_Friend{Friend: friend},
+	}
+}
+
+// PresenceUpdate builds a PRESENCE_UPDATE event carrying the partial user
+func PresenceUpdate(user *schema.User) *gatewayPb.GatewayEvent {
+	return &gatewayPb.GatewayEvent{
+		Type:    gatewayPb.GatewayEventType_PRESENCE_UPDATE,
+		Payload: &gatewayPb.GatewayEvent_User{User: user},
+	}
+}
+
+// UserUpdate builds a USER_UPDATE event
+func UserUpdate(user *schema.User) *gatewayPb.GatewayEvent {
+	return &gatewayPb.GatewayEvent{
+		Type:    gatewayPb.GatewayEventType_USER_UPDATE,
+		Payload: &gatewayPb.GatewayEvent_User{User: user},
+	}
+}
+
+// UserSettingsUpdate builds a USER_SETTINGS_UPDATE event
+func UserSettingsUpdate(settings *schema.UserSettings) *gatewayPb.GatewayEvent {
+	return &gatewayPb.GatewayEvent{
+		Type:    gatewayPb.GatewayEventType_USER_SETTINGS_UPDATE,
+		Payload: &gatewayPb.GatewayEvent_UserSettings{UserSettings: settings},
+	}
+}
+
+// VoiceStateUpdate builds a VOICE_STATE_UPDATE event
+func VoiceStateUpdate(state *schema.VoiceState) *gatewayPb.GatewayEvent {
+	return &gatewayPb.GatewayEvent{
+		Type:    gatewayPb.GatewayEventType_VOICE_STATE_UPDATE,
+		Payload: &gatewayPb.GatewayEvent_VoiceState{VoiceState: state},
+	}
+}
+
+// MemberUpdate builds a MEMBER_UPDATE event
+func MemberUpdate(member *schema.ServerMember) *gatewayPb.GatewayEvent {
+	return &gatewayPb.GatewayEvent{
+		Type:    gatewayPb.GatewayEventType_MEMBER_UPDATE,
+		Payload: &gatewayPb.GatewayEvent_Member{Member: member},
+	}
+}
+
+// DMChannelUpdate builds a DM_CHANNEL_UPDATE event
+func DMChannelUpdate(channel *schema.DMChannel) *gatewayPb.GatewayEvent {
+	return &gatewayPb.GatewayEvent{
+		Type:    gatewayPb.GatewayEventType_DM_CHANNEL_UPDATE,
+		Payload: &gatewayPb.GatewayEvent_DmChannel{DmChannel: channel},
+	}
+}
+
+// ChannelCreate builds a CHANNEL_CREATE event
+func ChannelCreate(channel *schema.Channel) *gatewayPb.GatewayEvent {
+	return &gatewayPb.GatewayEvent{
+		Type:    gatewayPb.GatewayEventType_CHANNEL_CREATE,
+		Payload: &gatewayPb.GatewayEvent_Channel{Channel: channel},
+	}
+}
+
+// ChannelUpdate builds a CHANNEL_UPDATE event
+func ChannelUpdate(channel *schema.Channel) *gatewayPb.GatewayEvent {
+	return &gatewayPb.GatewayEvent{
+		Type:    gatewayPb.GatewayEventType_CHANNEL_UPDATE,
+		Payload: &gatewayPb.GatewayEvent_Channel{Channel: channel},
+	}
+}
+
+// ChannelDelete builds a CHANNEL_DELETE event
+func ChannelDelete(channel *schema.Channel) *gatewayPb.GatewayEvent {
+	return &gatewayPb.GatewayEvent{
+		Type:    gatewayPb.GatewayEventType_CHANNEL_DELETE,
+		Payload: &gatewayPb.GatewayEvent_Channel{Channel: channel},
+	}
+}
+
+// RoleUpdate builds a ROLE_UPDATE event
+func RoleUpdate(role *schema.Role) *gatewayPb.GatewayEvent {
+	return &gatewayPb.GatewayEvent{
+		Type:    gatewayPb.GatewayEventType_ROLE_UPDATE,
+		Payload: &gatewayPb.GatewayEvent_Role{Role: role},
+	}
+}
+
+// ConvertPresence converts a repo presence to a proto presence
+func ConvertPresence(presence repo.UserPresence) *schema.UserPresence {
+	return &schema.UserPresence{
+		UserId:       presence.UserID,
+		Status:       ParseUserStatus(presence.Status.String),
+		CustomStatus: presence.CustomStatus.String,
+		LastSeen:     presence.LastSeen.Time.Unix(),
+		Activity:     presence.Activity.String,
+	}
+}
+
+// ParseUserStatus maps a stored status (online, idle, dnd, ...) to the proto enum
+func ParseUserStatus(status string) schema.UserStatus {
+	switch strings.ToLower(status) {
+	case "online":
+		return schema.UserStatus_ONLINE
+	case "idle":
+		return schema.UserStatus_IDLE
+	case "dnd":
+		return schema.UserStatus_DO_NOT_DISTURB
+	case "invisible":
+		return schema.UserStatus_INVISIBLE
+	default:
+		return schema.UserStatus_OFFLINE
+	}
+}
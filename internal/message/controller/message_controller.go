@@ -7,6 +7,7 @@ import (
 	messagePb "discord/gen/proto/service/message"
 	commonErrors "discord/internal/common/errors"
 	messageService "discord/internal/message/service"
+	messageUtil "discord/internal/message/util"
 )
 
 type MessageController struct {
@@ -55,52 +56,47 @@ func (c *MessageController) SendMessage(ctx context.Context, req *messagePb.Send
 	}, nil
 }
 
+// GetMessages sends one page of channel history. Clients continue with the
+// page's cursors or jump to a message with around_message_id.
 func (c *MessageController) GetMessages(req *messagePb.GetMessagesRequest, stream messagePb.MessageService_GetMessagesServer) error {
 	ctx := stream.Context()
+	userID, ok := ctx.Value("user_id").(int32)
+	if !ok {
+		return commonErrors.ToGRPCError(commonErrors.ErrUnauthorized)
+	}
 
 	if req.GetChannelId() == 0 {
 		return commonErrors.ToGRPCError(commonErrors.ErrInvalidInput)
 	}
 
-	limit := req.GetLimit()
-	if limit == 0 {
-		limit = 50
-	}
-
-	messages, err := c.messageService.GetMessages(ctx, req.GetChannelId(), limit, req.GetOffset(), nil, nil)
+	page, err := c.messageService.GetMessages(ctx, req.GetChannelId(), userID, req.GetCursor(), req.GetAroundMessageId(), req.GetLimit())
 	if err != nil {
 		return commonErrors.ToGRPCError(err)
 	}
 
-	// Stream messages to client
-	for _, msg := range messages {
-		pbMsg := &schema.Message{
-			Id:        msg.ID,
-			ChannelId: msg.ChannelID.Int32,
-			SenderId:  msg.SenderID,
-			Content:   msg.Content,
-			IsEdited:  msg.IsEdited.Bool,
-			IsPinned:  msg.IsPinned.Bool,
-			CreatedAt: msg.CreatedAt.Time.Unix(),
-		}
-
-		if err := stream.Send(&messagePb.GetMessagesResponse{
-			Messages: []*schema.Message{pbMsg},
-		}); err != nil {
-			return err
-		}
+	messages := make([]*schema.Message, len(page.Messages))
+	for i, msg := range page.Messages {
+		messages[i] = messageUtil.ConvertMessageToProto(msg)
 	}
 
-	return nil
+	return stream.Send(&messagePb.GetMessagesResponse{
+		Messages:      messages,
+		HasMoreBefore: page.HasMoreBefore,
+		HasMoreAfter:  page.HasMoreAfter,
+		BeforeCursor:  page.BeforeCursor,
+		AfterCursor:   page.AfterCursor,
+	})
 }
 
 // GetMessage retrieves a single message
 func (c *MessageController) GetMessage(ctx context.Context, req *messagePb.GetMessageRequest) (*messagePb.GetMessageResponse, error) {
+	userID := ctx.Value("user_id").(int32)
+
 	if req.GetMessageId() == 0 {
 		return nil, commonErrors.ToGRPCError(commonErrors.ErrInvalidInput)
 	}
 
-	message, err := c.messageService.GetMessage(ctx, req.GetMessageId())
+	message, err := c.messageService.GetMessage(ctx, req.GetMessageId(), userID)
 	if err != nil {
 		return nil, commonErrors.ToGRPCError(err)
 	}
@@ -162,13 +158,13 @@ func (c *MessageController) DeleteMessage(ctx context.Context, req *messagePb.De
 
 // PinMessage pins a message
 func (c *MessageController) PinMessage(ctx context.Context, req *messagePb.PinMessageRequest) (*messagePb.PinMessageResponse, error) {
+	userID := ctx.Value("user_id").(int32)
+
 	if req.GetMessageId() == 0 {
 		return nil, commonErrors.ToGRPCError(commonErrors.ErrInvalidInput)
 	}
 
-	// TODO: Check user permissions for pinning messages
-
-	err := c.messageService.PinMessage(ctx, req.GetMessageId())
+	err := c.messageService.PinMessage(ctx, req.GetMessageId(), userID)
 	if err != nil {
 		return nil, commonErrors.ToGRPCError(err)
 	}
@@ -180,11 +176,13 @@ func (c *MessageController) PinMessage(ctx context.Context, req *messagePb.PinMe
 
 // UnpinMessage unpins a message
 func (c *MessageController) UnpinMessage(ctx context.Context, req *messagePb.UnpinMessageRequest) (*messagePb.UnpinMessageResponse, error) {
+	userID := ctx.Value("user_id").(int32)
+
 	if req.GetMessageId() == 0 {
 		return nil, commonErrors.ToGRPCError(commonErrors.ErrInvalidInput)
 	}
 
-	err := c.messageService.UnpinMessage(ctx, req.GetMessageId())
+	err := c.messageService.UnpinMessage(ctx, req.GetMessageId(), userID)
 	if err != nil {
 		return nil, commonErrors.ToGRPCError(err)
 	}
@@ -196,11 +194,13 @@ func (c *MessageController) UnpinMessage(ctx context.Context, req *messagePb.Unp
 
 // GetPinnedMessages retrieves all pinned messages
 func (c *MessageController) GetPinnedMessages(ctx context.Context, req *messagePb.GetPinnedMessagesRequest) (*messagePb.GetPinnedMessagesResponse, error) {
+	userID := ctx.Value("user_id").(int32)
+
 	if req.GetChannelId() == 0 {
 		return nil, commonErrors.ToGRPCError(commonErrors.ErrInvalidInput)
 	}
 
-	messages, err := c.messageService.GetPinnedMessages(ctx, req.GetChannelId())
+	messages, err := c.messageService.GetPinnedMessages(ctx, req.GetChannelId(), userID)
 	if err != nil {
 		return nil, commonErrors.ToGRPCError(err)
 	}
@@ -254,6 +254,8 @@ func (c *MessageController) RemoveReaction(ctx context.Context, req *messagePb.R
 
 // GetReactions retrieves reactions for a message
 func (c *MessageController) GetReactions(ctx context.Context, req *messagePb.GetReactionsRequest) (*messagePb.GetReactionsResponse, error) {
+	userID := ctx.Value("user_id").(int32)
+
 	if req.GetMessageId() == 0 {
 		return nil, commonErrors.ToGRPCError(commonErrors.ErrInvalidInput)
 	}
@@ -264,7 +266,7 @@ func (c *MessageController) GetReactions(ctx context.Context, req *messagePb.Get
 		emoji = &e
 	}
 
-	reactions, err := c.messageService.GetReactions(ctx, req.GetMessageId(), emoji)
+	reactions, err := c.messageService.GetReactions(ctx, req.GetMessageId(), userID, emoji)
 	if err != nil {
 		return nil, commonErrors.ToGRPCError(err)
 	}
@@ -295,12 +297,17 @@ func (c *MessageController) GetReactions(ctx context.Context, req *messagePb.Get
 
 // SendTyping sends a typing indicator
 func (c *MessageController) SendTyping(ctx context.Context, req *messagePb.SendTypingRequest) (*messagePb.SendTypingResponse, error) {
+	userID := ctx.Value("user_id").(int32)
+
 	if req.GetChannelId() == 0 {
 		return nil, commonErrors.ToGRPCError(commonErrors.ErrInvalidInput)
 	}
 
-	// TODO: Implement typing indicator logic (websocket broadcast)
-	// For now, just return success
+	if err := c.messageService.SendTyping(ctx, req.GetChannelId(), userID); err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	// TODO: Broadcast the typing indicator
 
 	return &messagePb.SendTypingResponse{
 		Success: true,
@@ -309,13 +316,13 @@ func (c *MessageController) SendTyping(ctx context.Context, req *messagePb.SendT
 
 // BulkDeleteMessages deletes multiple messages
 func (c *MessageController) BulkDeleteMessages(ctx context.Context, req *messagePb.BulkDeleteMessagesRequest) (*messagePb.BulkDeleteMessagesResponse, error) {
+	userID := ctx.Value("user_id").(int32)
+
 	if len(req.GetMessageIds()) == 0 {
 		return nil, commonErrors.ToGRPCError(commonErrors.ErrInvalidInput)
 	}
 
-	// TODO: Check user permissions for bulk delete
-
-	err := c.messageService.BulkDeleteMessages(ctx, req.GetMessageIds())
+	err := c.messageService.BulkDeleteMessages(ctx, userID, req.GetMessageIds())
 	if err != nil {
 		return nil, commonErrors.ToGRPCError(err)
 	}
@@ -326,9 +333,11 @@ func (c *MessageController) BulkDeleteMessages(ctx context.Context, req *message
 	}, nil
 }
 
-// SearchMessages searches for messages
+// SearchMessages searches for messages in a channel or across a server
 func (c *MessageController) SearchMessages(ctx context.Context, req *messagePb.SearchMessagesRequest) (*messagePb.SearchMessagesResponse, error) {
-	if req.GetChannelId() == 0 || req.GetQuery() == "" {
+	userID := ctx.Value("user_id").(int32)
+
+	if (req.GetChannelId() == 0 && req.GetServerId() == 0) || req.GetQuery() == "" {
 		return nil, commonErrors.ToGRPCError(commonErrors.ErrInvalidInput)
 	}
 
@@ -337,7 +346,7 @@ func (c *MessageController) SearchMessages(ctx context.Context, req *messagePb.S
 		limit = 25
 	}
 
-	messages, err := c.messageService.SearchMessages(ctx, req.GetChannelId(), req.GetQuery(), limit, req.GetOffset())
+	messages, total, err := c.messageService.SearchMessages(ctx, userID, req.GetServerId(), req.GetChannelId(), req.GetQuery(), limit, req.GetOffset())
 	if err != nil {
 		return nil, commonErrors.ToGRPCError(err)
 	}
@@ -350,11 +359,13 @@ func (c *MessageController) SearchMessages(ctx context.Context, req *messagePb.S
 			SenderId:  msg.SenderID,
 			Content:   msg.Content,
 			CreatedAt: msg.CreatedAt.Time.Unix(),
+			Highlight: msg.Highlight,
+			Score:     msg.Rank,
 		}
 	}
 
 	return &messagePb.SearchMessagesResponse{
 		Results:      results,
-		TotalResults: int32(len(results)),
+		TotalResults: total,
 	}, nil
 }
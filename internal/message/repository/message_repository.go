@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"discord/gen/repo"
+	messageUtil "discord/internal/message/util"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -20,20 +21,51 @@ func NewMessageRepository(db *pgxpool.Pool) *MessageRepository {
 	}
 }
 
-func (r *MessageRepository) CreateMessage(ctx context.Context, channelID, senderID int32, content, messageType string, replyToMessageID *int32, mentionEveryone bool) (repo.Message, error) {
+// CreateMessage stores a channel message together with the users and roles it
+// mentions
+func (r *MessageRepository) CreateMessage(ctx context.Context, channelID, senderID int32, content, messageType string, replyToMessageID *int32, mentions messageUtil.Mentions) (repo.Message, error) {
 	var replyTo pgtype.Int4
 	if replyToMessageID != nil {
 		replyTo = pgtype.Int4{Int32: *replyToMessageID, Valid: true}
 	}
 
-	return r.queries.CreateMessage(ctx, repo.CreateMessageParams{
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return repo.Message{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+	message, err := qtx.CreateMessage(ctx, repo.CreateMessageParams{
 		ChannelID:        pgtype.Int4{Int32: channelID, Valid: true},
 		SenderID:         senderID,
 		Content:          content,
 		MessageType:      pgtype.Text{String: messageType, Valid: true},
 		ReplyToMessageID: replyTo,
-		MentionEveryone:  pgtype.Bool{Bool: mentionEveryone, Valid: true},
+		MentionEveryone:  pgtype.Bool{Bool: mentions.Everyone, Valid: true},
 	})
+	if err != nil {
+		return repo.Message{}, err
+	}
+
+	if len(mentions.UserIDs) > 0 {
+		if err := qtx.CreateUserMentions(ctx, repo.CreateUserMentionsParams{
+			MessageID: message.ID,
+			UserIds:   mentions.UserIDs,
+		}); err != nil {
+			return repo.Message{}, err
+		}
+	}
+	if len(mentions.RoleIDs) > 0 {
+		if err := qtx.CreateRoleMentions(ctx, repo.CreateRoleMentionsParams{
+			MessageID: message.ID,
+			RoleIds:   mentions.RoleIDs,
+		}); err != nil {
+			return repo.Message{}, err
+		}
+	}
+
+	return message, tx.Commit(ctx)
 }
 
 func (r *MessageRepository) GetMessageByID(ctx context.Context, messageID int32) (repo.Message, error) {
@@ -73,6 +105,28 @@ func (r *MessageRepository) GetMessagesAfter(ctx context.Context, channelID, aft
 	})
 }
 
+// HasMessagesFrom reports whether a channel has messages from messageID on
+func (r *MessageRepository) HasMessagesFrom(ctx context.Context, channelID, messageID int32) (bool, error) {
+	return r.queries.HasMessagesFrom(ctx, repo.HasMessagesFromParams{
+		ChannelID: pgtype.Int4{
+			Int32: channelID,
+			Valid: true,
+		},
+		ID: messageID,
+	})
+}
+
+// HasMessagesUntil reports whether a channel has messages up to messageID
+func (r *MessageRepository) HasMessagesUntil(ctx context.Context, channelID, messageID int32) (bool, error) {
+	return r.queries.HasMessagesUntil(ctx, repo.HasMessagesUntilParams{
+		ChannelID: pgtype.Int4{
+			Int32: channelID,
+			Valid: true,
+		},
+		ID: messageID,
+	})
+}
+
 func (r *MessageRepository) UpdateMessage(ctx context.Context, messageID int32, content string) (repo.Message, error) {
 	return r.queries.UpdateMessage(ctx, repo.UpdateMessageParams{
 		ID:      messageID,
@@ -81,8 +135,20 @@ func (r *MessageRepository) UpdateMessage(ctx context.Context, messageID int32,
 }
 
 func (r *MessageRepository) DeleteMessage(ctx context.Context, messageID int32) error {
-	_, err := r.queries.SoftDeleteMessage(ctx, messageID)
-	return err
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+	if _, err := qtx.CreateMessageDeletedEntities(ctx, []int32{messageID}); err != nil {
+		return err
+	}
+	if _, err := qtx.SoftDeleteMessage(ctx, messageID); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
 }
 
 func (r *MessageRepository) PinMessage(ctx context.Context, messageID int32) error {
@@ -103,20 +169,68 @@ func (r *MessageRepository) GetPinnedMessages(ctx context.Context, channelID int
 }
 
 func (r *MessageRepository) BulkDeleteMessages(ctx context.Context, messageIDs []int32) error {
-	_, err := r.queries.BulkSoftDeleteMessages(ctx, messageIDs)
-	return err
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+	if _, err := qtx.CreateMessageDeletedEntities(ctx, messageIDs); err != nil {
+		return err
+	}
+	if _, err := qtx.BulkSoftDeleteMessages(ctx, messageIDs); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
 }
 
-func (r *MessageRepository) SearchMessages(ctx context.Context, channelID int32, query string, limit, offset int32) ([]repo.Message, error) {
-	return r.queries.SearchMessages(ctx, repo.SearchMessagesParams{
-		ChannelID: pgtype.Int4{
-			Int32: channelID,
-			Valid: true,
-		},
-		Column2: pgtype.Text{String: query, Valid: true},
-		Limit:   limit,
-		Offset:  offset,
+// SearchMessages runs a full-text search over channelIDs with the filters of
+// q and returns one page of results with the number of matches in total
+func (r *MessageRepository) SearchMessages(ctx context.Context, channelIDs []int32, q messageUtil.SearchQuery, limit, offset int32) ([]repo.SearchMessagesRow, int32, error) {
+	filters := repo.CountSearchMessagesParams{
+		Query:      q.Text,
+		ChannelIds: channelIDs,
+	}
+	if q.FromUserID != nil {
+		filters.SenderID = pgtype.Int4{Int32: *q.FromUserID, Valid: true}
+	}
+	if q.HasAttachment != nil {
+		filters.HasAttachment = pgtype.Bool{Bool: *q.HasAttachment, Valid: true}
+	}
+	if q.MentionsUserID != nil {
+		filters.MentionsUserID = pgtype.Int4{Int32: *q.MentionsUserID, Valid: true}
+	}
+	if q.Before != nil {
+		filters.Before = pgtype.Timestamp{Time: *q.Before, Valid: true}
+	}
+	if q.After != nil {
+		filters.After = pgtype.Timestamp{Time: *q.After, Valid: true}
+	}
+	if q.Pinned != nil {
+		filters.Pinned = pgtype.Bool{Bool: *q.Pinned, Valid: true}
+	}
+
+	results, err := r.queries.SearchMessages(ctx, repo.SearchMessagesParams{
+		Query:          filters.Query,
+		ChannelIds:     filters.ChannelIds,
+		SenderID:       filters.SenderID,
+		HasAttachment:  filters.HasAttachment,
+		MentionsUserID: filters.MentionsUserID,
+		Before:         filters.Before,
+		After:          filters.After,
+		Pinned:         filters.Pinned,
+		ResultLimit:    limit,
+		ResultOffset:   offset,
 	})
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err := r.queries.CountSearchMessages(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	return results, total, nil
 }
 
 func (r *MessageRepository) GetUserMessages(ctx context.Context, userID int32, limit, offset int32) ([]repo.Message, error) {
@@ -3,21 +3,29 @@ package service
 import (
 	"context"
 	"errors"
+	"math"
+	"slices"
 
 	"discord/gen/repo"
+	channelUtil "discord/internal/channel/util"
 	commonErrors "discord/internal/common/errors"
+	gatewayUtil "discord/internal/gateway/util"
 	messageRepo "discord/internal/message/repository"
+	messageUtil "discord/internal/message/util"
+	permissionService "discord/internal/permission/service"
 	"discord/pkg/pubsub"
 )
 
 type MessageService struct {
 	messageRepo *messageRepo.MessageRepository
+	permissions *permissionService.Resolver
 	pubsub      *pubsub.PubSub
 }
 
-func NewMessageService(messageRepo *messageRepo.MessageRepository) *MessageService {
+func NewMessageService(messageRepo *messageRepo.MessageRepository, permissions *permissionService.Resolver) *MessageService {
 	return &MessageService{
 		messageRepo: messageRepo,
+		permissions: permissions,
 		pubsub:      pubsub.Get(),
 	}
 }
@@ -28,6 +36,14 @@ func (s *MessageService) SendMessage(ctx context.Context, channelID, senderID in
 		return repo.Message{}, commonErrors.ErrInvalidInput
 	}
 
+	permission := channelUtil.PermissionSendMessages
+	if mentionEveryone {
+		permission |= channelUtil.PermissionMentionEveryone
+	}
+	if err := s.permissions.RequireChannel(ctx, channelID, senderID, permission); err != nil {
+		return repo.Message{}, err
+	}
+
 	// Validate reply message if provided
 	if replyToMessageID != nil {
 		_, err := s.messageRepo.GetMessageByID(ctx, *replyToMessageID)
@@ -36,16 +52,48 @@ func (s *MessageService) SendMessage(ctx context.Context, channelID, senderID in
 		}
 	}
 
-	return s.messageRepo.CreateMessage(ctx, channelID, senderID, content, "TEXT", replyToMessageID, mentionEveryone)
+	// @everyone in the content only notifies when the sender may use it
+	mentions := messageUtil.ParseMentions(content)
+	if mentions.Everyone && !mentionEveryone {
+		permissions, err := s.permissions.ChannelPermissions(ctx, channelID, senderID)
+		mentions.Everyone = err == nil && channelUtil.HasPermission(permissions, channelUtil.PermissionMentionEveryone)
+	}
+	mentions.Everyone = mentions.Everyone || mentionEveryone
+
+	message, err := s.messageRepo.CreateMessage(ctx, channelID, senderID, content, "default", replyToMessageID, mentions)
+	if err != nil {
+		return repo.Message{}, err
+	}
+
+	s.publishToChannel(channelID, gatewayUtil.MessageCreate(messageUtil.ConvertMessageToProto(message)))
+	return message, nil
 }
 
 // GetMessage retrieves a single message
-func (s *MessageService) GetMessage(ctx context.Context, messageID int32) (repo.Message, error) {
-	return s.messageRepo.GetMessageByID(ctx, messageID)
+func (s *MessageService) GetMessage(ctx context.Context, messageID, userID int32) (repo.Message, error) {
+	return s.getMessage(ctx, messageID, userID, channelUtil.PermissionReadMessageHistory)
 }
 
-// GetMessages retrieves messages with pagination
-func (s *MessageService) GetMessages(ctx context.Context, channelID int32, limit, offset int32, beforeMessageID, afterMessageID *int32) ([]repo.Message, error) {
+// MessagePage is a window of channel history ordered oldest first. The
+// cursors continue the history in either direction and are empty when there
+// is nothing more to load.
+type MessagePage struct {
+	Messages      []repo.Message
+	HasMoreBefore bool
+	HasMoreAfter  bool
+	BeforeCursor  string
+	AfterCursor   string
+}
+
+// GetMessages loads a page of channel history. With a cursor it continues from
+// a previous page, with aroundMessageID it centres the page on that message,
+// used to jump to search results, replies and pins, and with neither it loads
+// the latest messages.
+func (s *MessageService) GetMessages(ctx context.Context, channelID, userID int32, cursor string, aroundMessageID int32, limit int32) (MessagePage, error) {
+	if err := s.permissions.RequireChannel(ctx, channelID, userID, channelUtil.PermissionReadMessageHistory); err != nil {
+		return MessagePage{}, err
+	}
+
 	if limit <= 0 {
 		limit = 50 // Default limit
 	}
@@ -53,15 +101,130 @@ func (s *MessageService) GetMessages(ctx context.Context, channelID int32, limit
 		limit = 100 // Max limit
 	}
 
-	if beforeMessageID != nil {
-		return s.messageRepo.GetMessagesBefore(ctx, channelID, *beforeMessageID, limit)
+	switch {
+	case cursor != "":
+		c, err := messageUtil.DecodeCursor(cursor, channelID)
+		if err != nil {
+			return MessagePage{}, commonErrors.ErrInvalidInput
+		}
+		if c.Direction == messageUtil.CursorAfter {
+			return s.messagesAfter(ctx, channelID, c.MessageID, limit)
+		}
+		return s.messagesBefore(ctx, channelID, c.MessageID, limit)
+	case aroundMessageID != 0:
+		return s.messagesAround(ctx, channelID, aroundMessageID, limit)
+	default:
+		return s.messagesBefore(ctx, channelID, math.MaxInt32, limit)
 	}
+}
 
-	if afterMessageID != nil {
-		return s.messageRepo.GetMessagesAfter(ctx, channelID, *afterMessageID, limit)
+// messagesBefore loads the limit messages older than messageID
+func (s *MessageService) messagesBefore(ctx context.Context, channelID, messageID, limit int32) (MessagePage, error) {
+	messages, hasMore, err := s.fetchBefore(ctx, channelID, messageID, limit)
+	if err != nil {
+		return MessagePage{}, err
+	}
+
+	page := MessagePage{Messages: messages, HasMoreBefore: hasMore}
+	// The latest page has nothing newer; otherwise the cursor message itself
+	// and anything after it is newer than the page
+	if messageID != math.MaxInt32 {
+		page.HasMoreAfter, err = s.messageRepo.HasMessagesFrom(ctx, channelID, messageID)
+		if err != nil {
+			return MessagePage{}, err
+		}
 	}
+	return page.withCursors(channelID), nil
+}
 
-	return s.messageRepo.GetChannelMessages(ctx, channelID, limit, offset)
+// messagesAfter loads the limit messages newer than messageID
+func (s *MessageService) messagesAfter(ctx context.Context, channelID, messageID, limit int32) (MessagePage, error) {
+	messages, hasMore, err := s.fetchAfter(ctx, channelID, messageID, limit)
+	if err != nil {
+		return MessagePage{}, err
+	}
+
+	page := MessagePage{Messages: messages, HasMoreAfter: hasMore}
+	page.HasMoreBefore, err = s.messageRepo.HasMessagesUntil(ctx, channelID, messageID)
+	if err != nil {
+		return MessagePage{}, err
+	}
+	return page.withCursors(channelID), nil
+}
+
+// fetchBefore reads the limit messages older than messageID, oldest first,
+// and whether there are more. One extra row is read to tell.
+func (s *MessageService) fetchBefore(ctx context.Context, channelID, messageID, limit int32) ([]repo.Message, bool, error) {
+	messages, err := s.messageRepo.GetMessagesBefore(ctx, channelID, messageID, limit+1)
+	if err != nil {
+		return nil, false, err
+	}
+	messages, hasMore := trimPage(messages, limit)
+	slices.Reverse(messages)
+	return messages, hasMore, nil
+}
+
+// fetchAfter reads the limit messages newer than messageID, oldest first,
+// and whether there are more
+func (s *MessageService) fetchAfter(ctx context.Context, channelID, messageID, limit int32) ([]repo.Message, bool, error) {
+	messages, err := s.messageRepo.GetMessagesAfter(ctx, channelID, messageID, limit+1)
+	if err != nil {
+		return nil, false, err
+	}
+	messages, hasMore := trimPage(messages, limit)
+	return messages, hasMore, nil
+}
+
+// trimPage cuts messages read with one extra row down to limit and reports
+// whether the extra row was there
+func trimPage(messages []repo.Message, limit int32) ([]repo.Message, bool) {
+	if len(messages) > int(limit) {
+		return messages[:limit], true
+	}
+	return messages, false
+}
+
+// messagesAround loads a page with the target message in the middle
+func (s *MessageService) messagesAround(ctx context.Context, channelID, messageID, limit int32) (MessagePage, error) {
+	target, err := s.messageRepo.GetMessageByID(ctx, messageID)
+	if err != nil {
+		return MessagePage{}, commonErrors.ErrNotFound
+	}
+	if targetChannel, ok := channelOf(target); !ok || targetChannel != channelID {
+		return MessagePage{}, commonErrors.ErrNotFound
+	}
+
+	older := (limit - 1) / 2
+	before, hasMoreBefore, err := s.fetchBefore(ctx, channelID, messageID, older)
+	if err != nil {
+		return MessagePage{}, err
+	}
+	after, hasMoreAfter, err := s.fetchAfter(ctx, channelID, messageID, limit-1-older)
+	if err != nil {
+		return MessagePage{}, err
+	}
+
+	page := MessagePage{
+		HasMoreBefore: hasMoreBefore,
+		HasMoreAfter:  hasMoreAfter,
+	}
+	page.Messages = append(before, target)
+	page.Messages = append(page.Messages, after...)
+	return page.withCursors(channelID), nil
+}
+
+// withCursors sets the cursors continuing from the first and last message
+func (p MessagePage) withCursors(channelID int32) MessagePage {
+	if len(p.Messages) == 0 {
+		return p
+	}
+	if p.HasMoreBefore {
+		p.BeforeCursor = messageUtil.Cursor{ChannelID: channelID, Direction: messageUtil.CursorBefore, MessageID: p.Messages[0].ID}.Encode()
+	}
+	if p.HasMoreAfter {
+		p.AfterCursor = messageUtil.Cursor{ChannelID: channelID, Direction: messageUtil.CursorAfter, MessageID: p.Messages[len(p.Messages)-1].ID}.Encode()
+	}
+	return p
 }
 
 // EditMessage edits an existing message
@@ -80,19 +243,29 @@ func (s *MessageService) EditMessage(ctx context.Context, messageID, userID int3
 		return repo.Message{}, commonErrors.ErrPermissionDenied
 	}
 
-	return s.messageRepo.UpdateMessage(ctx, messageID, content)
+	message, err = s.messageRepo.UpdateMessage(ctx, messageID, content)
+	if err != nil {
+		return repo.Message{}, err
+	}
+
+	if channelID, ok := channelOf(message); ok {
+		s.publishToChannel(channelID, gatewayUtil.MessageUpdate(messageUtil.ConvertMessageToProto(message)))
+	}
+	return message, nil
 }
 
-// DeleteMessage deletes a message
+// DeleteMessage deletes a message. Authors can delete their own messages,
+// anyone else needs MANAGE_MESSAGES in the channel.
 func (s *MessageService) DeleteMessage(ctx context.Context, messageID, userID int32) error {
-	// Verify message exists and user owns it
 	message, err := s.messageRepo.GetMessageByID(ctx, messageID)
 	if err != nil {
 		return commonErrors.ErrNotFound
 	}
 
 	if message.SenderID != userID {
-		return commonErrors.ErrPermissionDenied
+		if err := s.requireMessage(ctx, message, userID, channelUtil.PermissionManageMessages); err != nil {
+			return err
+		}
 	}
 
 	// Delete attachments first
@@ -101,36 +274,48 @@ func (s *MessageService) DeleteMessage(ctx context.Context, messageID, userID in
 	// Delete reactions
 	_ = s.messageRepo.DeleteAllReactions(ctx, messageID)
 
-	return s.messageRepo.DeleteMessage(ctx, messageID)
+	if err := s.messageRepo.DeleteMessage(ctx, messageID); err != nil {
+		return err
+	}
+
+	if channelID, ok := channelOf(message); ok {
+		s.publishToChannel(channelID, gatewayUtil.MessageDelete(channelID, messageID))
+	}
+	return nil
 }
 
 // PinMessage pins a message
-func (s *MessageService) PinMessage(ctx context.Context, messageID int32) error {
-	// Verify message exists
-	_, err := s.messageRepo.GetMessageByID(ctx, messageID)
-	if err != nil {
-		return commonErrors.ErrNotFound
+func (s *MessageService) PinMessage(ctx context.Context, messageID, userID int32) error {
+	if _, err := s.getMessage(ctx, messageID, userID, channelUtil.PermissionManageMessages); err != nil {
+		return err
 	}
 
 	return s.messageRepo.PinMessage(ctx, messageID)
 }
 
 // UnpinMessage unpins a message
-func (s *MessageService) UnpinMessage(ctx context.Context, messageID int32) error {
+func (s *MessageService) UnpinMessage(ctx context.Context, messageID, userID int32) error {
+	if _, err := s.getMessage(ctx, messageID, userID, channelUtil.PermissionManageMessages); err != nil {
+		return err
+	}
+
 	return s.messageRepo.UnpinMessage(ctx, messageID)
 }
 
 // GetPinnedMessages retrieves all pinned messages in a channel
-func (s *MessageService) GetPinnedMessages(ctx context.Context, channelID int32) ([]repo.Message, error) {
+func (s *MessageService) GetPinnedMessages(ctx context.Context, channelID, userID int32) ([]repo.Message, error) {
+	if err := s.permissions.RequireChannel(ctx, channelID, userID, channelUtil.PermissionReadMessageHistory); err != nil {
+		return nil, err
+	}
+
 	return s.messageRepo.GetPinnedMessages(ctx, channelID)
 }
 
 // AddReaction adds a reaction to a message
 func (s *MessageService) AddReaction(ctx context.Context, messageID, userID int32, emoji string, emojiID *string) error {
-	// Verify message exists
-	_, err := s.messageRepo.GetMessageByID(ctx, messageID)
+	_, err := s.getMessage(ctx, messageID, userID, channelUtil.PermissionAddReactions|channelUtil.PermissionReadMessageHistory)
 	if err != nil {
-		return commonErrors.ErrNotFound
+		return err
 	}
 
 	// Check if user already reacted with this emoji
@@ -147,21 +332,36 @@ func (s *MessageService) AddReaction(ctx context.Context, messageID, userID int3
 	return err
 }
 
+// SendTyping checks that the user may type in a channel. Timed out members
+// are refused with a TimeoutError, as the timeout withholds SEND_MESSAGES.
+func (s *MessageService) SendTyping(ctx context.Context, channelID, userID int32) error {
+	return s.permissions.RequireChannel(ctx, channelID, userID, channelUtil.PermissionSendMessages)
+}
+
 // RemoveReaction removes a reaction from a message
 func (s *MessageService) RemoveReaction(ctx context.Context, messageID, userID int32, emoji string) error {
+	if _, err := s.getMessage(ctx, messageID, userID, channelUtil.PermissionViewChannel); err != nil {
+		return err
+	}
+
 	return s.messageRepo.DeleteReaction(ctx, messageID, userID, emoji)
 }
 
 // GetReactions retrieves reactions for a message
-func (s *MessageService) GetReactions(ctx context.Context, messageID int32, emoji *string) ([]repo.MessageReaction, error) {
+func (s *MessageService) GetReactions(ctx context.Context, messageID, userID int32, emoji *string) ([]repo.MessageReaction, error) {
+	if _, err := s.getMessage(ctx, messageID, userID, channelUtil.PermissionReadMessageHistory); err != nil {
+		return nil, err
+	}
+
 	if emoji != nil {
 		return s.messageRepo.GetReactionsByEmoji(ctx, messageID, *emoji)
 	}
 	return s.messageRepo.GetMessageReactions(ctx, messageID)
 }
 
-// BulkDeleteMessages deletes multiple messages
-func (s *MessageService) BulkDeleteMessages(ctx context.Context, messageIDs []int32) error {
+// BulkDeleteMessages deletes multiple messages; the user needs MANAGE_MESSAGES
+// in every channel the messages belong to
+func (s *MessageService) BulkDeleteMessages(ctx context.Context, userID int32, messageIDs []int32) error {
 	if len(messageIDs) == 0 {
 		return commonErrors.ErrInvalidInput
 	}
@@ -170,13 +370,68 @@ func (s *MessageService) BulkDeleteMessages(ctx context.Context, messageIDs []in
 		return errors.New("cannot delete more than 100 messages at once")
 	}
 
-	return s.messageRepo.BulkDeleteMessages(ctx, messageIDs)
+	if err := s.permissions.RequireMessages(ctx, messageIDs, userID, channelUtil.PermissionManageMessages); err != nil {
+		return err
+	}
+
+	// Group the messages by channel for the delete events
+	byChannel := make(map[int32][]int32)
+	for _, messageID := range messageIDs {
+		message, err := s.messageRepo.GetMessageByID(ctx, messageID)
+		if err != nil {
+			continue
+		}
+		if channelID, ok := channelOf(message); ok {
+			byChannel[channelID] = append(byChannel[channelID], messageID)
+		}
+	}
+
+	if err := s.messageRepo.BulkDeleteMessages(ctx, messageIDs); err != nil {
+		return err
+	}
+
+	for channelID, ids := range byChannel {
+		s.publishToChannel(channelID, gatewayUtil.MessageDelete(channelID, ids...))
+	}
+	return nil
 }
 
-// SearchMessages searches for messages in a channel
-func (s *MessageService) SearchMessages(ctx context.Context, channelID int32, query string, limit, offset int32) ([]repo.Message, error) {
-	if query == "" {
-		return nil, commonErrors.ErrInvalidInput
+// SearchMessages runs a full-text search in a channel, or across every channel
+// of a server the user can read when serverID is set. The query may contain
+// filters (see messageUtil.ParseSearchQuery); an in: filter narrows the scope.
+// It returns one page of results, best match first, and the total match count.
+func (s *MessageService) SearchMessages(ctx context.Context, userID, serverID, channelID int32, query string, limit, offset int32) ([]repo.SearchMessagesRow, int32, error) {
+	q := messageUtil.ParseSearchQuery(query)
+	if q.Text == "" && !q.HasFilters() {
+		return nil, 0, commonErrors.ErrInvalidInput
+	}
+
+	const permission = channelUtil.PermissionViewChannel | channelUtil.PermissionReadMessageHistory
+	var channelIDs []int32
+	switch {
+	case serverID != 0:
+		ids, err := s.permissions.ChannelsWithPermission(ctx, serverID, userID, permission)
+		if err != nil {
+			return nil, 0, err
+		}
+		channelIDs = ids
+	case channelID != 0:
+		if err := s.permissions.RequireChannel(ctx, channelID, userID, permission); err != nil {
+			return nil, 0, err
+		}
+		channelIDs = []int32{channelID}
+	default:
+		return nil, 0, commonErrors.ErrInvalidInput
+	}
+
+	// Narrow the scope to the channel given in the request and in the query
+	for _, only := range []*int32{nonZero(channelID), q.InChannelID} {
+		if only != nil {
+			channelIDs = intersect(channelIDs, *only)
+		}
+	}
+	if len(channelIDs) == 0 {
+		return nil, 0, nil
 	}
 
 	if limit <= 0 {
@@ -185,8 +440,11 @@ func (s *MessageService) SearchMessages(ctx context.Context, channelID int32, qu
 	if limit > 100 {
 		limit = 100
 	}
+	if offset < 0 {
+		offset = 0
+	}
 
-	return s.messageRepo.SearchMessages(ctx, channelID, query, limit, offset)
+	return s.messageRepo.SearchMessages(ctx, channelIDs, q, limit, offset)
 }
 
 // CreateAttachment creates an attachment for a message
@@ -204,3 +462,44 @@ func (s *MessageService) CreateAttachment(ctx context.Context, messageID int32,
 func (s *MessageService) GetMessageAttachments(ctx context.Context, messageID int32) ([]repo.MessageAttachment, error) {
 	return s.messageRepo.GetMessageAttachments(ctx, messageID)
 }
+
+// getMessage loads a channel message and checks permission in its channel
+func (s *MessageService) getMessage(ctx context.Context, messageID, userID int32, permission int64) (repo.Message, error) {
+	message, err := s.messageRepo.GetMessageByID(ctx, messageID)
+	if err != nil {
+		return repo.Message{}, commonErrors.ErrNotFound
+	}
+
+	if err := s.requireMessage(ctx, message, userID, permission); err != nil {
+		return repo.Message{}, err
+	}
+
+	return message, nil
+}
+
+// requireMessage checks permission in the channel a message was sent to
+func (s *MessageService) requireMessage(ctx context.Context, message repo.Message, userID int32, permission int64) error {
+	channelID, ok := channelOf(message)
+	if !ok {
+		return commonErrors.ErrNotFound
+	}
+
+	return s.permissions.RequireChannel(ctx, channelID, userID, permission)
+}
+
+func nonZero(id int32) *int32 {
+	if id == 0 {
+		return nil
+	}
+	return &id
+}
+
+// intersect returns the ids that equal only, which is at most one
+func intersect(ids []int32, only int32) []int32 {
+	for _, id := range ids {
+		if id == only {
+			return []int32{only}
+		}
+	}
+	return nil
+}
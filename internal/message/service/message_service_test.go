@@ -0,0 +1,85 @@
+package service
+
+import (
+	"testing"
+
+	"discord/gen/repo"
+	messageUtil "discord/internal/message/util"
+)
+
+func messagesWithIDs(ids ...int32) []repo.Message {
+	messages := make([]repo.Message, len(ids))
+	for i, id := range ids {
+		messages[i] = repo.Message{ID: id}
+	}
+	return messages
+}
+
+func TestTrimPage(t *testing.T) {
+	tests := []struct {
+		name    string
+		read    int
+		limit   int32
+		want    int
+		hasMore bool
+	}{
+		{"empty", 0, 3, 0, false},
+		{"fewer than the limit", 2, 3, 2, false},
+		{"exactly the limit", 3, 3, 3, false},
+		{"one extra row", 4, 3, 3, true},
+		{"zero limit with a row", 1, 0, 0, true},
+		{"zero limit without rows", 0, 0, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids := make([]int32, tt.read)
+			for i := range ids {
+				ids[i] = int32(i + 1)
+			}
+			page, hasMore := trimPage(messagesWithIDs(ids...), tt.limit)
+			if len(page) != tt.want || hasMore != tt.hasMore {
+				t.Errorf("expected %d messages and more %v, got %d and %v", tt.want, tt.hasMore, len(page), hasMore)
+			}
+		})
+	}
+}
+
+func TestWithCursors(t *testing.T) {
+	const channelID = 4
+
+	tests := []struct {
+		name          string
+		page          MessagePage
+		before, after int32 // message ids of the cursors, 0 for none
+	}{
+		{"empty page", MessagePage{HasMoreBefore: true, HasMoreAfter: true}, 0, 0},
+		{"no more either way", MessagePage{Messages: messagesWithIDs(5, 6)}, 0, 0},
+		{"more before", MessagePage{Messages: messagesWithIDs(5, 6), HasMoreBefore: true}, 5, 0},
+		{"more after", MessagePage{Messages: messagesWithIDs(5, 6), HasMoreAfter: true}, 0, 6},
+		{"single message both ways", MessagePage{Messages: messagesWithIDs(5), HasMoreBefore: true, HasMoreAfter: true}, 5, 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page := tt.page.withCursors(channelID)
+			checkCursor(t, page.BeforeCursor, channelID, messageUtil.CursorBefore, tt.before)
+			checkCursor(t, page.AfterCursor, channelID, messageUtil.CursorAfter, tt.after)
+		})
+	}
+}
+
+func checkCursor(t *testing.T, cursor string, channelID int32, direction string, messageID int32) {
+	t.Helper()
+	if messageID == 0 {
+		if cursor != "" {
+			t.Errorf("expected no cursor, got %q", cursor)
+		}
+		return
+	}
+	c, err := messageUtil.DecodeCursor(cursor, channelID)
+	if err != nil {
+		t.Fatalf("expected a cursor: %v", err)
+	}
+	if c.Direction != direction || c.MessageID != messageID {
+		t.Errorf("expected cursor %s from %d, got %s from %d", direction, messageID, c.Direction, c.MessageID)
+	}
+}
@@ -2,6 +2,9 @@ package service
 
 import (
 	"discord/gen/proto/schema"
+	gatewayPb "discord/gen/proto/service/gateway"
+	"discord/gen/repo"
+	gatewayUtil "discord/internal/gateway/util"
 	"discord/pkg/pubsub"
 	"strconv"
 )
@@ -20,3 +23,16 @@ func Stream(id int32) *pubsub.Channel {
 	ch := ps.Subscribe(Topic(id))
 	return ch
 }
+
+// publishToChannel sends a gateway event to everyone subscribed to the channel
+func (s *MessageService) publishToChannel(channelID int32, event *gatewayPb.GatewayEvent) {
+	s.pubsub.Publish(gatewayUtil.ChannelTopic(channelID), event)
+}
+
+// channelOf returns the channel of a message, or false for direct messages
+func channelOf(message repo.Message) (int32, bool) {
+	if !message.Ischannel.Bool || !message.ChannelID.Valid {
+		return 0, false
+	}
+	return message.ChannelID.Int32, true
+}
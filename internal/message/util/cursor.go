@@ -0,0 +1,57 @@
+package util
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Cursor directions
+const (
+	CursorBefore = "before"
+	CursorAfter  = "after"
+)
+
+// ErrInvalidCursor is returned for cursors that were not issued for the channel
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor is a position in a channel's history. Messages are ordered by id,
+// which only grows, so a cursor stays valid while new messages arrive.
+type Cursor struct {
+	ChannelID int32
+	Direction string
+	MessageID int32
+}
+
+// Encode returns the opaque form of the cursor handed to clients
+func (c Cursor) Encode() string {
+	raw := fmt.Sprintf("%d:%s:%d", c.ChannelID, c.Direction, c.MessageID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeCursor parses a cursor and checks that it belongs to the channel
+func DecodeCursor(cursor string, channelID int32) (Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != 3 {
+		return Cursor{}, ErrInvalidCursor
+	}
+	cursorChannelID, err := strconv.ParseInt(parts[0], 10, 32)
+	if err != nil || int32(cursorChannelID) != channelID {
+		return Cursor{}, ErrInvalidCursor
+	}
+	messageID, err := strconv.ParseInt(parts[2], 10, 32)
+	if err != nil || messageID <= 0 {
+		return Cursor{}, ErrInvalidCursor
+	}
+	if parts[1] != CursorBefore && parts[1] != CursorAfter {
+		return Cursor{}, ErrInvalidCursor
+	}
+	return Cursor{ChannelID: channelID, Direction: parts[1], MessageID: int32(messageID)}, nil
+}
@@ -0,0 +1,26 @@
+package util
+
+import "testing"
+
+func TestCursorRoundTrip(t *testing.T) {
+	c := Cursor{ChannelID: 3, Direction: CursorAfter, MessageID: 42}
+
+	decoded, err := DecodeCursor(c.Encode(), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded != c {
+		t.Errorf("expected %+v, got %+v", c, decoded)
+	}
+}
+
+func TestDecodeCursorRejectsOtherChannels(t *testing.T) {
+	c := Cursor{ChannelID: 3, Direction: CursorBefore, MessageID: 42}
+
+	if _, err := DecodeCursor(c.Encode(), 4); err != ErrInvalidCursor {
+		t.Errorf("expected ErrInvalidCursor, got %v", err)
+	}
+	if _, err := DecodeCursor("not a cursor", 3); err != ErrInvalidCursor {
+		t.Errorf("expected ErrInvalidCursor, got %v", err)
+	}
+}
@@ -8,13 +8,14 @@ import (
 // ConvertMessageToProto converts a repo.Message to proto.Message
 func ConvertMessageToProto(message repo.Message) *schema.Message {
 	pbMessage := &schema.Message{
-		Id:        message.ID,
-		ChannelId: message.ChannelID.Int32,
-		SenderId:  message.SenderID,
-		Content:   message.Content,
-		IsEdited:  message.IsEdited.Bool,
-		IsPinned:  message.IsPinned.Bool,
-		CreatedAt: message.CreatedAt.Time.Unix(),
+		Id:          message.ID,
+		ChannelId:   message.ChannelID.Int32,
+		DmChannelId: message.DmChannelID.Int32,
+		SenderId:    message.SenderID,
+		Content:     message.Content,
+		IsEdited:    message.IsEdited.Bool,
+		IsPinned:    message.IsPinned.Bool,
+		CreatedAt:   message.CreatedAt.Time.Unix(),
 	}
 
 	if message.ReplyToMessageID.Valid {
@@ -0,0 +1,43 @@
+package util
+
+import (
+	"regexp"
+	"strconv"
+)
+
+var (
+	userMentionRe     = regexp.MustCompile(`<@!?(\d+)>`)
+	roleMentionRe     = regexp.MustCompile(`<@&(\d+)>`)
+	everyoneMentionRe = regexp.MustCompile(`@(everyone|here)\b`)
+)
+
+// Mentions are the users and roles a message mentions, written as <@12> (or
+// <@!12>) and <@&3>, and whether it mentions @everyone or @here
+type Mentions struct {
+	UserIDs  []int32
+	RoleIDs  []int32
+	Everyone bool
+}
+
+// ParseMentions extracts the mentions of a message, each id once
+func ParseMentions(content string) Mentions {
+	return Mentions{
+		UserIDs:  mentionIDs(userMentionRe, content),
+		RoleIDs:  mentionIDs(roleMentionRe, content),
+		Everyone: everyoneMentionRe.MatchString(content),
+	}
+}
+
+func mentionIDs(re *regexp.Regexp, content string) []int32 {
+	var ids []int32
+	seen := make(map[int32]bool)
+	for _, match := range re.FindAllStringSubmatch(content, -1) {
+		id, err := strconv.ParseInt(match[1], 10, 32)
+		if err != nil || id <= 0 || seen[int32(id)] {
+			continue
+		}
+		seen[int32(id)] = true
+		ids = append(ids, int32(id))
+	}
+	return ids
+}
@@ -0,0 +1,35 @@
+package util
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseMentions(t *testing.T) {
+	m := ParseMentions("<@12> and <@!7> please ask <@&3>, cc <@12>")
+
+	if !reflect.DeepEqual(m.UserIDs, []int32{12, 7}) {
+		t.Errorf("expected users [12 7], got %v", m.UserIDs)
+	}
+	if !reflect.DeepEqual(m.RoleIDs, []int32{3}) {
+		t.Errorf("expected roles [3], got %v", m.RoleIDs)
+	}
+	if m.Everyone {
+		t.Error("expected no @everyone")
+	}
+}
+
+func TestParseMentionsEveryone(t *testing.T) {
+	if !ParseMentions("hey @everyone").Everyone {
+		t.Error("expected @everyone")
+	}
+	if !ParseMentions("@here, standup").Everyone {
+		t.Error("expected @here")
+	}
+	if ParseMentions("mail me at someone@everyones.example").Everyone {
+		t.Error("expected no mention inside a word")
+	}
+	if m := ParseMentions("<@0> <@99999999999> <@abc>"); len(m.UserIDs) != 0 {
+		t.Errorf("expected invalid ids to be skipped, got %v", m.UserIDs)
+	}
+}
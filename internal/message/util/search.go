@@ -0,0 +1,94 @@
+package util
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SearchQuery is a parsed message search: free text plus the filters written
+// as key:value terms, e.g. "deploy from:12 in:4 has:attachment before:2025-01-31".
+// Unset filters are nil.
+type SearchQuery struct {
+	Text           string
+	FromUserID     *int32
+	InChannelID    *int32
+	HasAttachment  *bool
+	MentionsUserID *int32
+	Before         *time.Time
+	After          *time.Time
+	Pinned         *bool
+}
+
+// ParseSearchQuery splits filters from the search text. Users and channels are
+// given by id, optionally in mention form (<@12>, <#4>); dates are YYYY-MM-DD
+// in UTC. Terms with an unknown key or a malformed value are searched as text.
+func ParseSearchQuery(query string) SearchQuery {
+	var q SearchQuery
+	var text []string
+	for _, term := range strings.Fields(query) {
+		key, value, ok := strings.Cut(term, ":")
+		if !ok || value == "" || !q.setFilter(strings.ToLower(key), value) {
+			text = append(text, term)
+		}
+	}
+	q.Text = strings.Join(text, " ")
+	return q
+}
+
+// HasFilters reports whether any filter is set
+func (q SearchQuery) HasFilters() bool {
+	return q.FromUserID != nil || q.InChannelID != nil || q.HasAttachment != nil ||
+		q.MentionsUserID != nil || q.Before != nil || q.After != nil || q.Pinned != nil
+}
+
+func (q *SearchQuery) setFilter(key, value string) bool {
+	switch key {
+	case "from":
+		return setID(&q.FromUserID, value, "<@")
+	case "in":
+		return setID(&q.InChannelID, value, "<#")
+	case "mentions":
+		return setID(&q.MentionsUserID, value, "<@")
+	case "has":
+		switch strings.ToLower(value) {
+		case "attachment", "file":
+			has := true
+			q.HasAttachment = &has
+			return true
+		}
+		return false
+	case "pinned":
+		pinned, err := strconv.ParseBool(value)
+		if err != nil {
+			return false
+		}
+		q.Pinned = &pinned
+		return true
+	case "before":
+		return setDate(&q.Before, value)
+	case "after":
+		return setDate(&q.After, value)
+	}
+	return false
+}
+
+func setID(dst **int32, value, mentionPrefix string) bool {
+	value = strings.TrimSuffix(strings.TrimPrefix(value, mentionPrefix), ">")
+	id, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || id <= 0 {
+		return false
+	}
+	v := int32(id)
+	*dst = &v
+	return true
+}
+
+func setDate(dst **time.Time, value string) bool {
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return false
+	}
+	*dst = &t
+	return true
+}
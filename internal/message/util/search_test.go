@@ -0,0 +1,46 @@
+package util
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseSearchQuery(t *testing.T) {
+	q := ParseSearchQuery("deploy failed from:12 in:<#4> has:attachment mentions:<@7> before:2025-01-31 after:2025-01-01 pinned:true")
+
+	if q.Text != "deploy failed" {
+		t.Errorf("expected text %q, got %q", "deploy failed", q.Text)
+	}
+	if q.FromUserID == nil || *q.FromUserID != 12 {
+		t.Errorf("expected from 12, got %v", q.FromUserID)
+	}
+	if q.InChannelID == nil || *q.InChannelID != 4 {
+		t.Errorf("expected in 4, got %v", q.InChannelID)
+	}
+	if q.HasAttachment == nil || !*q.HasAttachment {
+		t.Errorf("expected has:attachment, got %v", q.HasAttachment)
+	}
+	if q.MentionsUserID == nil || *q.MentionsUserID != 7 {
+		t.Errorf("expected mentions 7, got %v", q.MentionsUserID)
+	}
+	if q.Before == nil || !q.Before.Equal(time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected before %v", q.Before)
+	}
+	if q.After == nil || !q.After.Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected after %v", q.After)
+	}
+	if q.Pinned == nil || !*q.Pinned {
+		t.Errorf("expected pinned, got %v", q.Pinned)
+	}
+}
+
+func TestParseSearchQueryKeepsUnknownTerms(t *testing.T) {
+	q := ParseSearchQuery("error: from:someone https://example.com before:yesterday")
+
+	if q.Text != "error: from:someone https://example.com before:yesterday" {
+		t.Errorf("unexpected text %q", q.Text)
+	}
+	if q.HasFilters() {
+		t.Errorf("expected no filters, got %+v", q)
+	}
+}
@@ -0,0 +1,100 @@
+package controller
+
+import (
+	"context"
+
+	permissionPb "discord/gen/proto/service/permission"
+	commonErrors "discord/internal/common/errors"
+	permissionService "discord/internal/permission/service"
+	serverService "discord/internal/server/service"
+)
+
+type PermissionController struct {
+	permissionPb.UnimplementedPermissionServiceServer
+	permissionService *permissionService.PermissionService
+	serverService     *serverService.ServerService
+}
+
+// NewPermissionController creates the permission controller. Roles are
+// created through the server service, which owns role management.
+func NewPermissionController(permissionService *permissionService.PermissionService, serverService *serverService.ServerService) *permissionPb.PermissionServiceServer {
+	controller := &PermissionController{
+		permissionService: permissionService,
+		serverService:     serverService,
+	}
+	var grpcController permissionPb.PermissionServiceServer = controller
+	return &grpcController
+}
+
+// CreatePermission resolves a built-in permission flag by name
+func (c *PermissionController) CreatePermission(ctx context.Context, req *permissionPb.CreatePermissionRequest) (*permissionPb.CreatePermissionResponse, error) {
+	permission, err := c.permissionService.CreatePermission(ctx, req.GetName())
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	return &permissionPb.CreatePermissionResponse{
+		Permission: permission,
+		Success:    true,
+	}, nil
+}
+
+// GetPermissions lists all permission flags
+func (c *PermissionController) GetPermissions(ctx context.Context, req *permissionPb.GetPermissionsRequest) (*permissionPb.GetPermissionsResponse, error) {
+	return &permissionPb.GetPermissionsResponse{
+		Permissions: c.permissionService.GetPermissions(ctx),
+	}, nil
+}
+
+// CreateRole creates a new role in a server
+func (c *PermissionController) CreateRole(ctx context.Context, req *permissionPb.CreateRoleRequest) (*permissionPb.CreateRoleResponse, error) {
+	userID, ok := ctx.Value("user_id").(int32)
+	if !ok {
+		return nil, commonErrors.ToGRPCError(commonErrors.ErrUnauthorized)
+	}
+
+	var color, description *string
+	if req.GetColor() != "" {
+		color = &req.Color
+	}
+	if req.GetDescription() != "" {
+		description = &req.Description
+	}
+
+	role, err := c.serverService.CreateRole(
+		ctx,
+		req.GetServerId(),
+		userID,
+		req.GetName(),
+		color,
+		req.GetHoist(),
+		req.GetMentionable(),
+		req.GetPermissions(),
+		description,
+	)
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	return &permissionPb.CreateRoleResponse{
+		Role:    permissionService.ToProtoRole(role),
+		Success: true,
+	}, nil
+}
+
+// GetRoles retrieves all roles for a server
+func (c *PermissionController) GetRoles(ctx context.Context, req *permissionPb.GetRolesRequest) (*permissionPb.GetRolesResponse, error) {
+	userID, ok := ctx.Value("user_id").(int32)
+	if !ok {
+		return nil, commonErrors.ToGRPCError(commonErrors.ErrUnauthorized)
+	}
+
+	roles, err := c.permissionService.GetRoles(ctx, req.GetServerId(), userID)
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	return &permissionPb.GetRolesResponse{
+		Roles: roles,
+	}, nil
+}
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"discord/gen/repo"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PermissionRepository struct {
+	queries *repo.Queries
+	db      *pgxpool.Pool
+}
+
+func NewPermissionRepository(db *pgxpool.Pool) *PermissionRepository {
+	return &PermissionRepository{
+		queries: repo.New(db),
+		db:      db,
+	}
+}
+
+// GetServerByID retrieves a server by ID
+func (r *PermissionRepository) GetServerByID(ctx context.Context, serverID int32) (repo.Server, error) {
+	return r.queries.GetServerByID(ctx, serverID)
+}
+
+// GetServerMember retrieves a user's membership in a server
+func (r *PermissionRepository) GetServerMember(ctx context.Context, serverID, userID int32) (repo.ServerMember, error) {
+	return r.queries.GetServerMember(ctx, repo.GetServerMemberParams{
+		ServerID: serverID,
+		UserID:   userID,
+	})
+}
+
+// GetEveryoneRole retrieves the @everyone role of a server
+func (r *PermissionRepository) GetEveryoneRole(ctx context.Context, serverID int32) (repo.Role, error) {
+	return r.queries.GetEveryoneRole(ctx, serverID)
+}
+
+// GetMemberRoles retrieves the roles assigned to a user in a server
+func (r *PermissionRepository) GetMemberRoles(ctx context.Context, serverID, userID int32) ([]repo.Role, error) {
+	return r.queries.GetMemberRolesByUser(ctx, repo.GetMemberRolesByUserParams{
+		ServerID: serverID,
+		UserID:   userID,
+	})
+}
+
+// GetChannelByID retrieves a channel by ID
+func (r *PermissionRepository) GetChannelByID(ctx context.Context, channelID int32) (repo.Channel, error) {
+	return r.queries.GetChannelByID(ctx, channelID)
+}
+
+// GetServerChannels retrieves the channels of a server
+func (r *PermissionRepository) GetServerChannels(ctx context.Context, serverID int32) ([]repo.Channel, error) {
+	return r.queries.GetServerChannels(ctx, serverID)
+}
+
+// GetChannelOverwrites retrieves all permission overwrites of a channel
+func (r *PermissionRepository) GetChannelOverwrites(ctx context.Context, channelID int32) ([]repo.ChannelPermission, error) {
+	return r.queries.GetChannelPermissions(ctx, channelID)
+}
+
+// GetMessagesChannelIDs retrieves the distinct channels of a set of channel messages
+func (r *PermissionRepository) GetMessagesChannelIDs(ctx context.Context, messageIDs []int32) ([]int32, error) {
+	return r.queries.GetMessagesChannelIDs(ctx, messageIDs)
+}
+
+// GetServerRoles retrieves all roles for a server
+func (r *PermissionRepository) GetServerRoles(ctx context.Context, serverID int32) ([]repo.Role, error) {
+	return r.queries.GetServerRoles(ctx, serverID)
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"discord/gen/proto/schema"
+	"discord/gen/repo"
+	commonErrors "discord/internal/common/errors"
+	permissionRepo "discord/internal/permission/repository"
+)
+
+type PermissionService struct {
+	permissionRepo *permissionRepo.PermissionRepository
+	permissions    *Resolver
+}
+
+func NewPermissionService(permissionRepo *permissionRepo.PermissionRepository, permissions *Resolver) *PermissionService {
+	return &PermissionService{
+		permissionRepo: permissionRepo,
+		permissions:    permissions,
+	}
+}
+
+// GetPermissions lists the built-in permission flags
+func (s *PermissionService) GetPermissions(ctx context.Context) []*schema.Permission {
+	flags := make([]int32, 0, len(schema.PermissionFlag_name))
+	for value := range schema.PermissionFlag_name {
+		if value != int32(schema.PermissionFlag_NONE) {
+			flags = append(flags, value)
+		}
+	}
+	sort.Slice(flags, func(i, j int) bool { return flags[i] < flags[j] })
+
+	permissions := make([]*schema.Permission, len(flags))
+	for i, flag := range flags {
+		permissions[i] = toProtoPermission(schema.PermissionFlag(flag))
+	}
+	return permissions
+}
+
+// CreatePermission resolves a permission by name. Permissions are a fixed
+// bitfield shared with clients, so only the built-in flags can be "created";
+// unknown names are rejected.
+func (s *PermissionService) CreatePermission(ctx context.Context, name string) (*schema.Permission, error) {
+	key := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(name), " ", "_"))
+	value, ok := schema.PermissionFlag_value[key]
+	if !ok || value == int32(schema.PermissionFlag_NONE) {
+		return nil, commonErrors.ErrInvalidInput
+	}
+	return toProtoPermission(schema.PermissionFlag(value)), nil
+}
+
+// GetRoles retrieves all roles for a server
+func (s *PermissionService) GetRoles(ctx context.Context, serverID, userID int32) ([]*schema.Role, error) {
+	if err := s.permissions.RequireMember(ctx, serverID, userID); err != nil {
+		return nil, err
+	}
+
+	roles, err := s.permissionRepo.GetServerRoles(ctx, serverID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*schema.Role, len(roles))
+	for i, role := range roles {
+		result[i] = ToProtoRole(role)
+	}
+	return result, nil
+}
+
+// Helper functions
+
+func toProtoPermission(flag schema.PermissionFlag) *schema.Permission {
+	return &schema.Permission{
+		Id:          int32(flag),
+		Name:        flag.String(),
+		Description: strings.ReplaceAll(strings.ToLower(flag.String()), "_", " "),
+		Permissions: int64(flag),
+	}
+}
+
+// ToProtoRole converts a role row to its proto representation
+func ToProtoRole(role repo.Role) *schema.Role {
+	return &schema.Role{
+		Id:          role.ID,
+		ServerId:    role.ServerID,
+		Name:        role.Name,
+		Color:       role.Color.String,
+		Hoist:       role.Hoist.Bool,
+		Position:    role.Position.Int32,
+		Permissions: role.Permissions.Int64,
+		Mentionable: role.Mentionable.Bool,
+		Description: role.Description.String,
+		Icon:        role.Icon.String,
+		CreatedAt:   role.CreatedAt.Time.Unix(),
+		UpdatedAt:   role.UpdatedAt.Time.Unix(),
+		IsDeleted:   role.IsDeleted.Bool,
+	}
+}
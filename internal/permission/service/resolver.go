@@ -0,0 +1,322 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"discord/gen/repo"
+	channelUtil "discord/internal/channel/util"
+	commonErrors "discord/internal/common/errors"
+	permissionRepo "discord/internal/permission/repository"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// NewRolePosition is where new roles are placed: directly above @everyone,
+// which always sits at position 0
+const NewRolePosition int32 = 1
+
+// Resolver computes a user's effective permissions from the server's @everyone
+// role, the roles assigned to the member and channel permission overwrites.
+// Services call it before any action that needs a permission.
+type Resolver struct {
+	permissionRepo *permissionRepo.PermissionRepository
+}
+
+func NewResolver(permissionRepo *permissionRepo.PermissionRepository) *Resolver {
+	return &Resolver{
+		permissionRepo: permissionRepo,
+	}
+}
+
+// memberPermissions is a member's server-level permissions plus the role ids
+// needed to pick the applicable channel overwrites. highestPosition is the
+// position of the member's highest role, 0 (@everyone) when they have none.
+// timedOutUntil is set while the member is timed out.
+type memberPermissions struct {
+	base            int64
+	everyoneRoleID  int32
+	roleIDs         map[int32]struct{}
+	owner           bool
+	highestPosition int32
+	timedOutUntil   time.Time
+}
+
+// restrict withholds the permissions a timed out member loses
+func (m *memberPermissions) restrict(permissions int64) int64 {
+	if m.timedOutUntil.IsZero() {
+		return permissions
+	}
+	return permissions &^ channelUtil.TimeoutRestrictedPermissions
+}
+
+// require checks that permissions hold every bit of permission. A member who
+// only lacks it because of a timeout is told until when the timeout lasts.
+func (m *memberPermissions) require(permissions, permission int64) error {
+	if channelUtil.HasPermission(permissions, permission) {
+		return nil
+	}
+	if !m.timedOutUntil.IsZero() && permission&channelUtil.TimeoutRestrictedPermissions != 0 {
+		return commonErrors.NewTimeoutError(m.timedOutUntil)
+	}
+	return commonErrors.ErrPermissionDenied
+}
+
+// outranks reports whether the member may moderate target: the owner outranks
+// everyone, nobody outranks the owner and equal positions do not outrank
+func (m *memberPermissions) outranks(target *memberPermissions) bool {
+	if m.owner {
+		return true
+	}
+	return !target.owner && m.highestPosition > target.highestPosition
+}
+
+// above reports whether a role position is below the member's highest role
+func (m *memberPermissions) above(position int32) bool {
+	return m.owner || position < m.highestPosition
+}
+
+// ServerPermissions returns the user's permissions in a server
+func (r *Resolver) ServerPermissions(ctx context.Context, serverID, userID int32) (int64, error) {
+	member, err := r.member(ctx, serverID, userID)
+	if err != nil {
+		return 0, err
+	}
+	return member.restrict(member.base), nil
+}
+
+// ChannelPermissions returns the user's permissions in a channel after overwrites
+func (r *Resolver) ChannelPermissions(ctx context.Context, channelID, userID int32) (int64, error) {
+	member, err := r.channelMember(ctx, channelID, userID)
+	if err != nil {
+		return 0, err
+	}
+	return r.channelPermissions(ctx, member, channelID, userID)
+}
+
+// ChannelsWithPermission returns the channels of a server in which the user
+// holds every bit of permission, in channel order
+func (r *Resolver) ChannelsWithPermission(ctx context.Context, serverID, userID int32, permission int64) ([]int32, error) {
+	member, err := r.member(ctx, serverID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	channels, err := r.permissionRepo.GetServerChannels(ctx, serverID)
+	if err != nil {
+		return nil, err
+	}
+
+	channelIDs := make([]int32, 0, len(channels))
+	for _, channel := range channels {
+		permissions, err := r.channelPermissions(ctx, member, channel.ID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if channelUtil.HasPermission(permissions, permission) {
+			channelIDs = append(channelIDs, channel.ID)
+		}
+	}
+	return channelIDs, nil
+}
+
+// channelPermissions applies a channel's overwrites to a member's permissions
+func (r *Resolver) channelPermissions(ctx context.Context, member *memberPermissions, channelID, userID int32) (int64, error) {
+	if channelUtil.IsAdministrator(member.base) {
+		return channelUtil.AllPermissions, nil
+	}
+
+	overwrites, err := r.permissionRepo.GetChannelOverwrites(ctx, channelID)
+	if err != nil {
+		return 0, err
+	}
+	return member.applyOverwrites(overwrites, userID), nil
+}
+
+// applyOverwrites picks the overwrites of a channel that apply to the member
+// and returns the member's permissions in that channel
+func (m *memberPermissions) applyOverwrites(overwrites []repo.ChannelPermission, userID int32) int64 {
+	var everyoneOverwrite, userOverwrite *channelUtil.ChannelOverwrite
+	roleOverwrites := make(map[int32]*channelUtil.ChannelOverwrite)
+	for _, ow := range overwrites {
+		overwrite := &channelUtil.ChannelOverwrite{
+			ID:    ow.ID,
+			Allow: ow.AllowPermissions.Int64,
+			Deny:  ow.DenyPermissions.Int64,
+		}
+		switch {
+		case ow.UserID.Valid && ow.UserID.Int32 == userID:
+			overwrite.Type = "member"
+			userOverwrite = overwrite
+		case ow.RoleID.Valid && ow.RoleID.Int32 == m.everyoneRoleID:
+			overwrite.Type = "role"
+			everyoneOverwrite = overwrite
+		case ow.RoleID.Valid:
+			if _, ok := m.roleIDs[ow.RoleID.Int32]; ok {
+				overwrite.Type = "role"
+				roleOverwrites[ow.RoleID.Int32] = overwrite
+			}
+		}
+	}
+
+	permissions := channelUtil.CalculateChannelPermissions(m.base, everyoneOverwrite, roleOverwrites, userOverwrite)
+	return m.restrict(permissions)
+}
+
+// RequireMember returns ErrPermissionDenied unless the user is a member of the server
+func (r *Resolver) RequireMember(ctx context.Context, serverID, userID int32) error {
+	_, err := r.member(ctx, serverID, userID)
+	return err
+}
+
+// RequireServer returns ErrPermissionDenied unless the user holds every bit of
+// permission in the server
+func (r *Resolver) RequireServer(ctx context.Context, serverID, userID int32, permission int64) error {
+	member, err := r.member(ctx, serverID, userID)
+	if err != nil {
+		return err
+	}
+	return member.require(member.restrict(member.base), permission)
+}
+
+// RequireChannel returns ErrPermissionDenied unless the user holds every bit of
+// permission in the channel
+func (r *Resolver) RequireChannel(ctx context.Context, channelID, userID int32, permission int64) error {
+	member, err := r.channelMember(ctx, channelID, userID)
+	if err != nil {
+		return err
+	}
+	permissions, err := r.channelPermissions(ctx, member, channelID, userID)
+	if err != nil {
+		return err
+	}
+	return member.require(permissions, permission)
+}
+
+// RequireMessages checks permission in every channel the given messages belong to
+func (r *Resolver) RequireMessages(ctx context.Context, messageIDs []int32, userID int32, permission int64) error {
+	channelIDs, err := r.permissionRepo.GetMessagesChannelIDs(ctx, messageIDs)
+	if err != nil {
+		return err
+	}
+	if len(channelIDs) == 0 {
+		return commonErrors.ErrNotFound
+	}
+	for _, channelID := range channelIDs {
+		if err := r.RequireChannel(ctx, channelID, userID, permission); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RequireAbove enforces the role hierarchy for moderation: the actor's highest
+// role must be strictly above the target's. The owner can act on anyone and
+// nobody can act on the owner. Targets that are not members have no roles.
+func (r *Resolver) RequireAbove(ctx context.Context, serverID, actorID, targetUserID int32) error {
+	actor, err := r.member(ctx, serverID, actorID)
+	if err != nil {
+		return err
+	}
+	if actor.owner {
+		return nil
+	}
+
+	target, err := r.member(ctx, serverID, targetUserID)
+	switch {
+	case errors.Is(err, commonErrors.ErrPermissionDenied):
+		return nil
+	case err != nil:
+		return err
+	}
+	if !actor.outranks(target) {
+		return commonErrors.ErrPermissionDenied
+	}
+	return nil
+}
+
+// RequireRoleBelow returns ErrPermissionDenied unless every position is below
+// the actor's highest role. The owner bypasses the hierarchy.
+func (r *Resolver) RequireRoleBelow(ctx context.Context, serverID, actorID int32, positions ...int32) error {
+	actor, err := r.member(ctx, serverID, actorID)
+	if err != nil {
+		return err
+	}
+	for _, position := range positions {
+		if !actor.above(position) {
+			return commonErrors.ErrPermissionDenied
+		}
+	}
+	return nil
+}
+
+// RequireGrantable returns ErrPermissionDenied if permissions include bits the
+// actor does not hold, so roles cannot be used to escalate privileges
+func (r *Resolver) RequireGrantable(ctx context.Context, serverID, actorID int32, permissions int64) error {
+	return r.RequireServer(ctx, serverID, actorID, permissions)
+}
+
+// channelMember loads the permissions of a user in the server of a channel
+func (r *Resolver) channelMember(ctx context.Context, channelID, userID int32) (*memberPermissions, error) {
+	channel, err := r.permissionRepo.GetChannelByID(ctx, channelID)
+	if err != nil {
+		return nil, commonErrors.ErrNotFound
+	}
+	return r.member(ctx, channel.ServerID, userID)
+}
+
+// member loads the server-level permissions of a user. The owner holds every
+// permission; non-members hold none and are denied. Owners and administrators
+// cannot be timed out.
+func (r *Resolver) member(ctx context.Context, serverID, userID int32) (*memberPermissions, error) {
+	server, err := r.permissionRepo.GetServerByID(ctx, serverID)
+	if err != nil {
+		return nil, commonErrors.ErrNotFound
+	}
+
+	member := &memberPermissions{roleIDs: make(map[int32]struct{})}
+	if server.OwnerID == userID {
+		member.owner = true
+		member.base = channelUtil.AllPermissions
+		return member, nil
+	}
+
+	serverMember, err := r.permissionRepo.GetServerMember(ctx, serverID, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, commonErrors.ErrPermissionDenied
+		}
+		return nil, err
+	}
+
+	var everyonePermissions int64
+	everyone, err := r.permissionRepo.GetEveryoneRole(ctx, serverID)
+	switch {
+	case err == nil:
+		member.everyoneRoleID = everyone.ID
+		everyonePermissions = everyone.Permissions.Int64
+	case !errors.Is(err, pgx.ErrNoRows):
+		return nil, err
+	}
+
+	roles, err := r.permissionRepo.GetMemberRoles(ctx, serverID, userID)
+	if err != nil {
+		return nil, err
+	}
+	rolePermissions := make([]int64, len(roles))
+	for i, role := range roles {
+		member.roleIDs[role.ID] = struct{}{}
+		rolePermissions[i] = role.Permissions.Int64
+		if role.Position.Int32 > member.highestPosition {
+			member.highestPosition = role.Position.Int32
+		}
+	}
+
+	member.base = channelUtil.CalculateBasePermissions(everyonePermissions, rolePermissions...)
+	timeout := serverMember.CommunicationDisabledUntil
+	if timeout.Valid && timeout.Time.After(time.Now()) && !channelUtil.IsAdministrator(member.base) {
+		member.timedOutUntil = timeout.Time
+	}
+	return member, nil
+}
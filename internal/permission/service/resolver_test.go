@@ -0,0 +1,189 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"discord/gen/repo"
+	channelUtil "discord/internal/channel/util"
+	commonErrors "discord/internal/common/errors"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestOutranks(t *testing.T) {
+	owner := &memberPermissions{owner: true}
+	admin := &memberPermissions{highestPosition: 5, base: channelUtil.AllPermissions}
+	moderator := &memberPermissions{highestPosition: 3}
+	peer := &memberPermissions{highestPosition: 3}
+	member := &memberPermissions{}
+
+	tests := []struct {
+		name          string
+		actor, target *memberPermissions
+		want          bool
+	}{
+		{"owner over admin", owner, admin, true},
+		{"owner over member", owner, member, true},
+		{"admin over moderator", admin, moderator, true},
+		{"admin not over owner", admin, owner, false},
+		{"moderator not over admin", moderator, admin, false},
+		{"equal positions", moderator, peer, false},
+		{"moderator over member", moderator, member, true},
+		{"member not over member", member, &memberPermissions{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.actor.outranks(tt.target); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestAbove(t *testing.T) {
+	tests := []struct {
+		name     string
+		actor    *memberPermissions
+		position int32
+		want     bool
+	}{
+		{"owner above any position", &memberPermissions{owner: true}, 100, true},
+		{"below highest role", &memberPermissions{highestPosition: 3}, 2, true},
+		{"equal to highest role", &memberPermissions{highestPosition: 3}, 3, false},
+		{"above highest role", &memberPermissions{highestPosition: 3}, 4, false},
+		{"no roles", &memberPermissions{}, NewRolePosition, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.actor.above(tt.position); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func overwrite(roleID, userID int32, allow, deny int64) repo.ChannelPermission {
+	ow := repo.ChannelPermission{
+		AllowPermissions: pgtype.Int8{Int64: allow, Valid: true},
+		DenyPermissions:  pgtype.Int8{Int64: deny, Valid: true},
+	}
+	if roleID != 0 {
+		ow.RoleID = pgtype.Int4{Int32: roleID, Valid: true}
+	}
+	if userID != 0 {
+		ow.UserID = pgtype.Int4{Int32: userID, Valid: true}
+	}
+	return ow
+}
+
+func TestApplyOverwrites(t *testing.T) {
+	const (
+		everyoneRole = 1
+		memberRole   = 2
+		otherRole    = 3
+		userID       = 10
+		otherUser    = 11
+		base         = channelUtil.PermissionViewChannel | channelUtil.PermissionSendMessages
+	)
+	member := func() *memberPermissions {
+		return &memberPermissions{
+			base:           base,
+			everyoneRoleID: everyoneRole,
+			roleIDs:        map[int32]struct{}{memberRole: {}},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		member     *memberPermissions
+		overwrites []repo.ChannelPermission
+		want       int64
+	}{
+		{
+			name:   "no overwrites",
+			member: member(),
+			want:   base,
+		},
+		{
+			name:       "everyone overwrite applies",
+			member:     member(),
+			overwrites: []repo.ChannelPermission{overwrite(everyoneRole, 0, 0, channelUtil.PermissionSendMessages)},
+			want:       channelUtil.PermissionViewChannel,
+		},
+		{
+			name:   "role overwrite beats everyone",
+			member: member(),
+			overwrites: []repo.ChannelPermission{
+				overwrite(everyoneRole, 0, 0, channelUtil.PermissionSendMessages),
+				overwrite(memberRole, 0, channelUtil.PermissionSendMessages, 0),
+			},
+			want: base,
+		},
+		{
+			name:       "roles the member lacks are ignored",
+			member:     member(),
+			overwrites: []repo.ChannelPermission{overwrite(otherRole, 0, 0, channelUtil.PermissionSendMessages)},
+			want:       base,
+		},
+		{
+			name:   "member overwrite beats roles",
+			member: member(),
+			overwrites: []repo.ChannelPermission{
+				overwrite(memberRole, 0, channelUtil.PermissionManageMessages, 0),
+				overwrite(0, userID, 0, channelUtil.PermissionManageMessages|channelUtil.PermissionSendMessages),
+			},
+			want: channelUtil.PermissionViewChannel,
+		},
+		{
+			name:       "other members' overwrites are ignored",
+			member:     member(),
+			overwrites: []repo.ChannelPermission{overwrite(0, otherUser, 0, channelUtil.PermissionViewChannel)},
+			want:       base,
+		},
+		{
+			name:       "administrator ignores overwrites",
+			member:     &memberPermissions{base: channelUtil.AllPermissions, everyoneRoleID: everyoneRole},
+			overwrites: []repo.ChannelPermission{overwrite(everyoneRole, 0, 0, channelUtil.PermissionViewChannel)},
+			want:       channelUtil.AllPermissions,
+		},
+		{
+			name: "timeout withholds restricted permissions",
+			member: &memberPermissions{
+				base:          base,
+				roleIDs:       map[int32]struct{}{},
+				timedOutUntil: time.Now().Add(time.Hour),
+			},
+			want: channelUtil.PermissionViewChannel,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.member.applyOverwrites(tt.overwrites, userID); got != tt.want {
+				t.Errorf("expected %#x, got %#x", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRequire(t *testing.T) {
+	until := time.Now().Add(time.Hour)
+	timedOut := &memberPermissions{timedOutUntil: until}
+
+	if err := (&memberPermissions{}).require(channelUtil.PermissionSendMessages, channelUtil.PermissionSendMessages); err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+	if err := (&memberPermissions{}).require(0, channelUtil.PermissionSendMessages); !errors.Is(err, commonErrors.ErrPermissionDenied) {
+		t.Errorf("expected ErrPermissionDenied, got %v", err)
+	}
+
+	err := timedOut.require(timedOut.restrict(channelUtil.PermissionSendMessages), channelUtil.PermissionSendMessages)
+	var timeoutErr *commonErrors.TimeoutError
+	if !errors.As(err, &timeoutErr) {
+		t.Fatalf("expected a timeout error, got %v", err)
+	}
+	if err := timedOut.require(0, channelUtil.PermissionManageChannels); !errors.Is(err, commonErrors.ErrPermissionDenied) {
+		t.Errorf("expected ErrPermissionDenied for a permission timeouts do not restrict, got %v", err)
+	}
+}
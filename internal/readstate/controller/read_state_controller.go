@@ -0,0 +1,73 @@
+package controller
+
+import (
+	"context"
+
+	readStatePb "discord/gen/proto/service/read_state"
+	commonErrors "discord/internal/common/errors"
+	readStateService "discord/internal/readstate/service"
+)
+
+type ReadStateController struct {
+	readStatePb.UnimplementedReadStateServiceServer
+	readStateService *readStateService.ReadStateService
+}
+
+func NewReadStateController(readStateService *readStateService.ReadStateService) *readStatePb.ReadStateServiceServer {
+	controller := &ReadStateController{
+		readStateService: readStateService,
+	}
+	var grpcController readStatePb.ReadStateServiceServer = controller
+	return &grpcController
+}
+
+// Ack marks a channel as read up to a message, or entirely without one
+func (c *ReadStateController) Ack(ctx context.Context, req *readStatePb.AckRequest) (*readStatePb.AckResponse, error) {
+	userID := ctx.Value("user_id").(int32)
+
+	if req.GetChannelId() == 0 || req.GetMessageId() < 0 {
+		return nil, commonErrors.ToGRPCError(commonErrors.ErrInvalidInput)
+	}
+
+	state, err := c.readStateService.Ack(ctx, req.GetChannelId(), userID, req.GetMessageId())
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	return &readStatePb.AckResponse{ReadState: state}, nil
+}
+
+// MarkServerRead marks every visible channel of a server as read
+func (c *ReadStateController) MarkServerRead(ctx context.Context, req *readStatePb.MarkServerReadRequest) (*readStatePb.MarkServerReadResponse, error) {
+	userID := ctx.Value("user_id").(int32)
+
+	if req.GetServerId() == 0 {
+		return nil, commonErrors.ToGRPCError(commonErrors.ErrInvalidInput)
+	}
+
+	total, err := c.readStateService.MarkServerRead(ctx, req.GetServerId(), userID)
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	return &readStatePb.MarkServerReadResponse{ServerReadState: total}, nil
+}
+
+// GetReadStates returns the read states of the visible channels of a server
+func (c *ReadStateController) GetReadStates(ctx context.Context, req *readStatePb.GetReadStatesRequest) (*readStatePb.GetReadStatesResponse, error) {
+	userID := ctx.Value("user_id").(int32)
+
+	if req.GetServerId() == 0 {
+		return nil, commonErrors.ToGRPCError(commonErrors.ErrInvalidInput)
+	}
+
+	states, total, err := c.readStateService.GetReadStates(ctx, req.GetServerId(), userID)
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	return &readStatePb.GetReadStatesResponse{
+		ReadStates:      states,
+		ServerReadState: total,
+	}, nil
+}
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+
+	"discord/gen/repo"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ReadStateRepository struct {
+	db      *pgxpool.Pool
+	queries *repo.Queries
+}
+
+func NewReadStateRepository(db *pgxpool.Pool) *ReadStateRepository {
+	return &ReadStateRepository{
+		db:      db,
+		queries: repo.New(db),
+	}
+}
+
+// GetMessage retrieves a message that is not deleted
+func (r *ReadStateRepository) GetMessage(ctx context.Context, messageID int32) (repo.Message, error) {
+	return r.queries.GetMessageByID(ctx, messageID)
+}
+
+// GetLatestMessageID returns the id of the latest message of a channel, 0 if
+// it has none
+func (r *ReadStateRepository) GetLatestMessageID(ctx context.Context, channelID int32) (int32, error) {
+	return r.queries.GetLatestChannelMessageID(ctx, pgtype.Int4{Int32: channelID, Valid: true})
+}
+
+// Ack moves the user's read marker of a channel forward to messageID
+func (r *ReadStateRepository) Ack(ctx context.Context, userID, channelID, messageID int32) (repo.ReadState, error) {
+	return r.queries.UpsertReadState(ctx, repo.UpsertReadStateParams{
+		UserID:            userID,
+		ChannelID:         channelID,
+		LastReadMessageID: messageID,
+	})
+}
+
+// MarkChannelsRead moves the user's read markers of the channels to their
+// latest messages
+func (r *ReadStateRepository) MarkChannelsRead(ctx context.Context, userID int32, channelIDs []int32) (int64, error) {
+	return r.queries.MarkChannelsRead(ctx, repo.MarkChannelsReadParams{
+		UserID:     userID,
+		ChannelIds: channelIDs,
+	})
+}
+
+// ListReadStates retrieves the user's read states of the channels
+func (r *ReadStateRepository) ListReadStates(ctx context.Context, userID int32, channelIDs []int32) ([]repo.ListChannelReadStatesRow, error) {
+	return r.queries.ListChannelReadStates(ctx, repo.ListChannelReadStatesParams{
+		UserID:     userID,
+		ChannelIds: channelIDs,
+	})
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+
+	"discord/gen/proto/schema"
+	"discord/gen/repo"
+	channelUtil "discord/internal/channel/util"
+	commonErrors "discord/internal/common/errors"
+	permissionService "discord/internal/permission/service"
+	readStateRepo "discord/internal/readstate/repository"
+	readStateUtil "discord/internal/readstate/util"
+)
+
+type ReadStateService struct {
+	readStateRepo *readStateRepo.ReadStateRepository
+	permissions   *permissionService.Resolver
+}
+
+func NewReadStateService(readStateRepo *readStateRepo.ReadStateRepository, permissions *permissionService.Resolver) *ReadStateService {
+	return &ReadStateService{
+		readStateRepo: readStateRepo,
+		permissions:   permissions,
+	}
+}
+
+// Ack marks a channel as read up to and including messageID, or up to its
+// latest message when messageID is 0. The message has to belong to the
+// channel. Acking an older message than the current marker changes nothing.
+func (s *ReadStateService) Ack(ctx context.Context, channelID, userID, messageID int32) (*schema.ReadState, error) {
+	if err := s.permissions.RequireChannel(ctx, channelID, userID, channelUtil.PermissionViewChannel); err != nil {
+		return nil, err
+	}
+
+	if messageID == 0 {
+		latest, err := s.readStateRepo.GetLatestMessageID(ctx, channelID)
+		if err != nil {
+			return nil, err
+		}
+		messageID = latest
+	} else {
+		message, err := s.readStateRepo.GetMessage(ctx, messageID)
+		if err != nil || !message.ChannelID.Valid || message.ChannelID.Int32 != channelID {
+			return nil, commonErrors.ErrNotFound
+		}
+	}
+
+	// An empty channel has nothing to mark
+	if messageID != 0 {
+		if _, err := s.readStateRepo.Ack(ctx, userID, channelID, messageID); err != nil {
+			return nil, err
+		}
+	}
+
+	states, err := s.readStateRepo.ListReadStates(ctx, userID, []int32{channelID})
+	if err != nil {
+		return nil, err
+	}
+	if len(states) == 0 {
+		return nil, commonErrors.ErrNotFound
+	}
+	return readStateUtil.ConvertReadStateToProto(states[0]), nil
+}
+
+// MarkServerRead marks every channel of a server the user can see as read
+func (s *ReadStateService) MarkServerRead(ctx context.Context, serverID, userID int32) (*schema.ServerReadState, error) {
+	channelIDs, err := s.permissions.ChannelsWithPermission(ctx, serverID, userID, channelUtil.PermissionViewChannel)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(channelIDs) > 0 {
+		if _, err := s.readStateRepo.MarkChannelsRead(ctx, userID, channelIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	_, total, err := s.serverReadStates(ctx, serverID, userID, channelIDs)
+	return total, err
+}
+
+// GetReadStates returns the user's read states of the channels they can see in
+// a server together with the server totals
+func (s *ReadStateService) GetReadStates(ctx context.Context, serverID, userID int32) ([]*schema.ReadState, *schema.ServerReadState, error) {
+	channelIDs, err := s.permissions.ChannelsWithPermission(ctx, serverID, userID, channelUtil.PermissionViewChannel)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.serverReadStates(ctx, serverID, userID, channelIDs)
+}
+
+func (s *ReadStateService) serverReadStates(ctx context.Context, serverID, userID int32, channelIDs []int32) ([]*schema.ReadState, *schema.ServerReadState, error) {
+	var rows []repo.ListChannelReadStatesRow
+	if len(channelIDs) > 0 {
+		var err error
+		rows, err = s.readStateRepo.ListReadStates(ctx, userID, channelIDs)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	states := readStateUtil.ConvertReadStatesToProto(rows)
+	return states, readStateUtil.ServerReadStates([]int32{serverID}, states)[0], nil
+}
@@ -0,0 +1,50 @@
+package util
+
+import (
+	"discord/gen/proto/schema"
+	"discord/gen/repo"
+)
+
+// ConvertReadStateToProto converts a channel read state to proto format
+func ConvertReadStateToProto(row repo.ListChannelReadStatesRow) *schema.ReadState {
+	return &schema.ReadState{
+		ChannelId:         row.ChannelID,
+		ServerId:          row.ServerID,
+		LastReadMessageId: row.LastReadMessageID.Int32,
+		UnreadCount:       row.UnreadCount,
+		MentionCount:      row.MentionCount,
+	}
+}
+
+// ConvertReadStatesToProto converts channel read states to proto format
+func ConvertReadStatesToProto(rows []repo.ListChannelReadStatesRow) []*schema.ReadState {
+	states := make([]*schema.ReadState, len(rows))
+	for i, row := range rows {
+		states[i] = ConvertReadStateToProto(row)
+	}
+	return states
+}
+
+// ServerReadStates sums channel read states per server. Every server in
+// serverIDs gets an entry, in that order, even without any unread channel.
+func ServerReadStates(serverIDs []int32, states []*schema.ReadState) []*schema.ServerReadState {
+	totals := make(map[int32]*schema.ServerReadState, len(serverIDs))
+	result := make([]*schema.ServerReadState, len(serverIDs))
+	for i, serverID := range serverIDs {
+		result[i] = &schema.ServerReadState{ServerId: serverID}
+		totals[serverID] = result[i]
+	}
+
+	for _, state := range states {
+		total, ok := totals[state.ServerId]
+		if !ok {
+			continue
+		}
+		total.UnreadCount += state.UnreadCount
+		total.MentionCount += state.MentionCount
+		if state.UnreadCount > 0 {
+			total.UnreadChannelCount++
+		}
+	}
+	return result
+}
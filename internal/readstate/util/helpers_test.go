@@ -0,0 +1,35 @@
+package util
+
+import (
+	"testing"
+
+	"discord/gen/proto/schema"
+)
+
+func TestServerReadStates(t *testing.T) {
+	states := []*schema.ReadState{
+		{ChannelId: 1, ServerId: 10, UnreadCount: 3, MentionCount: 1},
+		{ChannelId: 2, ServerId: 10, UnreadCount: 0},
+		{ChannelId: 3, ServerId: 10, UnreadCount: 2, MentionCount: 2},
+		{ChannelId: 4, ServerId: 20, UnreadCount: 5},
+		{ChannelId: 5, ServerId: 99, UnreadCount: 7},
+	}
+
+	totals := ServerReadStates([]int32{20, 10, 30}, states)
+	if len(totals) != 3 {
+		t.Fatalf("got %d server read states, want 3", len(totals))
+	}
+
+	want := []*schema.ServerReadState{
+		{ServerId: 20, UnreadCount: 5, UnreadChannelCount: 1},
+		{ServerId: 10, UnreadCount: 5, MentionCount: 3, UnreadChannelCount: 2},
+		{ServerId: 30},
+	}
+	for i, w := range want {
+		got := totals[i]
+		if got.ServerId != w.ServerId || got.UnreadCount != w.UnreadCount ||
+			got.MentionCount != w.MentionCount || got.UnreadChannelCount != w.UnreadChannelCount {
+			t.Errorf("server read state %d = %+v, want %+v", i, got, w)
+		}
+	}
+}
@@ -5,8 +5,11 @@ import (
 
 	"discord/gen/proto/schema"
 	serverPb "discord/gen/proto/service/server"
+	"discord/gen/repo"
+	auditRepo "discord/internal/audit/repository"
 	commonErrors "discord/internal/common/errors"
 	serverService "discord/internal/server/service"
+	serverUtil "discord/internal/server/util"
 )
 
 type ServerController struct {
@@ -160,6 +163,24 @@ func (c *ServerController) DeleteServer(ctx context.Context, req *serverPb.Delet
 	}, nil
 }
 
+// TransferOwnership hands the server over to another member
+func (c *ServerController) TransferOwnership(ctx context.Context, req *serverPb.TransferOwnershipRequest) (*serverPb.TransferOwnershipResponse, error) {
+	userID := ctx.Value("user_id").(int32)
+
+	if req.GetServerId() == 0 || req.GetNewOwnerId() == 0 {
+		return nil, commonErrors.ToGRPCError(commonErrors.ErrInvalidInput)
+	}
+
+	err := c.serverService.TransferOwnership(ctx, req.GetServerId(), userID, req.GetNewOwnerId())
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	return &serverPb.TransferOwnershipResponse{
+		Success: true,
+	}, nil
+}
+
 // GetUserServers retrieves all servers a user is in
 func (c *ServerController) GetUserServers(ctx context.Context, req *serverPb.GetUserServersRequest) (*serverPb.GetUserServersResponse, error) {
 	if req.GetUserId() == 0 {
@@ -188,14 +209,16 @@ func (c *ServerController) GetUserServers(ctx context.Context, req *serverPb.Get
 	}, nil
 }
 
-// AddMember adds a member to a server (not typically used - JoinServerWithInvite is preferred)
+// AddMember adds another user to a server. Users join themselves with an
+// invite through JoinServerWithInvite.
 func (c *ServerController) AddMember(ctx context.Context, req *serverPb.AddMemberRequest) (*serverPb.AddMemberResponse, error) {
+	userID := ctx.Value("user_id").(int32)
+
 	if req.GetServerId() == 0 || req.GetUserId() == 0 {
 		return nil, commonErrors.ToGRPCError(commonErrors.ErrInvalidInput)
 	}
 
-	// Use JoinServer without invite code
-	err := c.serverService.JoinServer(ctx, req.GetServerId(), req.GetUserId(), nil)
+	err := c.serverService.AddMember(ctx, req.GetServerId(), userID, req.GetUserId())
 	if err != nil {
 		return nil, commonErrors.ToGRPCError(err)
 	}
@@ -205,13 +228,21 @@ func (c *ServerController) AddMember(ctx context.Context, req *serverPb.AddMembe
 	}, nil
 }
 
-// RemoveMember removes a member from a server
+// RemoveMember removes a member from a server. Removing yourself leaves the
+// server, removing someone else is a kick.
 func (c *ServerController) RemoveMember(ctx context.Context, req *serverPb.RemoveMemberRequest) (*serverPb.RemoveMemberResponse, error) {
+	userID := ctx.Value("user_id").(int32)
+
 	if req.GetServerId() == 0 || req.GetUserId() == 0 {
 		return nil, commonErrors.ToGRPCError(commonErrors.ErrInvalidInput)
 	}
 
-	err := c.serverService.LeaveServer(ctx, req.GetServerId(), req.GetUserId())
+	var err error
+	if req.GetUserId() == userID {
+		err = c.serverService.LeaveServer(ctx, req.GetServerId(), userID)
+	} else {
+		err = c.serverService.KickMember(ctx, req.GetServerId(), userID, req.GetUserId())
+	}
 	if err != nil {
 		return nil, commonErrors.ToGRPCError(err)
 	}
@@ -224,6 +255,10 @@ func (c *ServerController) RemoveMember(ctx context.Context, req *serverPb.Remov
 // GetMembers retrieves server members (streaming response)
 func (c *ServerController) GetMembers(req *serverPb.GetMembersRequest, stream serverPb.ServerService_GetMembersServer) error {
 	ctx := stream.Context()
+	userID, ok := ctx.Value("user_id").(int32)
+	if !ok {
+		return commonErrors.ToGRPCError(commonErrors.ErrUnauthorized)
+	}
 
 	if req.GetServerId() == 0 {
 		return commonErrors.ToGRPCError(commonErrors.ErrInvalidInput)
@@ -234,25 +269,15 @@ func (c *ServerController) GetMembers(req *serverPb.GetMembersRequest, stream se
 		limit = 100
 	}
 
-	members, err := c.serverService.GetServerMembers(ctx, req.GetServerId(), limit, req.GetOffset())
+	members, err := c.serverService.GetServerMembers(ctx, req.GetServerId(), userID, limit, req.GetOffset())
 	if err != nil {
 		return commonErrors.ToGRPCError(err)
 	}
 
 	// Stream members to client
 	for _, member := range members {
-		pbMember := &schema.ServerMember{
-			ServerId: member.ServerID,
-			UserId:   member.UserID,
-			JoinedAt: member.JoinedAt.Time.Unix(),
-		}
-
-		if member.Nickname.Valid {
-			pbMember.Nickname = member.Nickname.String
-		}
-
 		if err := stream.Send(&serverPb.GetMembersResponse{
-			Members: []*schema.ServerMember{pbMember},
+			Members: []*schema.ServerMember{serverUtil.ConvertMemberToProto(member)},
 		}); err != nil {
 			return err
 		}
@@ -263,6 +288,8 @@ func (c *ServerController) GetMembers(req *serverPb.GetMembersRequest, stream se
 
 // UpdateMember updates member information
 func (c *ServerController) UpdateMember(ctx context.Context, req *serverPb.UpdateMemberRequest) (*serverPb.UpdateMemberResponse, error) {
+	userID := ctx.Value("user_id").(int32)
+
 	if req.GetServerId() == 0 || req.GetUserId() == 0 {
 		return nil, commonErrors.ToGRPCError(commonErrors.ErrInvalidInput)
 	}
@@ -273,7 +300,7 @@ func (c *ServerController) UpdateMember(ctx context.Context, req *serverPb.Updat
 		nickname = &n
 	}
 
-	err := c.serverService.UpdateMemberNickname(ctx, req.GetServerId(), req.GetUserId(), nickname)
+	err := c.serverService.UpdateMemberNickname(ctx, req.GetServerId(), userID, req.GetUserId(), nickname)
 	if err != nil {
 		return nil, commonErrors.ToGRPCError(err)
 	}
@@ -317,8 +344,14 @@ func (c *ServerController) BanMember(ctx context.Context, req *serverPb.BanMembe
 		reason = &r
 	}
 
-	// Note: delete_message_days is ignored in this implementation
-	err := c.serverService.BanMember(ctx, req.GetServerId(), moderatorID, req.GetUserId(), reason, nil)
+	var duration *int32
+	if req.GetDurationSeconds() > 0 {
+		d := req.GetDurationSeconds()
+		duration = &d
+	}
+
+	deleteMessageHours := req.GetDeleteMessageDays()*24 + req.GetDeleteMessageHours()
+	err := c.serverService.BanMember(ctx, req.GetServerId(), moderatorID, req.GetUserId(), reason, duration, deleteMessageHours)
 	if err != nil {
 		return nil, commonErrors.ToGRPCError(err)
 	}
@@ -328,6 +361,38 @@ func (c *ServerController) BanMember(ctx context.Context, req *serverPb.BanMembe
 	}, nil
 }
 
+// BulkBanMembers bans many members of the server at once
+func (c *ServerController) BulkBanMembers(ctx context.Context, req *serverPb.BulkBanMembersRequest) (*serverPb.BulkBanMembersResponse, error) {
+	// Get moderator ID from context
+	moderatorID := ctx.Value("user_id").(int32)
+
+	if req.GetServerId() == 0 || len(req.GetUserIds()) == 0 {
+		return nil, commonErrors.ToGRPCError(commonErrors.ErrInvalidInput)
+	}
+
+	var reason *string
+	if req.GetReason() != "" {
+		r := req.GetReason()
+		reason = &r
+	}
+
+	var duration *int32
+	if req.GetDurationSeconds() > 0 {
+		d := req.GetDurationSeconds()
+		duration = &d
+	}
+
+	banned, failed, err := c.serverService.BulkBanMembers(ctx, req.GetServerId(), moderatorID, req.GetUserIds(), reason, duration, req.GetDeleteMessageHours())
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	return &serverPb.BulkBanMembersResponse{
+		BannedUserIds: banned,
+		FailedUserIds: failed,
+	}, nil
+}
+
 // UnbanMember unbans a member from the server
 func (c *ServerController) UnbanMember(ctx context.Context, req *serverPb.UnbanMemberRequest) (*serverPb.UnbanMemberResponse, error) {
 	// Get moderator ID from context
@@ -347,6 +412,119 @@ func (c *ServerController) UnbanMember(ctx context.Context, req *serverPb.UnbanM
 	}, nil
 }
 
+// TimeoutMember times a member out, or ends their timeout
+func (c *ServerController) TimeoutMember(ctx context.Context, req *serverPb.TimeoutMemberRequest) (*serverPb.TimeoutMemberResponse, error) {
+	// Get moderator ID from context
+	moderatorID := ctx.Value("user_id").(int32)
+
+	if req.GetServerId() == 0 || req.GetUserId() == 0 {
+		return nil, commonErrors.ToGRPCError(commonErrors.ErrInvalidInput)
+	}
+
+	member, err := c.serverService.TimeoutMember(ctx, req.GetServerId(), moderatorID, req.GetUserId(), req.GetDurationSeconds())
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	return &serverPb.TimeoutMemberResponse{
+		Member: serverUtil.ConvertMemberToProto(member),
+	}, nil
+}
+
+// GetAuditLog returns a page of the server's audit log, newest first
+func (c *ServerController) GetAuditLog(ctx context.Context, req *serverPb.GetAuditLogRequest) (*serverPb.GetAuditLogResponse, error) {
+	userID := ctx.Value("user_id").(int32)
+
+	if req.GetServerId() == 0 {
+		return nil, commonErrors.ToGRPCError(commonErrors.ErrInvalidInput)
+	}
+
+	filter := auditRepo.Filter{
+		UserID:   req.GetUserId(),
+		Action:   req.GetAction(),
+		TargetID: req.GetTargetId(),
+	}
+	entries, nextCursor, err := c.serverService.GetAuditLog(ctx, req.GetServerId(), userID, filter, req.GetCursor(), req.GetLimit())
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	protoEntries := make([]*schema.AuditLog, len(entries))
+	for i, entry := range entries {
+		protoEntries[i] = toProtoAuditLog(entry)
+	}
+
+	return &serverPb.GetAuditLogResponse{
+		Entries:    protoEntries,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// ReorderRoles moves roles to new positions
+func (c *ServerController) ReorderRoles(ctx context.Context, req *serverPb.ReorderRolesRequest) (*serverPb.ReorderRolesResponse, error) {
+	userID := ctx.Value("user_id").(int32)
+
+	if req.GetServerId() == 0 {
+		return nil, commonErrors.ToGRPCError(commonErrors.ErrInvalidInput)
+	}
+
+	positions := make(map[int32]int32, len(req.GetPositions()))
+	for _, p := range req.GetPositions() {
+		positions[p.GetRoleId()] = p.GetPosition()
+	}
+
+	roles, err := c.serverService.ReorderRoles(ctx, req.GetServerId(), userID, positions)
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	protoRoles := make([]*schema.Role, len(roles))
+	for i, role := range roles {
+		protoRoles[i] = toProtoRole(role)
+	}
+
+	return &serverPb.ReorderRolesResponse{
+		Roles:   protoRoles,
+		Success: true,
+	}, nil
+}
+
+// AssignRole gives a member a role
+func (c *ServerController) AssignRole(ctx context.Context, req *serverPb.AssignRoleRequest) (*serverPb.AssignRoleResponse, error) {
+	userID := ctx.Value("user_id").(int32)
+
+	if req.GetServerId() == 0 || req.GetUserId() == 0 || req.GetRoleId() == 0 {
+		return nil, commonErrors.ToGRPCError(commonErrors.ErrInvalidInput)
+	}
+
+	err := c.serverService.AssignRole(ctx, req.GetServerId(), userID, req.GetUserId(), req.GetRoleId())
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	return &serverPb.AssignRoleResponse{
+		Success: true,
+	}, nil
+}
+
+// UnassignRole takes a role away from a member
+func (c *ServerController) UnassignRole(ctx context.Context, req *serverPb.UnassignRoleRequest) (*serverPb.UnassignRoleResponse, error) {
+	userID := ctx.Value("user_id").(int32)
+
+	if req.GetServerId() == 0 || req.GetUserId() == 0 || req.GetRoleId() == 0 {
+		return nil, commonErrors.ToGRPCError(commonErrors.ErrInvalidInput)
+	}
+
+	err := c.serverService.UnassignRole(ctx, req.GetServerId(), userID, req.GetUserId(), req.GetRoleId())
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	return &serverPb.UnassignRoleResponse{
+		Success: true,
+	}, nil
+}
+
 // CreateInvite creates a server invite
 func (c *ServerController) CreateInvite(ctx context.Context, req *serverPb.CreateInviteRequest) (*serverPb.CreateInviteResponse, error) {
 	// Get inviter ID from context
@@ -357,9 +535,6 @@ func (c *ServerController) CreateInvite(ctx context.Context, req *serverPb.Creat
 	}
 
 	channelID := req.GetChannelId()
-	if channelID == 0 {
-		channelID = 1 // Default channel, adjust as needed
-	}
 
 	maxAge := req.GetMaxAge()
 	maxUses := req.GetMaxUses()
@@ -449,11 +624,13 @@ func (c *ServerController) DeleteInvite(ctx context.Context, req *serverPb.Delet
 
 // GetServerInvites retrieves all invites for a server
 func (c *ServerController) GetServerInvites(ctx context.Context, req *serverPb.GetServerInvitesRequest) (*serverPb.GetServerInvitesResponse, error) {
+	userID := ctx.Value("user_id").(int32)
+
 	if req.GetServerId() == 0 {
 		return nil, commonErrors.ToGRPCError(commonErrors.ErrInvalidInput)
 	}
 
-	invites, err := c.serverService.GetServerInvites(ctx, req.GetServerId())
+	invites, err := c.serverService.GetServerInvites(ctx, req.GetServerId(), userID)
 	if err != nil {
 		return nil, commonErrors.ToGRPCError(err)
 	}
@@ -484,6 +661,7 @@ func (c *ServerController) GetServerInvites(ctx context.Context, req *serverPb.G
 }
 
 // JoinServerWithInvite allows a user to join a server using an invite code
+// or the server's vanity URL
 func (c *ServerController) JoinServerWithInvite(ctx context.Context, req *serverPb.JoinServerWithInviteRequest) (*serverPb.JoinServerWithInviteResponse, error) {
 	// Get user ID from context
 	userID := ctx.Value("user_id").(int32)
@@ -492,35 +670,87 @@ func (c *ServerController) JoinServerWithInvite(ctx context.Context, req *server
 		return nil, commonErrors.ToGRPCError(commonErrors.ErrInvalidInput)
 	}
 
-	// Get invite to find server
-	invite, err := c.serverService.GetInvite(ctx, req.GetCode())
+	server, err := c.serverService.JoinWithCode(ctx, userID, req.GetCode())
 	if err != nil {
 		return nil, commonErrors.ToGRPCError(err)
 	}
 
-	// Join server with invite code
-	code := req.GetCode()
-	err = c.serverService.JoinServer(ctx, invite.ServerID, userID, &code)
+	return &serverPb.JoinServerWithInviteResponse{
+		Server: &schema.Server{
+			Id:      server.ID,
+			Name:    server.Name,
+			OwnerId: server.OwnerID,
+		},
+		Success: true,
+	}, nil
+}
+
+// GetInviteJoins lists who joined the server through which invite
+func (c *ServerController) GetInviteJoins(ctx context.Context, req *serverPb.GetInviteJoinsRequest) (*serverPb.GetInviteJoinsResponse, error) {
+	userID := ctx.Value("user_id").(int32)
+
+	if req.GetServerId() == 0 {
+		return nil, commonErrors.ToGRPCError(commonErrors.ErrInvalidInput)
+	}
+
+	joins, err := c.serverService.GetInviteJoins(ctx, req.GetServerId(), userID, req.GetCode(), req.GetLimit(), req.GetOffset())
 	if err != nil {
 		return nil, commonErrors.ToGRPCError(err)
 	}
 
-	// Get server info
-	server, err := c.serverService.GetServer(ctx, invite.ServerID)
+	pbJoins := make([]*serverPb.InviteJoin, len(joins))
+	for i, join := range joins {
+		pbJoins[i] = &serverPb.InviteJoin{
+			UserId:    join.UserID,
+			Code:      join.Code,
+			InviterId: join.InviterID,
+			JoinedAt:  join.JoinedAt.Time.Unix(),
+		}
+		if join.TemporaryUntil.Valid {
+			pbJoins[i].TemporaryUntil = join.TemporaryUntil.Time.Unix()
+		}
+	}
+
+	return &serverPb.GetInviteJoinsResponse{
+		Joins: pbJoins,
+	}, nil
+}
+
+// ClaimVanityUrl sets or releases the vanity URL of a server
+func (c *ServerController) ClaimVanityUrl(ctx context.Context, req *serverPb.ClaimVanityUrlRequest) (*serverPb.ClaimVanityUrlResponse, error) {
+	userID := ctx.Value("user_id").(int32)
+
+	if req.GetServerId() == 0 {
+		return nil, commonErrors.ToGRPCError(commonErrors.ErrInvalidInput)
+	}
+
+	server, err := c.serverService.ClaimVanityUrl(ctx, req.GetServerId(), userID, req.GetVanityUrl())
 	if err != nil {
 		return nil, commonErrors.ToGRPCError(err)
 	}
 
-	return &serverPb.JoinServerWithInviteResponse{
-		Server: &schema.Server{
-			Id:      server.ID,
-			Name:    server.Name,
-			OwnerId: server.OwnerID,
-		},
+	return &serverPb.ClaimVanityUrlResponse{
+		Server:  toProtoServer(server),
 		Success: true,
 	}, nil
 }
 
+// ResolveVanityUrl looks up the server behind a vanity URL
+func (c *ServerController) ResolveVanityUrl(ctx context.Context, req *serverPb.ResolveVanityUrlRequest) (*serverPb.ResolveVanityUrlResponse, error) {
+	if req.GetVanityUrl() == "" {
+		return nil, commonErrors.ToGRPCError(commonErrors.ErrInvalidInput)
+	}
+
+	server, err := c.serverService.ResolveVanityUrl(ctx, req.GetVanityUrl())
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	return &serverPb.ResolveVanityUrlResponse{
+		Server: toProtoServer(server),
+	}, nil
+}
+
 // CreateEmoji creates a custom emoji
 func (c *ServerController) CreateEmoji(ctx context.Context, req *serverPb.CreateEmojiRequest) (*serverPb.CreateEmojiResponse, error) {
 	// TODO: Implement emoji creation
@@ -544,3 +774,56 @@ func (c *ServerController) GetServerEmojis(ctx context.Context, req *serverPb.Ge
 		Emojis: []*schema.Emoji{},
 	}, nil
 }
+
+// toProtoServer converts a server row to its proto representation
+func toProtoServer(server repo.Server) *schema.Server {
+	return &schema.Server{
+		Id:          server.ID,
+		Name:        server.Name,
+		Icon:        server.Icon.String,
+		Banner:      server.Banner.String,
+		Description: server.Description.String,
+		OwnerId:     server.OwnerID,
+		Region:      server.Region.String,
+		MemberCount: server.MemberCount.Int32,
+		IsVerified:  server.IsVerified.Bool,
+		VanityUrl:   server.VanityUrl.String,
+		CreatedAt:   server.CreatedAt.Time.Unix(),
+		UpdatedAt:   server.UpdatedAt.Time.Unix(),
+		IsDeleted:   server.IsDeleted.Bool,
+	}
+}
+
+// toProtoRole converts a role row to its proto representation
+func toProtoRole(role repo.Role) *schema.Role {
+	return &schema.Role{
+		Id:          role.ID,
+		ServerId:    role.ServerID,
+		Name:        role.Name,
+		Color:       role.Color.String,
+		Hoist:       role.Hoist.Bool,
+		Position:    role.Position.Int32,
+		Permissions: role.Permissions.Int64,
+		Mentionable: role.Mentionable.Bool,
+		Description: role.Description.String,
+		Icon:        role.Icon.String,
+		CreatedAt:   role.CreatedAt.Time.Unix(),
+		UpdatedAt:   role.UpdatedAt.Time.Unix(),
+		IsDeleted:   role.IsDeleted.Bool,
+	}
+}
+
+// toProtoAuditLog converts an audit log row to its proto representation
+func toProtoAuditLog(entry repo.AuditLog) *schema.AuditLog {
+	return &schema.AuditLog{
+		Id:         entry.ID,
+		ServerId:   entry.ServerID,
+		UserId:     entry.UserID.Int32,
+		Action:     entry.Action,
+		TargetId:   entry.TargetID.Int32,
+		TargetType: entry.TargetType.String,
+		Changes:    string(entry.Changes),
+		Reason:     entry.Reason.String,
+		CreatedAt:  entry.CreatedAt.Time.Unix(),
+	}
+}
@@ -2,9 +2,14 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"discord/gen/repo"
+	"discord/internal/common/util"
+	serverUtil "discord/internal/server/util"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -85,8 +90,23 @@ func (r *ServerRepository) UpdateServer(ctx context.Context, serverID int32, nam
 
 // DeleteServer deletes a server
 func (r *ServerRepository) DeleteServer(ctx context.Context, serverID int32) error {
-	_, err := r.queries.SoftDeleteServer(ctx, serverID)
-	return err
+	return r.deleteWithLog(ctx, util.DeletedServer, serverID, util.ScopeServer, serverID, func(q *repo.Queries) error {
+		_, err := q.SoftDeleteServer(ctx, serverID)
+		return err
+	})
+}
+
+// GetServerByVanityUrl retrieves the server that claimed a vanity URL
+func (r *ServerRepository) GetServerByVanityUrl(ctx context.Context, vanityURL string) (repo.Server, error) {
+	return r.queries.GetServerByVanityUrl(ctx, pgtype.Text{String: vanityURL, Valid: true})
+}
+
+// SetVanityUrl claims a vanity URL for a server; an empty URL releases it
+func (r *ServerRepository) SetVanityUrl(ctx context.Context, serverID int32, vanityURL string) (repo.Server, error) {
+	return r.queries.SetServerVanityUrl(ctx, repo.SetServerVanityUrlParams{
+		ID:        serverID,
+		VanityUrl: pgtype.Text{String: vanityURL, Valid: vanityURL != ""},
+	})
 }
 
 // GetUserServers retrieves all servers a user is a member of
@@ -175,12 +195,73 @@ func (r *ServerRepository) RemoveServerMember(ctx context.Context, serverID, use
 	return err
 }
 
+// MakeMemberPermanent keeps a temporary member in the server for good
+func (r *ServerRepository) MakeMemberPermanent(ctx context.Context, memberID int32) error {
+	return r.queries.MakeMemberPermanent(ctx, memberID)
+}
+
+// RemoveTemporaryMemberships removes a user from every server they only
+// joined temporarily
+func (r *ServerRepository) RemoveTemporaryMemberships(ctx context.Context, userID int32) ([]repo.ServerMember, error) {
+	return r.removeMembers(ctx, func(q *repo.Queries) ([]repo.ServerMember, error) {
+		return q.RemoveTemporaryMemberships(ctx, userID)
+	})
+}
+
+// RemoveExpiredTemporaryMembers removes the temporary members whose time is up
+func (r *ServerRepository) RemoveExpiredTemporaryMembers(ctx context.Context) ([]repo.ServerMember, error) {
+	return r.removeMembers(ctx, func(q *repo.Queries) ([]repo.ServerMember, error) {
+		return q.RemoveExpiredTemporaryMembers(ctx)
+	})
+}
+
+// removeMembers runs a member delete and decrements the member count of every
+// affected server in one transaction
+func (r *ServerRepository) removeMembers(ctx context.Context, remove func(q *repo.Queries) ([]repo.ServerMember, error)) ([]repo.ServerMember, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+	members, err := remove(qtx)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range members {
+		if _, err := qtx.DecrementMemberCount(ctx, member.ServerID); err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+	}
+	return members, tx.Commit(ctx)
+}
+
+// SetMemberTimeout sets until when a member is timed out; a zero time ends the timeout
+func (r *ServerRepository) SetMemberTimeout(ctx context.Context, serverID, userID int32, until time.Time) (repo.ServerMember, error) {
+	var untilType pgtype.Timestamp
+	if !until.IsZero() {
+		untilType = pgtype.Timestamp{Time: until, Valid: true}
+	}
+	return r.queries.SetMemberTimeout(ctx, repo.SetMemberTimeoutParams{
+		CommunicationDisabledUntil: untilType,
+		ServerID:                   serverID,
+		UserID:                     userID,
+	})
+}
+
+// ClearExpiredMemberTimeouts ends the timeouts that have passed and returns the
+// members whose timeout ended
+func (r *ServerRepository) ClearExpiredMemberTimeouts(ctx context.Context) ([]repo.ServerMember, error) {
+	return r.queries.ClearExpiredMemberTimeouts(ctx)
+}
+
 // CountServerMembers counts the number of members in a server
 func (r *ServerRepository) CountServerMembers(ctx context.Context, serverID int32) (int64, error) {
 	return r.queries.CountServerMembers(ctx, serverID)
 }
 
-// CreateRole creates a new role
+// CreateRole creates a new role at position, moving the roles at or above it up
 func (r *ServerRepository) CreateRole(ctx context.Context, serverID int32, name string, color *string, hoist, mentionable bool, position int32, permissions int64, description *string) (repo.Role, error) {
 	var colorType, descType pgtype.Text
 
@@ -191,7 +272,22 @@ func (r *ServerRepository) CreateRole(ctx context.Context, serverID int32, name
 		descType = pgtype.Text{String: *description, Valid: true}
 	}
 
-	return r.queries.CreateRole(ctx, repo.CreateRoleParams{
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return repo.Role{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+	err = qtx.ShiftRolePositions(ctx, repo.ShiftRolePositionsParams{
+		ServerID: serverID,
+		Position: pgtype.Int4{Int32: position, Valid: true},
+	})
+	if err != nil {
+		return repo.Role{}, err
+	}
+
+	role, err := qtx.CreateRole(ctx, repo.CreateRoleParams{
 		ServerID:    serverID,
 		Name:        name,
 		Color:       colorType,
@@ -201,11 +297,103 @@ func (r *ServerRepository) CreateRole(ctx context.Context, serverID int32, name
 		Mentionable: pgtype.Bool{Bool: mentionable, Valid: true},
 		Description: descType,
 	})
-} // GetRoleByID retrieves a role by ID
+	if err != nil {
+		return repo.Role{}, err
+	}
+
+	return role, tx.Commit(ctx)
+}
+
+// UpdateRole updates the non-nil fields of a role
+func (r *ServerRepository) UpdateRole(ctx context.Context, roleID int32, name, color *string, hoist, mentionable *bool, permissions *int64, description *string) (repo.Role, error) {
+	params := repo.UpdateRoleParams{ID: roleID}
+
+	if name != nil {
+		params.Name = pgtype.Text{String: *name, Valid: true}
+	}
+	if color != nil {
+		params.Color = pgtype.Text{String: *color, Valid: true}
+	}
+	if hoist != nil {
+		params.Hoist = pgtype.Bool{Bool: *hoist, Valid: true}
+	}
+	if mentionable != nil {
+		params.Mentionable = pgtype.Bool{Bool: *mentionable, Valid: true}
+	}
+	if permissions != nil {
+		params.Permissions = pgtype.Int8{Int64: *permissions, Valid: true}
+	}
+	if description != nil {
+		params.Description = pgtype.Text{String: *description, Valid: true}
+	}
+
+	return r.queries.UpdateRole(ctx, params)
+}
+
+// UpdateRolePositions moves roles of a server to the requested positions and
+// renumbers all of them in one transaction, so no two roles share a position
+func (r *ServerRepository) UpdateRolePositions(ctx context.Context, serverID int32, moves map[int32]int32) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+	roles, err := qtx.GetServerRoles(ctx, serverID)
+	if err != nil {
+		return err
+	}
+	for roleID, position := range serverUtil.OrderRolePositions(roles, moves) {
+		_, err := qtx.UpdateRolePosition(ctx, repo.UpdateRolePositionParams{
+			ID:       roleID,
+			Position: pgtype.Int4{Int32: position, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+// AssignRole gives a member a role; assigning a role twice is a no-op
+func (r *ServerRepository) AssignRole(ctx context.Context, memberID, roleID int32) error {
+	return r.queries.AddMemberRole(ctx, repo.AddMemberRoleParams{
+		MemberID: memberID,
+		RoleID:   roleID,
+	})
+}
+
+// UnassignRole takes a role away from a member; removing a missing role is a no-op
+func (r *ServerRepository) UnassignRole(ctx context.Context, memberID, roleID int32) error {
+	_, err := r.queries.RemoveRoleFromMember(ctx, repo.RemoveRoleFromMemberParams{
+		MemberID: memberID,
+		RoleID:   roleID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	return err
+}
+
+// GetRoleByID retrieves a role by ID
 func (r *ServerRepository) GetRoleByID(ctx context.Context, roleID int32) (repo.Role, error) {
 	return r.queries.GetRoleByID(ctx, roleID)
 }
 
+// GetChannelByID retrieves a channel by ID
+func (r *ServerRepository) GetChannelByID(ctx context.Context, channelID int32) (repo.Channel, error) {
+	return r.queries.GetChannelByID(ctx, channelID)
+}
+
+// CreateEveryoneRole creates the @everyone role of a server
+func (r *ServerRepository) CreateEveryoneRole(ctx context.Context, serverID int32, permissions int64) (repo.Role, error) {
+	return r.queries.CreateEveryoneRole(ctx, repo.CreateEveryoneRoleParams{
+		ServerID:    serverID,
+		Permissions: pgtype.Int8{Int64: permissions, Valid: true},
+	})
+}
+
 // GetServerRoles retrieves all roles for a server
 func (r *ServerRepository) GetServerRoles(ctx context.Context, serverID int32) ([]repo.Role, error) {
 	return r.queries.GetServerRoles(ctx, serverID)
@@ -213,8 +401,38 @@ func (r *ServerRepository) GetServerRoles(ctx context.Context, serverID int32) (
 
 // DeleteRole deletes a role
 func (r *ServerRepository) DeleteRole(ctx context.Context, roleID int32) error {
-	_, err := r.queries.SoftDeleteRole(ctx, roleID)
-	return err
+	role, err := r.queries.GetRoleByID(ctx, roleID)
+	if err != nil {
+		return err
+	}
+	return r.deleteWithLog(ctx, util.DeletedRole, roleID, util.ScopeServer, role.ServerID, func(q *repo.Queries) error {
+		_, err := q.SoftDeleteRole(ctx, roleID)
+		return err
+	})
+}
+
+// deleteWithLog runs a delete and records it in the deletion log in one transaction
+func (r *ServerRepository) deleteWithLog(ctx context.Context, entityType string, entityID int32, scopeType string, scopeID int32, del func(q *repo.Queries) error) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+	if err := del(qtx); err != nil {
+		return err
+	}
+	_, err = qtx.CreateDeletedEntity(ctx, repo.CreateDeletedEntityParams{
+		EntityType: entityType,
+		EntityID:   entityID,
+		ScopeType:  scopeType,
+		ScopeID:    scopeID,
+	})
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
 }
 
 // CreateInvite creates a server invite
@@ -227,7 +445,7 @@ func (r *ServerRepository) CreateInvite(ctx context.Context, code string, server
 	return r.queries.CreateInvite(ctx, repo.CreateInviteParams{
 		Code:      code,
 		ServerID:  serverID,
-		ChannelID: pgtype.Int4{Int32: channelID, Valid: true},
+		ChannelID: pgtype.Int4{Int32: channelID, Valid: channelID != 0},
 		InviterID: inviterID,
 		MaxUses:   pgtype.Int4{Int32: maxUses, Valid: true},
 		MaxAge:    pgtype.Int4{Int32: maxAge, Valid: true},
@@ -246,10 +464,55 @@ func (r *ServerRepository) GetServerInvites(ctx context.Context, serverID int32)
 	return r.queries.GetServerInvites(ctx, serverID)
 }
 
-// IncrementInviteUses increments the invite usage count
-func (r *ServerRepository) IncrementInviteUses(ctx context.Context, code string) error {
-	_, err := r.queries.IncrementInviteUses(ctx, code)
-	return err
+// RedeemInvite counts a use of an invite and adds the user to its server in
+// one transaction. It returns pgx.ErrNoRows when the invite is unknown,
+// expired or used up. Members joining through a temporary invite stay until
+// temporaryUntil unless they are made permanent.
+func (r *ServerRepository) RedeemInvite(ctx context.Context, code string, userID int32, temporaryUntil pgtype.Timestamp) (repo.Invite, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return repo.Invite{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+	invite, err := qtx.RedeemInvite(ctx, code)
+	if err != nil {
+		return repo.Invite{}, err
+	}
+
+	params := repo.AddServerMemberParams{
+		ServerID: invite.ServerID,
+		UserID:   userID,
+		InviteID: pgtype.Int4{Int32: invite.ID, Valid: true},
+	}
+	if invite.Temporary.Bool {
+		params.TemporaryUntil = temporaryUntil
+	}
+	if _, err := qtx.AddServerMember(ctx, params); err != nil {
+		return repo.Invite{}, err
+	}
+	if _, err := qtx.IncrementMemberCount(ctx, invite.ServerID); err != nil {
+		return repo.Invite{}, err
+	}
+
+	return invite, tx.Commit(ctx)
+}
+
+// SoftDeleteExpiredInvites deletes the invites that expired or ran out of uses
+func (r *ServerRepository) SoftDeleteExpiredInvites(ctx context.Context) (int64, error) {
+	return r.queries.SoftDeleteExpiredInvites(ctx)
+}
+
+// GetInviteJoins lists the members that joined through an invite, newest
+// first. An empty code lists the joins of every invite of the server.
+func (r *ServerRepository) GetInviteJoins(ctx context.Context, serverID int32, code string, limit, offset int32) ([]repo.GetInviteJoinsRow, error) {
+	return r.queries.GetInviteJoins(ctx, repo.GetInviteJoinsParams{
+		ServerID:     serverID,
+		Code:         pgtype.Text{String: code, Valid: code != ""},
+		ResultLimit:  limit,
+		ResultOffset: offset,
+	})
 }
 
 // DeleteInvite deletes an invite
@@ -306,3 +569,40 @@ func (r *ServerRepository) IsUserBanned(ctx context.Context, serverID, userID in
 		UserID:   userID,
 	})
 }
+
+// LiftExpiredBans deletes the bans whose expires_at has passed and returns them
+func (r *ServerRepository) LiftExpiredBans(ctx context.Context) ([]repo.Ban, error) {
+	return r.queries.SoftDeleteExpiredBans(ctx)
+}
+
+// PurgeUserMessages deletes the messages a user sent in the server's channels
+// since the given time and returns the ids and channels of the deleted messages
+func (r *ServerRepository) PurgeUserMessages(ctx context.Context, serverID, userID int32, since time.Time) ([]repo.GetServerMessagesBySenderRow, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+	messages, err := qtx.GetServerMessagesBySender(ctx, repo.GetServerMessagesBySenderParams{
+		ServerID:  serverID,
+		SenderID:  userID,
+		CreatedAt: pgtype.Timestamp{Time: since, Valid: true},
+	})
+	if err != nil || len(messages) == 0 {
+		return nil, err
+	}
+
+	ids := make([]int32, len(messages))
+	for i, message := range messages {
+		ids[i] = message.ID
+	}
+	if _, err := qtx.CreateMessageDeletedEntities(ctx, ids); err != nil {
+		return nil, err
+	}
+	if _, err := qtx.BulkSoftDeleteMessages(ctx, ids); err != nil {
+		return nil, err
+	}
+	return messages, tx.Commit(ctx)
+}
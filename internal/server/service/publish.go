@@ -0,0 +1,63 @@
+package service
+
+import (
+	"discord/gen/proto/schema"
+	gatewayPb "discord/gen/proto/service/gateway"
+	"discord/gen/repo"
+	gatewayUtil "discord/internal/gateway/util"
+	permissionService "discord/internal/permission/service"
+	serverUtil "discord/internal/server/util"
+	"discord/pkg/pubsub"
+)
+
+// publishMemberUpdate sends a MEMBER_UPDATE to everyone subscribed to the server
+func publishMemberUpdate(serverID, userID int32, operation string) {
+	pubsub.Get().Publish(gatewayUtil.ServerTopic(serverID), memberUpdate(serverID, userID, operation))
+}
+
+// publishMemberJoin sends a MEMBER_UPDATE for a new member to the server and
+// to the member, whose sessions are not subscribed to the server yet
+func publishMemberJoin(serverID, userID int32) {
+	event := memberUpdate(serverID, userID, gatewayUtil.MemberJoin)
+	pubsub.Get().Publish(gatewayUtil.ServerTopic(serverID), event)
+	pubsub.Get().Publish(gatewayUtil.UserTopic(userID), event)
+}
+
+// publishMembersLeft sends a MEMBER_UPDATE for each removed member
+func publishMembersLeft(members []repo.ServerMember) {
+	for _, member := range members {
+		publishMemberUpdate(member.ServerID, member.UserID, gatewayUtil.MemberLeave)
+	}
+}
+
+func memberUpdate(serverID, userID int32, operation string) *gatewayPb.GatewayEvent {
+	return gatewayUtil.MemberUpdate(&schema.ServerMember{
+		ServerId:  serverID,
+		UserId:    userID,
+		Operation: &operation,
+	})
+}
+
+// publishMember sends a MEMBER_UPDATE carrying the member to the server
+func publishMember(member repo.ServerMember, operation string) {
+	pbMember := serverUtil.ConvertMemberToProto(member)
+	pbMember.Operation = &operation
+	pubsub.Get().Publish(gatewayUtil.ServerTopic(member.ServerID), gatewayUtil.MemberUpdate(pbMember))
+}
+
+// publishRoleUpdate sends a ROLE_UPDATE to the server of the role
+func publishRoleUpdate(role repo.Role) {
+	pubsub.Get().Publish(gatewayUtil.ServerTopic(role.ServerID), gatewayUtil.RoleUpdate(permissionService.ToProtoRole(role)))
+}
+
+// publishMessagePurge sends a MESSAGE_DELETE to each channel messages were
+// purged from
+func publishMessagePurge(messages []repo.GetServerMessagesBySenderRow) {
+	byChannel := make(map[int32][]int32)
+	for _, message := range messages {
+		byChannel[message.ChannelID.Int32] = append(byChannel[message.ChannelID.Int32], message.ID)
+	}
+	for channelID, ids := range byChannel {
+		pubsub.Get().Publish(gatewayUtil.ChannelTopic(channelID), gatewayUtil.MessageDelete(channelID, ids...))
+	}
+}
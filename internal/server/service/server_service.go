@@ -5,22 +5,56 @@ import (
 	"crypto/rand"
 	"encoding/base64"
 	"errors"
+	"log"
+	"regexp"
+	"strings"
 	"time"
 
 	"discord/gen/repo"
+	auditRepo "discord/internal/audit/repository"
+	auditService "discord/internal/audit/service"
+	auditUtil "discord/internal/audit/util"
+	channelUtil "discord/internal/channel/util"
 	commonErrors "discord/internal/common/errors"
+	commonUtil "discord/internal/common/util"
+	gatewayUtil "discord/internal/gateway/util"
+	permissionService "discord/internal/permission/service"
 	serverRepo "discord/internal/server/repository"
+	serverUtil "discord/internal/server/util"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+// TemporaryMembershipTimeout is how long a member who joined through a
+// temporary invite stays without being given a role
+const TemporaryMembershipTimeout = 24 * time.Hour
+
+// MaxBulkBanUsers is how many users a single bulk ban may target
+const MaxBulkBanUsers = 200
+
+// MaxTimeoutDuration is the longest a member can be timed out for
+const MaxTimeoutDuration = 28 * 24 * time.Hour
+
+// MaxDeleteMessageHours is how far back a ban may delete the user's messages
+const MaxDeleteMessageHours = 7 * 24
+
+// Vanity URLs are lowercase letters, digits and dashes
+var vanityURLPattern = regexp.MustCompile(`^[a-z0-9-]{3,32}$`)
+
 type ServerService struct {
-	serverRepo *serverRepo.ServerRepository
+	serverRepo  *serverRepo.ServerRepository
+	permissions *permissionService.Resolver
+	audit       *auditService.AuditService
+	onTimeout   []func(ctx context.Context, serverID, userID int32)
 }
 
-func NewServerService(serverRepo *serverRepo.ServerRepository) *ServerService {
+func NewServerService(serverRepo *serverRepo.ServerRepository, permissions *permissionService.Resolver, audit *auditService.AuditService) *ServerService {
 	return &ServerService{
-		serverRepo: serverRepo,
+		serverRepo:  serverRepo,
+		permissions: permissions,
+		audit:       audit,
 	}
 }
 
@@ -35,6 +69,12 @@ func (s *ServerService) CreateServer(ctx context.Context, name string, ownerID i
 		return repo.Server{}, err
 	}
 
+	// Every server starts with an @everyone role
+	_, err = s.serverRepo.CreateEveryoneRole(ctx, server.ID, channelUtil.DefaultEveryonePermissions)
+	if err != nil {
+		return repo.Server{}, err
+	}
+
 	// Add owner as first member
 	_, err = s.serverRepo.AddServerMember(ctx, server.ID, ownerID, nil)
 	if err != nil {
@@ -44,6 +84,7 @@ func (s *ServerService) CreateServer(ctx context.Context, name string, ownerID i
 	// Increment member count
 	_ = s.serverRepo.IncrementMemberCount(ctx, server.ID)
 
+	publishMemberJoin(server.ID, ownerID)
 	return server, nil
 }
 
@@ -54,17 +95,30 @@ func (s *ServerService) GetServer(ctx context.Context, serverID int32) (repo.Ser
 
 // UpdateServer updates server information
 func (s *ServerService) UpdateServer(ctx context.Context, serverID, userID int32, name, icon, banner, description, region *string) (repo.Server, error) {
-	// Verify user is server owner
-	server, err := s.serverRepo.GetServerByID(ctx, serverID)
+	if err := s.permissions.RequireServer(ctx, serverID, userID, channelUtil.PermissionManageServer); err != nil {
+		return repo.Server{}, err
+	}
+
+	before, err := s.serverRepo.GetServerByID(ctx, serverID)
 	if err != nil {
 		return repo.Server{}, commonErrors.ErrNotFound
 	}
 
-	if server.OwnerID != userID {
-		return repo.Server{}, commonErrors.ErrPermissionDenied
+	server, err := s.serverRepo.UpdateServer(ctx, serverID, name, icon, banner, description, region)
+	if err != nil {
+		return repo.Server{}, err
 	}
 
-	return s.serverRepo.UpdateServer(ctx, serverID, name, icon, banner, description, region)
+	s.audit.Record(ctx, auditService.Entry{
+		ServerID:   serverID,
+		UserID:     userID,
+		Action:     auditUtil.ServerUpdate,
+		TargetID:   serverID,
+		TargetType: auditUtil.TargetServer,
+		Before:     before,
+		After:      server,
+	})
+	return server, nil
 }
 
 // DeleteServer deletes a server
@@ -105,10 +159,25 @@ func (s *ServerService) TransferOwnership(ctx context.Context, serverID, current
 		return errors.New("new owner must be a server member")
 	}
 
-	return s.serverRepo.UpdateServerOwner(ctx, serverID, newOwnerID)
+	if err := s.serverRepo.UpdateServerOwner(ctx, serverID, newOwnerID); err != nil {
+		return err
+	}
+
+	s.audit.Record(ctx, auditService.Entry{
+		ServerID:   serverID,
+		UserID:     currentOwnerID,
+		Action:     auditUtil.OwnershipTransfer,
+		TargetID:   serverID,
+		TargetType: auditUtil.TargetServer,
+		Before:     map[string]int32{"owner_id": currentOwnerID},
+		After:      map[string]int32{"owner_id": newOwnerID},
+	})
+	return nil
 }
 
-// JoinServer adds a user to a server
+// JoinServer adds a user to a server. With an invite code the use is counted
+// and the member added in one transaction, so concurrent joins cannot exceed
+// the invite's max uses.
 func (s *ServerService) JoinServer(ctx context.Context, serverID, userID int32, inviteCode *string) error {
 	// Check if user is banned
 	banned, err := s.serverRepo.IsUserBanned(ctx, serverID, userID)
@@ -122,39 +191,86 @@ func (s *ServerService) JoinServer(ctx context.Context, serverID, userID int32,
 		return errors.New("already a member of this server")
 	}
 
-	// Validate invite if provided
-	if inviteCode != nil {
-		invite, err := s.serverRepo.GetInviteByCode(ctx, *inviteCode)
+	if inviteCode == nil {
+		_, err = s.serverRepo.AddServerMember(ctx, serverID, userID, nil)
 		if err != nil {
-			return errors.New("invalid invite code")
+			return err
 		}
-
-		if invite.ServerID != serverID {
-			return errors.New("invite is for a different server")
+		if err := s.serverRepo.IncrementMemberCount(ctx, serverID); err != nil {
+			return err
 		}
+		publishMemberJoin(serverID, userID)
+		return nil
+	}
 
-		// Check if invite has expired
-		if invite.ExpiresAt.Valid && invite.ExpiresAt.Time.Before(time.Now()) {
-			return errors.New("invite has expired")
-		}
+	invite, err := s.serverRepo.GetInviteByCode(ctx, *inviteCode)
+	if err != nil {
+		return errors.New("invalid invite code")
+	}
 
-		// Check max uses
-		if invite.MaxUses.Valid && invite.MaxUses.Int32 > 0 && invite.Uses.Int32 >= invite.MaxUses.Int32 {
-			return errors.New("invite has reached max uses")
-		}
+	if invite.ServerID != serverID {
+		return errors.New("invite is for a different server")
+	}
 
-		// Increment invite uses
-		_ = s.serverRepo.IncrementInviteUses(ctx, *inviteCode)
+	// Check if invite has expired
+	if invite.ExpiresAt.Valid && invite.ExpiresAt.Time.Before(time.Now()) {
+		return errors.New("invite has expired")
 	}
 
-	// Add member
-	_, err = s.serverRepo.AddServerMember(ctx, serverID, userID, nil)
+	// Check max uses
+	if invite.MaxUses.Valid && invite.MaxUses.Int32 > 0 && invite.Uses.Int32 >= invite.MaxUses.Int32 {
+		return errors.New("invite has reached max uses")
+	}
+
+	// The checks above only give early errors; redeeming re-checks under the
+	// row lock and loses the race to a concurrent join cleanly
+	temporaryUntil := pgtype.Timestamp{Time: time.Now().Add(TemporaryMembershipTimeout), Valid: true}
+	_, err = s.serverRepo.RedeemInvite(ctx, *inviteCode, userID, temporaryUntil)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return errors.New("invite is no longer valid")
+	}
 	if err != nil {
 		return err
 	}
 
-	// Increment member count
-	return s.serverRepo.IncrementMemberCount(ctx, serverID)
+	publishMemberJoin(serverID, userID)
+	return nil
+}
+
+// JoinWithCode joins the server an invite code or vanity URL points at and
+// returns that server
+func (s *ServerService) JoinWithCode(ctx context.Context, userID int32, code string) (repo.Server, error) {
+	var serverID int32
+	var inviteCode *string
+	if invite, err := s.serverRepo.GetInviteByCode(ctx, code); err == nil {
+		serverID = invite.ServerID
+		inviteCode = &code
+	} else {
+		server, err := s.serverRepo.GetServerByVanityUrl(ctx, strings.ToLower(code))
+		if err != nil {
+			return repo.Server{}, commonErrors.ErrNotFound
+		}
+		serverID = server.ID
+	}
+
+	if err := s.JoinServer(ctx, serverID, userID, inviteCode); err != nil {
+		return repo.Server{}, err
+	}
+	return s.serverRepo.GetServerByID(ctx, serverID)
+}
+
+// AddMember adds another user to a server on behalf of actorID, which
+// requires MANAGE_SERVER. Users join themselves through JoinWithCode, since
+// only an invite or vanity URL lets them in.
+func (s *ServerService) AddMember(ctx context.Context, serverID, actorID, userID int32) error {
+	if actorID == userID {
+		return commonErrors.ErrPermissionDenied
+	}
+	if err := s.permissions.RequireServer(ctx, serverID, actorID, channelUtil.PermissionManageServer); err != nil {
+		return err
+	}
+
+	return s.JoinServer(ctx, serverID, userID, nil)
 }
 
 // LeaveServer removes a user from a server
@@ -176,23 +292,28 @@ func (s *ServerService) LeaveServer(ctx context.Context, serverID, userID int32)
 	}
 
 	// Decrement member count
-	return s.serverRepo.DecrementMemberCount(ctx, serverID)
+	if err := s.serverRepo.DecrementMemberCount(ctx, serverID); err != nil {
+		return err
+	}
+
+	publishMemberUpdate(serverID, userID, gatewayUtil.MemberLeave)
+	return nil
 }
 
-// KickMember kicks a member from the server
+// KickMember kicks a member ranked below the moderator from the server
 func (s *ServerService) KickMember(ctx context.Context, serverID, moderatorID, targetUserID int32) error {
-	// Verify server exists and moderator has permission
+	if err := s.permissions.RequireServer(ctx, serverID, moderatorID, channelUtil.PermissionKickMembers); err != nil {
+		return err
+	}
+	if err := s.permissions.RequireAbove(ctx, serverID, moderatorID, targetUserID); err != nil {
+		return err
+	}
+
 	server, err := s.serverRepo.GetServerByID(ctx, serverID)
 	if err != nil {
 		return commonErrors.ErrNotFound
 	}
 
-	// Owner can kick anyone
-	if server.OwnerID != moderatorID {
-		// TODO: Check if moderator has KICK_MEMBERS permission
-		return commonErrors.ErrPermissionDenied
-	}
-
 	// Cannot kick owner
 	if server.OwnerID == targetUserID {
 		return errors.New("cannot kick server owner")
@@ -205,11 +326,27 @@ func (s *ServerService) KickMember(ctx context.Context, serverID, moderatorID, t
 	}
 
 	// Decrement member count
-	return s.serverRepo.DecrementMemberCount(ctx, serverID)
+	if err := s.serverRepo.DecrementMemberCount(ctx, serverID); err != nil {
+		return err
+	}
+	publishMemberUpdate(serverID, targetUserID, gatewayUtil.MemberKick)
+
+	s.audit.Record(ctx, auditService.Entry{
+		ServerID:   serverID,
+		UserID:     moderatorID,
+		Action:     auditUtil.MemberKick,
+		TargetID:   targetUserID,
+		TargetType: auditUtil.TargetUser,
+	})
+	return nil
 }
 
-// GetServerMembers retrieves server members
-func (s *ServerService) GetServerMembers(ctx context.Context, serverID int32, limit, offset int32) ([]repo.ServerMember, error) {
+// GetServerMembers retrieves server members; only members can list them
+func (s *ServerService) GetServerMembers(ctx context.Context, serverID, userID int32, limit, offset int32) ([]repo.ServerMember, error) {
+	if err := s.permissions.RequireMember(ctx, serverID, userID); err != nil {
+		return nil, err
+	}
+
 	if limit <= 0 {
 		limit = 100
 	}
@@ -220,65 +357,313 @@ func (s *ServerService) GetServerMembers(ctx context.Context, serverID int32, li
 	return s.serverRepo.GetServerMembers(ctx, serverID, limit, offset)
 }
 
-// UpdateMemberNickname updates a member's nickname
-func (s *ServerService) UpdateMemberNickname(ctx context.Context, serverID, userID int32, nickname *string) error {
+// UpdateMemberNickname updates a member's nickname. Members need
+// CHANGE_NICKNAME for their own and MANAGE_NICKNAMES for anyone else's.
+func (s *ServerService) UpdateMemberNickname(ctx context.Context, serverID, actorID, userID int32, nickname *string) error {
+	permission := channelUtil.PermissionManageNicknames
+	if actorID == userID {
+		permission = channelUtil.PermissionChangeNickname
+	}
+	if err := s.permissions.RequireServer(ctx, serverID, actorID, permission); err != nil {
+		return err
+	}
+
 	// Verify member exists
-	_, err := s.serverRepo.GetServerMember(ctx, serverID, userID)
+	member, err := s.serverRepo.GetServerMember(ctx, serverID, userID)
 	if err != nil {
 		return commonErrors.ErrNotFound
 	}
 
-	return s.serverRepo.UpdateMemberNickname(ctx, serverID, userID, nickname)
+	if err := s.serverRepo.UpdateMemberNickname(ctx, serverID, userID, nickname); err != nil {
+		return err
+	}
+
+	after := map[string]*string{"nickname": nickname}
+	before := map[string]*string{"nickname": nil}
+	if member.Nickname.Valid {
+		before["nickname"] = &member.Nickname.String
+	}
+	s.audit.Record(ctx, auditService.Entry{
+		ServerID:   serverID,
+		UserID:     actorID,
+		Action:     auditUtil.MemberUpdate,
+		TargetID:   userID,
+		TargetType: auditUtil.TargetUser,
+		Before:     before,
+		After:      after,
+	})
+	return nil
 }
 
-// CreateRole creates a new role
+// CreateRole creates a new role directly above @everyone. The actor can only
+// grant permissions they hold themselves.
 func (s *ServerService) CreateRole(ctx context.Context, serverID, userID int32, name string, color *string, hoist, mentionable bool, permissions int64, description *string) (repo.Role, error) {
-	// Verify user has permission
-	server, err := s.serverRepo.GetServerByID(ctx, serverID)
+	if !serverUtil.ValidateRoleName(name) {
+		return repo.Role{}, commonErrors.ErrInvalidInput
+	}
+
+	if err := s.permissions.RequireServer(ctx, serverID, userID, channelUtil.PermissionManageRoles); err != nil {
+		return repo.Role{}, err
+	}
+	// Inserting at NewRolePosition pushes the actor's own roles up, so any
+	// actor with a role above @everyone outranks the new role
+	if err := s.permissions.RequireRoleBelow(ctx, serverID, userID, permissionService.NewRolePosition-1); err != nil {
+		return repo.Role{}, err
+	}
+	if err := s.permissions.RequireGrantable(ctx, serverID, userID, permissions); err != nil {
+		return repo.Role{}, err
+	}
+
+	role, err := s.serverRepo.CreateRole(ctx, serverID, name, color, hoist, mentionable, permissionService.NewRolePosition, permissions, description)
 	if err != nil {
-		return repo.Role{}, commonErrors.ErrNotFound
+		return repo.Role{}, err
 	}
 
-	if server.OwnerID != userID {
-		// TODO: Check MANAGE_ROLES permission
-		return repo.Role{}, commonErrors.ErrPermissionDenied
+	s.audit.Record(ctx, auditService.Entry{
+		ServerID:   serverID,
+		UserID:     userID,
+		Action:     auditUtil.RoleCreate,
+		TargetID:   role.ID,
+		TargetType: auditUtil.TargetRole,
+		After:      role,
+	})
+	return role, nil
+}
+
+// UpdateRole edits a role below the actor's highest role
+func (s *ServerService) UpdateRole(ctx context.Context, roleID, userID int32, name, color *string, hoist, mentionable *bool, permissions *int64, description *string) (repo.Role, error) {
+	role, err := s.manageableRole(ctx, roleID, userID)
+	if err != nil {
+		return repo.Role{}, err
 	}
 
-	// Get current role count for position
-	roles, _ := s.serverRepo.GetServerRoles(ctx, serverID)
-	position := int32(len(roles))
+	if permissions != nil {
+		if err := s.permissions.RequireGrantable(ctx, role.ServerID, userID, *permissions); err != nil {
+			return repo.Role{}, err
+		}
+	}
 
-	return s.serverRepo.CreateRole(ctx, serverID, name, color, hoist, mentionable, position, permissions, description)
+	updated, err := s.serverRepo.UpdateRole(ctx, roleID, name, color, hoist, mentionable, permissions, description)
+	if err != nil {
+		return repo.Role{}, err
+	}
+	publishRoleUpdate(updated)
+
+	s.audit.Record(ctx, auditService.Entry{
+		ServerID:   role.ServerID,
+		UserID:     userID,
+		Action:     auditUtil.RoleUpdate,
+		TargetID:   roleID,
+		TargetType: auditUtil.TargetRole,
+		Before:     role,
+		After:      updated,
+	})
+	return updated, nil
 }
 
 // GetServerRoles retrieves all roles for a server
-func (s *ServerService) GetServerRoles(ctx context.Context, serverID int32) ([]repo.Role, error) {
+func (s *ServerService) GetServerRoles(ctx context.Context, serverID, userID int32) ([]repo.Role, error) {
+	if err := s.permissions.RequireMember(ctx, serverID, userID); err != nil {
+		return nil, err
+	}
+
 	return s.serverRepo.GetServerRoles(ctx, serverID)
 }
 
-// DeleteRole deletes a role
+// DeleteRole deletes a role below the actor's highest role
 func (s *ServerService) DeleteRole(ctx context.Context, roleID, userID int32) error {
+	role, err := s.manageableRole(ctx, roleID, userID)
+	if err != nil {
+		return err
+	}
+
+	if role.IsDefault.Bool {
+		return commonErrors.ErrInvalidInput
+	}
+
+	if err := s.serverRepo.DeleteRole(ctx, roleID); err != nil {
+		return err
+	}
+	deleted := role
+	deleted.IsDeleted = pgtype.Bool{Bool: true, Valid: true}
+	publishRoleUpdate(deleted)
+
+	s.audit.Record(ctx, auditService.Entry{
+		ServerID:   role.ServerID,
+		UserID:     userID,
+		Action:     auditUtil.RoleDelete,
+		TargetID:   roleID,
+		TargetType: auditUtil.TargetRole,
+		Before:     role,
+	})
+	return nil
+}
+
+// ReorderRoles moves roles to new positions, renumbering the others around
+// them. Only roles below the actor's highest role can be moved, only to
+// positions below it, and @everyone stays at the bottom.
+func (s *ServerService) ReorderRoles(ctx context.Context, serverID, userID int32, positions map[int32]int32) ([]repo.Role, error) {
+	if len(positions) == 0 {
+		return nil, commonErrors.ErrInvalidInput
+	}
+
+	if err := s.permissions.RequireServer(ctx, serverID, userID, channelUtil.PermissionManageRoles); err != nil {
+		return nil, err
+	}
+
+	roles, err := s.serverRepo.GetServerRoles(ctx, serverID)
+	if err != nil {
+		return nil, err
+	}
+	current := make(map[int32]repo.Role, len(roles))
+	for _, role := range roles {
+		current[role.ID] = role
+	}
+
+	touched := make([]int32, 0, len(positions)*2)
+	for roleID, position := range positions {
+		role, ok := current[roleID]
+		if !ok {
+			return nil, commonErrors.ErrNotFound
+		}
+		if role.IsDefault.Bool || position < permissionService.NewRolePosition {
+			return nil, commonErrors.ErrInvalidInput
+		}
+		touched = append(touched, role.Position.Int32, position)
+	}
+	if err := s.permissions.RequireRoleBelow(ctx, serverID, userID, touched...); err != nil {
+		return nil, err
+	}
+
+	if err := s.serverRepo.UpdateRolePositions(ctx, serverID, positions); err != nil {
+		return nil, err
+	}
+
+	roles, err = s.serverRepo.GetServerRoles(ctx, serverID)
+	if err != nil {
+		return nil, err
+	}
+	for _, role := range roles {
+		before := current[role.ID]
+		if before.Position.Int32 == role.Position.Int32 {
+			continue
+		}
+		s.audit.Record(ctx, auditService.Entry{
+			ServerID:   serverID,
+			UserID:     userID,
+			Action:     auditUtil.RoleUpdate,
+			TargetID:   role.ID,
+			TargetType: auditUtil.TargetRole,
+			Before:     before,
+			After:      role,
+		})
+	}
+	return roles, nil
+}
+
+// AssignRole gives a member a role below the actor's highest role
+func (s *ServerService) AssignRole(ctx context.Context, serverID, actorID, userID, roleID int32) error {
+	member, err := s.roleTarget(ctx, serverID, actorID, userID, roleID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.serverRepo.AssignRole(ctx, member.ID, roleID); err != nil {
+		return err
+	}
+	publishMemberUpdate(serverID, userID, gatewayUtil.MemberRoles)
+
+	s.audit.Record(ctx, auditService.Entry{
+		ServerID:   serverID,
+		UserID:     actorID,
+		Action:     auditUtil.MemberRoleAdd,
+		TargetID:   userID,
+		TargetType: auditUtil.TargetUser,
+		After:      map[string]int32{"role_id": roleID},
+	})
+
+	// Members given a role keep their place when their temporary invite ends
+	if member.TemporaryUntil.Valid {
+		return s.serverRepo.MakeMemberPermanent(ctx, member.ID)
+	}
+	return nil
+}
+
+// UnassignRole takes a role below the actor's highest role away from a member
+func (s *ServerService) UnassignRole(ctx context.Context, serverID, actorID, userID, roleID int32) error {
+	member, err := s.roleTarget(ctx, serverID, actorID, userID, roleID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.serverRepo.UnassignRole(ctx, member.ID, roleID); err != nil {
+		return err
+	}
+	publishMemberUpdate(serverID, userID, gatewayUtil.MemberRoles)
+
+	s.audit.Record(ctx, auditService.Entry{
+		ServerID:   serverID,
+		UserID:     actorID,
+		Action:     auditUtil.MemberRoleRemove,
+		TargetID:   userID,
+		TargetType: auditUtil.TargetUser,
+		Before:     map[string]int32{"role_id": roleID},
+	})
+	return nil
+}
+
+// manageableRole loads a role the user may edit: they need MANAGE_ROLES and
+// the role must sit below their highest role
+func (s *ServerService) manageableRole(ctx context.Context, roleID, userID int32) (repo.Role, error) {
 	role, err := s.serverRepo.GetRoleByID(ctx, roleID)
 	if err != nil {
-		return commonErrors.ErrNotFound
+		return repo.Role{}, commonErrors.ErrNotFound
+	}
+
+	if err := s.permissions.RequireServer(ctx, role.ServerID, userID, channelUtil.PermissionManageRoles); err != nil {
+		return repo.Role{}, err
 	}
+	if err := s.permissions.RequireRoleBelow(ctx, role.ServerID, userID, role.Position.Int32); err != nil {
+		return repo.Role{}, err
+	}
+
+	return role, nil
+}
 
-	// Verify user has permission
-	server, err := s.serverRepo.GetServerByID(ctx, role.ServerID)
+// roleTarget checks an assignment of roleID to userID and returns the member.
+// @everyone is implicit and cannot be assigned or removed.
+func (s *ServerService) roleTarget(ctx context.Context, serverID, actorID, userID, roleID int32) (repo.ServerMember, error) {
+	role, err := s.manageableRole(ctx, roleID, actorID)
 	if err != nil {
-		return commonErrors.ErrNotFound
+		return repo.ServerMember{}, err
+	}
+	if role.ServerID != serverID || role.IsDefault.Bool {
+		return repo.ServerMember{}, commonErrors.ErrInvalidInput
 	}
 
-	if server.OwnerID != userID {
-		// TODO: Check MANAGE_ROLES permission
-		return commonErrors.ErrPermissionDenied
+	member, err := s.serverRepo.GetServerMember(ctx, serverID, userID)
+	if err != nil {
+		return repo.ServerMember{}, commonErrors.ErrNotFound
 	}
 
-	return s.serverRepo.DeleteRole(ctx, roleID)
+	return member, nil
 }
 
 // CreateInvite creates a server invite
 func (s *ServerService) CreateInvite(ctx context.Context, serverID, channelID, inviterID int32, maxUses, maxAge int32, temporary bool) (repo.Invite, error) {
+	// Invites may point at a channel of the server or at the server itself
+	if channelID != 0 {
+		channel, err := s.serverRepo.GetChannelByID(ctx, channelID)
+		if err != nil || channel.ServerID != serverID {
+			return repo.Invite{}, commonErrors.ErrInvalidInput
+		}
+		if err := s.permissions.RequireChannel(ctx, channelID, inviterID, channelUtil.PermissionCreateInvite); err != nil {
+			return repo.Invite{}, err
+		}
+	} else if err := s.permissions.RequireServer(ctx, serverID, inviterID, channelUtil.PermissionCreateInvite); err != nil {
+		return repo.Invite{}, err
+	}
+
 	// Generate random invite code
 	code := generateInviteCode()
 
@@ -292,7 +677,20 @@ func (s *ServerService) CreateInvite(ctx context.Context, serverID, channelID, i
 		}
 	}
 
-	return s.serverRepo.CreateInvite(ctx, code, serverID, channelID, inviterID, maxUses, maxAge, temporary, expiresAt)
+	invite, err := s.serverRepo.CreateInvite(ctx, code, serverID, channelID, inviterID, maxUses, maxAge, temporary, expiresAt)
+	if err != nil {
+		return repo.Invite{}, err
+	}
+
+	s.audit.Record(ctx, auditService.Entry{
+		ServerID:   serverID,
+		UserID:     inviterID,
+		Action:     auditUtil.InviteCreate,
+		TargetID:   invite.ID,
+		TargetType: auditUtil.TargetInvite,
+		After:      invite,
+	})
+	return invite, nil
 }
 
 // GetInvite retrieves an invite by code
@@ -301,7 +699,11 @@ func (s *ServerService) GetInvite(ctx context.Context, code string) (repo.Invite
 }
 
 // GetServerInvites retrieves all invites for a server
-func (s *ServerService) GetServerInvites(ctx context.Context, serverID int32) ([]repo.Invite, error) {
+func (s *ServerService) GetServerInvites(ctx context.Context, serverID, userID int32) ([]repo.Invite, error) {
+	if err := s.permissions.RequireServer(ctx, serverID, userID, channelUtil.PermissionManageServer); err != nil {
+		return nil, err
+	}
+
 	return s.serverRepo.GetServerInvites(ctx, serverID)
 }
 
@@ -312,33 +714,198 @@ func (s *ServerService) DeleteInvite(ctx context.Context, code string, userID in
 		return commonErrors.ErrNotFound
 	}
 
-	// Verify user has permission (owner or invite creator)
-	server, err := s.serverRepo.GetServerByID(ctx, invite.ServerID)
+	// The invite creator can always delete it, anyone else needs MANAGE_SERVER
+	if invite.InviterID != userID {
+		if err := s.permissions.RequireServer(ctx, invite.ServerID, userID, channelUtil.PermissionManageServer); err != nil {
+			return err
+		}
+	}
+
+	if err := s.serverRepo.DeleteInvite(ctx, code); err != nil {
+		return err
+	}
+
+	s.audit.Record(ctx, auditService.Entry{
+		ServerID:   invite.ServerID,
+		UserID:     userID,
+		Action:     auditUtil.InviteDelete,
+		TargetID:   invite.ID,
+		TargetType: auditUtil.TargetInvite,
+		Before:     invite,
+	})
+	return nil
+}
+
+// GetInviteJoins lists who joined the server through which invite. An empty
+// code lists the joins of every invite.
+func (s *ServerService) GetInviteJoins(ctx context.Context, serverID, userID int32, code string, limit, offset int32) ([]repo.GetInviteJoinsRow, error) {
+	if err := s.permissions.RequireServer(ctx, serverID, userID, channelUtil.PermissionManageServer); err != nil {
+		return nil, err
+	}
+	if limit <= 0 || limit > 100 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	return s.serverRepo.GetInviteJoins(ctx, serverID, code, limit, offset)
+}
+
+// ClaimVanityUrl gives a server a vanity URL that can be joined like an
+// invite. An empty URL releases the current one.
+func (s *ServerService) ClaimVanityUrl(ctx context.Context, serverID, userID int32, vanityURL string) (repo.Server, error) {
+	if err := s.permissions.RequireServer(ctx, serverID, userID, channelUtil.PermissionManageServer); err != nil {
+		return repo.Server{}, err
+	}
+
+	before, err := s.serverRepo.GetServerByID(ctx, serverID)
 	if err != nil {
-		return commonErrors.ErrNotFound
+		return repo.Server{}, commonErrors.ErrNotFound
 	}
 
-	if server.OwnerID != userID && invite.InviterID != userID {
-		// TODO: Check MANAGE_SERVER permission
-		return commonErrors.ErrPermissionDenied
+	vanityURL = strings.ToLower(vanityURL)
+	if vanityURL != "" {
+		if !vanityURLPattern.MatchString(vanityURL) {
+			return repo.Server{}, commonErrors.ErrInvalidInput
+		}
+		owner, err := s.serverRepo.GetServerByVanityUrl(ctx, vanityURL)
+		if err == nil && owner.ID != serverID {
+			return repo.Server{}, commonErrors.ErrDuplicate
+		}
+	}
+
+	server, err := s.serverRepo.SetVanityUrl(ctx, serverID, vanityURL)
+	if err != nil {
+		// 23505 is a unique violation: another server claimed the URL between
+		// the check and the update
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return repo.Server{}, commonErrors.ErrDuplicate
+		}
+		return repo.Server{}, err
 	}
 
-	return s.serverRepo.DeleteInvite(ctx, code)
+	s.audit.Record(ctx, auditService.Entry{
+		ServerID:   serverID,
+		UserID:     userID,
+		Action:     auditUtil.VanityURLUpdate,
+		TargetID:   serverID,
+		TargetType: auditUtil.TargetServer,
+		Before:     map[string]pgtype.Text{"vanity_url": before.VanityUrl},
+		After:      map[string]pgtype.Text{"vanity_url": server.VanityUrl},
+	})
+	return server, nil
 }
 
-// BanMember bans a user from the server
-func (s *ServerService) BanMember(ctx context.Context, serverID, moderatorID, targetUserID int32, reason *string, durationSeconds *int32) error {
-	// Verify moderator has permission
+// ResolveVanityUrl retrieves the server behind a vanity URL
+func (s *ServerService) ResolveVanityUrl(ctx context.Context, vanityURL string) (repo.Server, error) {
+	server, err := s.serverRepo.GetServerByVanityUrl(ctx, strings.ToLower(vanityURL))
+	if err != nil {
+		return repo.Server{}, commonErrors.ErrNotFound
+	}
+	return server, nil
+}
+
+// RevokeTemporaryMemberships removes a user from the servers they joined
+// through a temporary invite and were not given a role in since. The gateway
+// calls it once the user's last session is gone.
+func (s *ServerService) RevokeTemporaryMemberships(ctx context.Context, userID int32) error {
+	members, err := s.serverRepo.RemoveTemporaryMemberships(ctx, userID)
+	if err != nil {
+		return err
+	}
+	publishMembersLeft(members)
+	return nil
+}
+
+// SweepInvites deletes expired and used up invites and removes the temporary
+// members whose time is up
+func (s *ServerService) SweepInvites(ctx context.Context) (int64, int, error) {
+	invites, err := s.serverRepo.SoftDeleteExpiredInvites(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	members, err := s.serverRepo.RemoveExpiredTemporaryMembers(ctx)
+	if err != nil {
+		return invites, 0, err
+	}
+	publishMembersLeft(members)
+	return invites, len(members), nil
+}
+
+// StartInviteSweeper sweeps invites and temporary members every interval
+// until ctx is done
+func (s *ServerService) StartInviteSweeper(ctx context.Context, interval time.Duration) {
+	go commonUtil.RunPeriodic(ctx, interval, func(ctx context.Context) {
+		invites, members, err := s.SweepInvites(ctx)
+		if err != nil {
+			log.Printf("failed to sweep invites: %v", err)
+			return
+		}
+		if invites > 0 || members > 0 {
+			log.Printf("swept %d invites and %d temporary members", invites, members)
+		}
+	})
+}
+
+// BanMember bans a user ranked below the moderator from the server. A ban
+// with a duration is lifted by the ban scheduler once it expires. The messages
+// the user sent in the last deleteMessageHours are deleted along with it.
+func (s *ServerService) BanMember(ctx context.Context, serverID, moderatorID, targetUserID int32, reason *string, durationSeconds *int32, deleteMessageHours int32) error {
+	if deleteMessageHours < 0 || deleteMessageHours > MaxDeleteMessageHours {
+		return commonErrors.ErrInvalidInput
+	}
+	if err := s.permissions.RequireServer(ctx, serverID, moderatorID, channelUtil.PermissionBanMembers); err != nil {
+		return err
+	}
+	if err := s.permissions.RequireAbove(ctx, serverID, moderatorID, targetUserID); err != nil {
+		return err
+	}
+
 	server, err := s.serverRepo.GetServerByID(ctx, serverID)
 	if err != nil {
 		return commonErrors.ErrNotFound
 	}
 
-	if server.OwnerID != moderatorID {
-		// TODO: Check BAN_MEMBERS permission
-		return commonErrors.ErrPermissionDenied
+	return s.banMember(ctx, server, moderatorID, targetUserID, reason, durationSeconds, deleteMessageHours)
+}
+
+// BulkBanMembers bans many users at once, e.g. to respond to a raid. Users the
+// moderator does not rank above are skipped and returned as failed.
+func (s *ServerService) BulkBanMembers(ctx context.Context, serverID, moderatorID int32, userIDs []int32, reason *string, durationSeconds *int32, deleteMessageHours int32) ([]int32, []int32, error) {
+	if len(userIDs) == 0 || len(userIDs) > MaxBulkBanUsers {
+		return nil, nil, commonErrors.ErrInvalidInput
+	}
+	if deleteMessageHours < 0 || deleteMessageHours > MaxDeleteMessageHours {
+		return nil, nil, commonErrors.ErrInvalidInput
+	}
+	if err := s.permissions.RequireServer(ctx, serverID, moderatorID, channelUtil.PermissionBanMembers); err != nil {
+		return nil, nil, err
+	}
+
+	server, err := s.serverRepo.GetServerByID(ctx, serverID)
+	if err != nil {
+		return nil, nil, commonErrors.ErrNotFound
 	}
 
+	var banned, failed []int32
+	for _, userID := range userIDs {
+		if err := s.permissions.RequireAbove(ctx, serverID, moderatorID, userID); err != nil {
+			failed = append(failed, userID)
+			continue
+		}
+		if err := s.banMember(ctx, server, moderatorID, userID, reason, durationSeconds, deleteMessageHours); err != nil {
+			failed = append(failed, userID)
+			continue
+		}
+		banned = append(banned, userID)
+	}
+	return banned, failed, nil
+}
+
+// banMember bans a user the moderator was already checked to rank above
+func (s *ServerService) banMember(ctx context.Context, server repo.Server, moderatorID, targetUserID int32, reason *string, durationSeconds *int32, deleteMessageHours int32) error {
 	// Cannot ban owner
 	if server.OwnerID == targetUserID {
 		return errors.New("cannot ban server owner")
@@ -355,36 +922,196 @@ func (s *ServerService) BanMember(ctx context.Context, serverID, moderatorID, ta
 	}
 
 	// Create ban
-	_, err = s.serverRepo.CreateBan(ctx, serverID, targetUserID, moderatorID, reason, expiresAt)
+	ban, err := s.serverRepo.CreateBan(ctx, server.ID, targetUserID, moderatorID, reason, expiresAt)
 	if err != nil {
 		return err
 	}
 
 	// Remove member if they're in the server
-	_ = s.serverRepo.RemoveServerMember(ctx, serverID, targetUserID)
-	_ = s.serverRepo.DecrementMemberCount(ctx, serverID)
+	if err := s.serverRepo.RemoveServerMember(ctx, server.ID, targetUserID); err == nil {
+		_ = s.serverRepo.DecrementMemberCount(ctx, server.ID)
+	}
 
+	if deleteMessageHours > 0 {
+		since := time.Now().Add(-time.Duration(deleteMessageHours) * time.Hour)
+		messages, err := s.serverRepo.PurgeUserMessages(ctx, server.ID, targetUserID, since)
+		if err != nil {
+			return err
+		}
+		publishMessagePurge(messages)
+	}
+
+	s.audit.Record(ctx, auditService.Entry{
+		ServerID:   server.ID,
+		UserID:     moderatorID,
+		Action:     auditUtil.MemberBanAdd,
+		TargetID:   targetUserID,
+		TargetType: auditUtil.TargetUser,
+		After:      ban,
+		Reason:     reason,
+	})
+	publishMemberUpdate(server.ID, targetUserID, gatewayUtil.MemberBan)
 	return nil
 }
 
 // UnbanMember unbans a user from the server
 func (s *ServerService) UnbanMember(ctx context.Context, serverID, moderatorID, targetUserID int32) error {
-	// Verify moderator has permission
-	server, err := s.serverRepo.GetServerByID(ctx, serverID)
+	if err := s.permissions.RequireServer(ctx, serverID, moderatorID, channelUtil.PermissionBanMembers); err != nil {
+		return err
+	}
+
+	if err := s.serverRepo.DeleteBan(ctx, serverID, targetUserID); err != nil {
+		return err
+	}
+
+	s.audit.Record(ctx, auditService.Entry{
+		ServerID:   serverID,
+		UserID:     moderatorID,
+		Action:     auditUtil.MemberBanRemove,
+		TargetID:   targetUserID,
+		TargetType: auditUtil.TargetUser,
+	})
+	publishMemberUpdate(serverID, targetUserID, gatewayUtil.MemberUnban)
+	return nil
+}
+
+// LiftExpiredBans unbans every user whose ban has expired
+func (s *ServerService) LiftExpiredBans(ctx context.Context) (int, error) {
+	bans, err := s.serverRepo.LiftExpiredBans(ctx)
 	if err != nil {
-		return commonErrors.ErrNotFound
+		return 0, err
 	}
+	for _, ban := range bans {
+		publishMemberUpdate(ban.ServerID, ban.UserID, gatewayUtil.MemberUnban)
+	}
+	return len(bans), nil
+}
 
-	if server.OwnerID != moderatorID {
-		// TODO: Check BAN_MEMBERS permission
-		return commonErrors.ErrPermissionDenied
+// StartBanScheduler lifts expired bans every interval until ctx is done
+func (s *ServerService) StartBanScheduler(ctx context.Context, interval time.Duration) {
+	go commonUtil.RunPeriodic(ctx, interval, func(ctx context.Context) {
+		lifted, err := s.LiftExpiredBans(ctx)
+		if err != nil {
+			log.Printf("failed to lift expired bans: %v", err)
+			return
+		}
+		if lifted > 0 {
+			log.Printf("lifted %d expired bans", lifted)
+		}
+	})
+}
+
+// TimeoutMember stops a member ranked below the moderator from communicating
+// for durationSeconds. A duration of 0 ends the member's timeout early.
+func (s *ServerService) TimeoutMember(ctx context.Context, serverID, moderatorID, targetUserID, durationSeconds int32) (repo.ServerMember, error) {
+	duration := time.Duration(durationSeconds) * time.Second
+	if duration < 0 || duration > MaxTimeoutDuration {
+		return repo.ServerMember{}, commonErrors.ErrInvalidInput
+	}
+	if err := s.permissions.RequireServer(ctx, serverID, moderatorID, channelUtil.PermissionModerateMembers); err != nil {
+		return repo.ServerMember{}, err
+	}
+	if err := s.permissions.RequireAbove(ctx, serverID, moderatorID, targetUserID); err != nil {
+		return repo.ServerMember{}, err
+	}
+
+	// Administrators are never restricted, so a timeout would not apply to them
+	targetPermissions, err := s.permissions.ServerPermissions(ctx, serverID, targetUserID)
+	if err != nil {
+		return repo.ServerMember{}, commonErrors.ErrNotFound
+	}
+	if channelUtil.IsAdministrator(targetPermissions) {
+		return repo.ServerMember{}, errors.New("cannot time out an administrator")
 	}
 
-	return s.serverRepo.DeleteBan(ctx, serverID, targetUserID)
+	previous, err := s.serverRepo.GetServerMember(ctx, serverID, targetUserID)
+	if err != nil {
+		return repo.ServerMember{}, commonErrors.ErrNotFound
+	}
+
+	var until time.Time
+	operation := gatewayUtil.MemberTimeoutEnd
+	if duration > 0 {
+		until = time.Now().Add(duration)
+		operation = gatewayUtil.MemberTimeout
+	}
+
+	member, err := s.serverRepo.SetMemberTimeout(ctx, serverID, targetUserID, until)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return repo.ServerMember{}, commonErrors.ErrNotFound
+		}
+		return repo.ServerMember{}, err
+	}
+
+	s.audit.Record(ctx, auditService.Entry{
+		ServerID:   serverID,
+		UserID:     moderatorID,
+		Action:     auditUtil.MemberTimeout,
+		TargetID:   targetUserID,
+		TargetType: auditUtil.TargetUser,
+		Before:     map[string]pgtype.Timestamp{"communication_disabled_until": previous.CommunicationDisabledUntil},
+		After:      map[string]pgtype.Timestamp{"communication_disabled_until": member.CommunicationDisabledUntil},
+	})
+
+	publishMember(member, operation)
+	if duration > 0 {
+		for _, fn := range s.onTimeout {
+			fn(ctx, serverID, targetUserID)
+		}
+	}
+	return member, nil
+}
+
+// OnTimeout registers fn to run when a member is timed out. Hooks are
+// registered while the application is set up, before requests are served.
+func (s *ServerService) OnTimeout(fn func(ctx context.Context, serverID, userID int32)) {
+	s.onTimeout = append(s.onTimeout, fn)
+}
+
+// EndExpiredTimeouts ends every member timeout that has passed
+func (s *ServerService) EndExpiredTimeouts(ctx context.Context) (int, error) {
+	members, err := s.serverRepo.ClearExpiredMemberTimeouts(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for _, member := range members {
+		publishMember(member, gatewayUtil.MemberTimeoutEnd)
+	}
+	return len(members), nil
+}
+
+// StartTimeoutScheduler ends expired member timeouts every interval until ctx
+// is done
+func (s *ServerService) StartTimeoutScheduler(ctx context.Context, interval time.Duration) {
+	go commonUtil.RunPeriodic(ctx, interval, func(ctx context.Context) {
+		ended, err := s.EndExpiredTimeouts(ctx)
+		if err != nil {
+			log.Printf("failed to end expired timeouts: %v", err)
+			return
+		}
+		if ended > 0 {
+			log.Printf("ended %d expired timeouts", ended)
+		}
+	})
+}
+
+// GetAuditLog returns one page of the server's audit log and the cursor of
+// the next page
+func (s *ServerService) GetAuditLog(ctx context.Context, serverID, userID int32, filter auditRepo.Filter, cursor string, limit int32) ([]repo.AuditLog, string, error) {
+	if err := s.permissions.RequireServer(ctx, serverID, userID, channelUtil.PermissionViewAuditLog); err != nil {
+		return nil, "", err
+	}
+
+	return s.audit.GetAuditLog(ctx, serverID, filter, cursor, limit)
 }
 
 // GetServerBans retrieves all bans for a server
-func (s *ServerService) GetServerBans(ctx context.Context, serverID int32) ([]repo.Ban, error) {
+func (s *ServerService) GetServerBans(ctx context.Context, serverID, userID int32) ([]repo.Ban, error) {
+	if err := s.permissions.RequireServer(ctx, serverID, userID, channelUtil.PermissionBanMembers); err != nil {
+		return nil, err
+	}
+
 	return s.serverRepo.GetServerBans(ctx, serverID)
 }
 
@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	commonErrors "discord/internal/common/errors"
+)
+
+func TestAddMemberSelf(t *testing.T) {
+	// Rejected before any lookup, so no repository is needed
+	s := &ServerService{}
+	err := s.AddMember(context.Background(), 1, 2, 2)
+	if !errors.Is(err, commonErrors.ErrPermissionDenied) {
+		t.Errorf("expected adding yourself without an invite to be denied, got %v", err)
+	}
+}
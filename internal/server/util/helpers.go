@@ -1,8 +1,10 @@
 package util
 
 import (
+	"discord/gen/proto/schema"
 	"discord/gen/repo"
 	"fmt"
+	"sort"
 	"strings"
 )
 
@@ -49,6 +51,29 @@ func IsServerMember(members []repo.ServerMember, userID int32) bool {
 	return false
 }
 
+// ConvertMemberToProto converts a repo server member to a proto server member
+func ConvertMemberToProto(member repo.ServerMember) *schema.ServerMember {
+	pbMember := &schema.ServerMember{
+		Id:       member.ID,
+		ServerId: member.ServerID,
+		UserId:   member.UserID,
+		JoinedAt: member.JoinedAt.Time.Unix(),
+	}
+	if member.Nickname.Valid {
+		pbMember.Nickname = member.Nickname.String
+	}
+	if member.IsMuted.Valid {
+		pbMember.IsMuted = member.IsMuted.Bool
+	}
+	if member.IsDeafened.Valid {
+		pbMember.IsDeafened = member.IsDeafened.Bool
+	}
+	if member.CommunicationDisabledUntil.Valid {
+		pbMember.CommunicationDisabledUntil = member.CommunicationDisabledUntil.Time.Unix()
+	}
+	return pbMember
+}
+
 // HasPermission checks if user has a specific permission
 func HasPermission(permissions int64, permission int64) bool {
 	return permissions&permission == permission
@@ -263,3 +288,53 @@ func GetPermissionNames(permissions int64) []string {
 func IsValidColor(color int32) bool {
 	return color >= 0 && color <= 0xFFFFFF
 }
+
+// OrderRolePositions applies moves, role ids to requested positions, and
+// numbers the roles from 1 upwards without gaps or ties. A moved role takes
+// the requested slot and the roles it passes make room. @everyone keeps 0.
+// It returns the new position of every role whose position changes.
+func OrderRolePositions(roles []repo.Role, moves map[int32]int32) map[int32]int32 {
+	type slot struct {
+		role  repo.Role
+		key   int32
+		shift int // +1 moved up, -1 moved down, 0 not moved
+	}
+	slots := make([]slot, 0, len(roles))
+	for _, role := range roles {
+		if role.IsDefault.Bool {
+			continue
+		}
+		s := slot{role: role, key: role.Position.Int32}
+		if target, ok := moves[role.ID]; ok && target != s.key {
+			if target > s.key {
+				s.shift = 1
+			} else {
+				s.shift = -1
+			}
+			s.key = target
+		}
+		slots = append(slots, s)
+	}
+
+	sort.Slice(slots, func(i, j int) bool {
+		a, b := slots[i], slots[j]
+		if a.key != b.key {
+			return a.key < b.key
+		}
+		if a.shift != b.shift {
+			return a.shift < b.shift
+		}
+		if a.role.Position.Int32 != b.role.Position.Int32 {
+			return a.role.Position.Int32 < b.role.Position.Int32
+		}
+		return a.role.ID < b.role.ID
+	})
+
+	positions := make(map[int32]int32)
+	for i, s := range slots {
+		if position := int32(i) + 1; position != s.role.Position.Int32 {
+			positions[s.role.ID] = position
+		}
+	}
+	return positions
+}
@@ -0,0 +1,45 @@
+package util
+
+import (
+	"reflect"
+	"testing"
+
+	"discord/gen/repo"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// rolesAt returns @everyone and a role with id i+1 at positions[i]
+func rolesAt(positions ...int32) []repo.Role {
+	roles := []repo.Role{{ID: 100, IsDefault: pgtype.Bool{Bool: true, Valid: true}}}
+	for i, position := range positions {
+		roles = append(roles, repo.Role{ID: int32(i + 1), Position: pgtype.Int4{Int32: position, Valid: true}})
+	}
+	return roles
+}
+
+func TestOrderRolePositions(t *testing.T) {
+	tests := []struct {
+		name  string
+		roles []repo.Role
+		moves map[int32]int32
+		want  map[int32]int32
+	}{
+		{"no change", rolesAt(1, 2, 3), map[int32]int32{2: 2}, map[int32]int32{}},
+		{"move up", rolesAt(1, 2, 3), map[int32]int32{1: 3}, map[int32]int32{1: 3, 2: 1, 3: 2}},
+		{"move down", rolesAt(1, 2, 3), map[int32]int32{3: 1}, map[int32]int32{1: 2, 2: 3, 3: 1}},
+		{"swap", rolesAt(1, 2), map[int32]int32{1: 2, 2: 1}, map[int32]int32{1: 2, 2: 1}},
+		{"same target twice", rolesAt(1, 2, 3), map[int32]int32{1: 3, 2: 3}, map[int32]int32{1: 2, 2: 3, 3: 1}},
+		{"existing ties", rolesAt(1, 1, 1), nil, map[int32]int32{2: 2, 3: 3}},
+		{"gaps", rolesAt(2, 5, 9), nil, map[int32]int32{1: 1, 2: 2, 3: 3}},
+		{"beyond the top", rolesAt(1, 2), map[int32]int32{1: 50}, map[int32]int32{1: 2, 2: 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := OrderRolePositions(tt.roles, tt.moves)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,307 @@
+package controller
+
+import (
+	"context"
+
+	syncPb "discord/gen/proto/service/sync"
+	commonErrors "discord/internal/common/errors"
+	syncService "discord/internal/sync/service"
+	"discord/internal/sync/util"
+)
+
+type SyncController struct {
+	syncPb.UnimplementedSyncServiceServer
+	syncService *syncService.SyncService
+}
+
+func NewSyncController(syncService *syncService.SyncService) *syncPb.SyncServiceServer {
+	controller := &SyncController{
+		syncService: syncService,
+	}
+	var grpcController syncPb.SyncServiceServer = controller
+	return &grpcController
+}
+
+// callerID returns the authenticated user. A user_id in the request is only
+// accepted when it matches the caller.
+func callerID(ctx context.Context, requested int32) (int32, error) {
+	userID, ok := ctx.Value("user_id").(int32)
+	if !ok || userID == 0 {
+		return 0, commonErrors.ErrUnauthorized
+	}
+	if requested != 0 && requested != userID {
+		return 0, commonErrors.ErrPermissionDenied
+	}
+	return userID, nil
+}
+
+func (c *SyncController) SyncFriends(ctx context.Context, req *syncPb.SyncDataRequest) (*syncPb.SyncFriendsResponse, error) {
+	userID, err := callerID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+	return c.syncFriends(ctx, userID, req.GetLastUpdatedAt(), req.GetLimit(), req.GetOffset())
+}
+
+func (c *SyncController) syncFriends(ctx context.Context, userID int32, lastUpdatedAt int64, limit, offset int32) (*syncPb.SyncFriendsResponse, error) {
+	delta, err := c.syncService.SyncFriends(ctx, userID, lastUpdatedAt, limit, offset)
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	total := len(delta.Items) + len(delta.PendingRequests) + len(delta.DeletedIDs)
+	return &syncPb.SyncFriendsResponse{
+		Friends:          util.ConvertFriends(delta.Items),
+		PendingRequests:  util.ConvertFriends(delta.PendingRequests),
+		DeletedFriendIds: delta.DeletedIDs,
+		ServerTimestamp:  delta.ServerTimestamp,
+		IsSynced:         total == 0 && !delta.HasMore,
+		TotalUpdates:     int32(total),
+	}, nil
+}
+
+func (c *SyncController) SyncMessages(ctx context.Context, req *syncPb.SyncDataRequest) (*syncPb.SyncMessagesResponse, error) {
+	userID, err := callerID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+	return c.syncMessages(ctx, userID, req.GetLastUpdatedAt(), req.GetLimit(), req.GetOffset())
+}
+
+func (c *SyncController) syncMessages(ctx context.Context, userID int32, lastUpdatedAt int64, limit, offset int32) (*syncPb.SyncMessagesResponse, error) {
+	delta, err := c.syncService.SyncMessages(ctx, userID, lastUpdatedAt, limit, offset)
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	total := len(delta.Items) + len(delta.DeletedIDs)
+	return &syncPb.SyncMessagesResponse{
+		Messages:          util.ConvertMessages(delta.Items),
+		DeletedMessageIds: delta.DeletedIDs,
+		ServerTimestamp:   delta.ServerTimestamp,
+		IsSynced:          total == 0 && !delta.HasMore,
+		TotalUpdates:      int32(total),
+		HasMore:           delta.HasMore,
+	}, nil
+}
+
+func (c *SyncController) SyncServers(ctx context.Context, req *syncPb.SyncDataRequest) (*syncPb.SyncServersResponse, error) {
+	userID, err := callerID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+	return c.syncServers(ctx, userID, req.GetLastUpdatedAt(), req.GetLimit(), req.GetOffset())
+}
+
+func (c *SyncController) syncServers(ctx context.Context, userID int32, lastUpdatedAt int64, limit, offset int32) (*syncPb.SyncServersResponse, error) {
+	delta, err := c.syncService.SyncServers(ctx, userID, lastUpdatedAt, limit, offset)
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	total := len(delta.Items) + len(delta.DeletedIDs)
+	return &syncPb.SyncServersResponse{
+		Servers:          util.ConvertServers(delta.Items),
+		DeletedServerIds: delta.DeletedIDs,
+		ServerTimestamp:  delta.ServerTimestamp,
+		IsSynced:         total == 0 && !delta.HasMore,
+		TotalUpdates:     int32(total),
+	}, nil
+}
+
+func (c *SyncController) SyncChannels(ctx context.Context, req *syncPb.SyncDataRequest) (*syncPb.SyncChannelsResponse, error) {
+	userID, err := callerID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+	return c.syncChannels(ctx, userID, req.GetLastUpdatedAt(), req.GetLimit(), req.GetOffset())
+}
+
+func (c *SyncController) syncChannels(ctx context.Context, userID int32, lastUpdatedAt int64, limit, offset int32) (*syncPb.SyncChannelsResponse, error) {
+	delta, err := c.syncService.SyncChannels(ctx, userID, lastUpdatedAt, limit, offset)
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	total := len(delta.Items) + len(delta.DeletedIDs)
+	return &syncPb.SyncChannelsResponse{
+		Channels:          util.ConvertChannels(delta.Items),
+		DeletedChannelIds: delta.DeletedIDs,
+		ServerTimestamp:   delta.ServerTimestamp,
+		IsSynced:          total == 0 && !delta.HasMore,
+		TotalUpdates:      int32(total),
+	}, nil
+}
+
+func (c *SyncController) SyncUserProfile(ctx context.Context, req *syncPb.SyncDataRequest) (*syncPb.SyncUserProfileResponse, error) {
+	userID, err := callerID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+	return c.syncUserProfile(ctx, userID, req.GetLastUpdatedAt())
+}
+
+func (c *SyncController) syncUserProfile(ctx context.Context, userID int32, lastUpdatedAt int64) (*syncPb.SyncUserProfileResponse, error) {
+	delta, err := c.syncService.SyncUserProfile(ctx, userID, lastUpdatedAt)
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	resp := &syncPb.SyncUserProfileResponse{
+		ServerTimestamp: delta.ServerTimestamp,
+		IsSynced:        delta.User == nil,
+	}
+	if delta.User != nil {
+		resp.User = util.ConvertUser(*delta.User)
+		resp.LastProfileUpdate = delta.User.UpdatedAt.Time.UnixMilli()
+	}
+	return resp, nil
+}
+
+func (c *SyncController) SyncVoiceChannels(ctx context.Context, req *syncPb.SyncDataRequest) (*syncPb.SyncVoiceChannelsResponse, error) {
+	userID, err := callerID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+	return c.syncVoiceChannels(ctx, userID, req.GetLastUpdatedAt(), req.GetLimit(), req.GetOffset())
+}
+
+func (c *SyncController) syncVoiceChannels(ctx context.Context, userID int32, lastUpdatedAt int64, limit, offset int32) (*syncPb.SyncVoiceChannelsResponse, error) {
+	delta, err := c.syncService.SyncChannelsByType(ctx, userID, "voice", lastUpdatedAt, limit, offset)
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	total := len(delta.Items) + len(delta.DeletedIDs)
+	return &syncPb.SyncVoiceChannelsResponse{
+		VoiceChannels:          util.ConvertVoiceChannels(delta.Items),
+		DeletedVoiceChannelIds: delta.DeletedIDs,
+		ServerTimestamp:        delta.ServerTimestamp,
+		IsSynced:               total == 0 && !delta.HasMore,
+		TotalUpdates:           int32(total),
+	}, nil
+}
+
+func (c *SyncController) SyncTextChannels(ctx context.Context, req *syncPb.SyncDataRequest) (*syncPb.SyncTextChannelsResponse, error) {
+	userID, err := callerID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+	return c.syncTextChannels(ctx, userID, req.GetLastUpdatedAt(), req.GetLimit(), req.GetOffset())
+}
+
+func (c *SyncController) syncTextChannels(ctx context.Context, userID int32, lastUpdatedAt int64, limit, offset int32) (*syncPb.SyncTextChannelsResponse, error) {
+	delta, err := c.syncService.SyncChannelsByType(ctx, userID, "text", lastUpdatedAt, limit, offset)
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	total := len(delta.Items) + len(delta.DeletedIDs)
+	return &syncPb.SyncTextChannelsResponse{
+		TextChannels:          util.ConvertTextChannels(delta.Items),
+		DeletedTextChannelIds: delta.DeletedIDs,
+		ServerTimestamp:       delta.ServerTimestamp,
+		IsSynced:              total == 0 && !delta.HasMore,
+		TotalUpdates:          int32(total),
+	}, nil
+}
+
+func (c *SyncController) SyncDirectMessages(ctx context.Context, req *syncPb.SyncDataRequest) (*syncPb.SyncDirectMessagesResponse, error) {
+	userID, err := callerID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+	return c.syncDirectMessages(ctx, userID, req.GetLastUpdatedAt(), req.GetLimit(), req.GetOffset())
+}
+
+func (c *SyncController) syncDirectMessages(ctx context.Context, userID int32, lastUpdatedAt int64, limit, offset int32) (*syncPb.SyncDirectMessagesResponse, error) {
+	delta, err := c.syncService.SyncDirectMessages(ctx, userID, lastUpdatedAt, limit, offset)
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	total := len(delta.Items) + len(delta.DeletedIDs)
+	return &syncPb.SyncDirectMessagesResponse{
+		DirectMessages:  util.ConvertDirectMessages(delta.Items),
+		DeletedDmIds:    delta.DeletedIDs,
+		ServerTimestamp: delta.ServerTimestamp,
+		IsSynced:        total == 0 && !delta.HasMore,
+		TotalUpdates:    int32(total),
+		HasMore:         delta.HasMore,
+	}, nil
+}
+
+func (c *SyncController) SyncPermissions(ctx context.Context, req *syncPb.SyncDataRequest) (*syncPb.SyncPermissionsResponse, error) {
+	userID, err := callerID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+	return c.syncPermissions(ctx, userID, req.GetLastUpdatedAt(), req.GetLimit(), req.GetOffset())
+}
+
+func (c *SyncController) syncPermissions(ctx context.Context, userID int32, lastUpdatedAt int64, limit, offset int32) (*syncPb.SyncPermissionsResponse, error) {
+	delta, err := c.syncService.SyncRoles(ctx, userID, lastUpdatedAt, limit, offset)
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	total := len(delta.Items) + len(delta.DeletedIDs)
+	return &syncPb.SyncPermissionsResponse{
+		Permissions:          util.ConvertRolesToPermissions(delta.Items),
+		DeletedPermissionIds: delta.DeletedIDs,
+		ServerTimestamp:      delta.ServerTimestamp,
+		IsSynced:             total == 0 && !delta.HasMore,
+		TotalUpdates:         int32(total),
+	}, nil
+}
+
+// SyncAll returns the first page of every entity using per-entity timestamps
+func (c *SyncController) SyncAll(ctx context.Context, req *syncPb.SyncAllRequest) (*syncPb.SyncAllResponse, error) {
+	userID, err := callerID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	resp := &syncPb.SyncAllResponse{}
+	if resp.Friends, err = c.syncFriends(ctx, userID, req.GetFriendsLastUpdatedAt(), 0, 0); err != nil {
+		return nil, err
+	}
+	if resp.Messages, err = c.syncMessages(ctx, userID, req.GetMessagesLastUpdatedAt(), 0, 0); err != nil {
+		return nil, err
+	}
+	if resp.Servers, err = c.syncServers(ctx, userID, req.GetServersLastUpdatedAt(), 0, 0); err != nil {
+		return nil, err
+	}
+	if resp.Channels, err = c.syncChannels(ctx, userID, req.GetChannelsLastUpdatedAt(), 0, 0); err != nil {
+		return nil, err
+	}
+	if resp.UserProfile, err = c.syncUserProfile(ctx, userID, req.GetUserProfileLastUpdatedAt()); err != nil {
+		return nil, err
+	}
+	if resp.VoiceChannels, err = c.syncVoiceChannels(ctx, userID, req.GetVoiceChannelsLastUpdatedAt(), 0, 0); err != nil {
+		return nil, err
+	}
+	if resp.TextChannels, err = c.syncTextChannels(ctx, userID, req.GetTextChannelsLastUpdatedAt(), 0, 0); err != nil {
+		return nil, err
+	}
+	if resp.DirectMessages, err = c.syncDirectMessages(ctx, userID, req.GetDirectMessagesLastUpdatedAt(), 0, 0); err != nil {
+		return nil, err
+	}
+	if resp.Permissions, err = c.syncPermissions(ctx, userID, req.GetPermissionsLastUpdatedAt(), 0, 0); err != nil {
+		return nil, err
+	}
+
+	// The oldest per-entity timestamp is safe to use for every entity next time
+	resp.ServerTimestamp = resp.Friends.ServerTimestamp
+	resp.TotalUpdates = resp.Friends.TotalUpdates + resp.Messages.TotalUpdates + resp.Servers.TotalUpdates +
+		resp.Channels.TotalUpdates + resp.VoiceChannels.TotalUpdates + resp.TextChannels.TotalUpdates +
+		resp.DirectMessages.TotalUpdates + resp.Permissions.TotalUpdates
+	if !resp.UserProfile.IsSynced {
+		resp.TotalUpdates++
+	}
+	resp.IsFullySynced = resp.Friends.IsSynced && resp.Messages.IsSynced && resp.Servers.IsSynced &&
+		resp.Channels.IsSynced && resp.UserProfile.IsSynced && resp.VoiceChannels.IsSynced &&
+		resp.TextChannels.IsSynced && resp.DirectMessages.IsSynced && resp.Permissions.IsSynced
+
+	return resp, nil
+}
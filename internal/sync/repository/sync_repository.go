@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"discord/gen/repo"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SyncRepository struct {
+	db      *pgxpool.Pool
+	queries *repo.Queries
+}
+
+func NewSyncRepository(db *pgxpool.Pool) *SyncRepository {
+	return &SyncRepository{
+		db:      db,
+		queries: repo.New(db),
+	}
+}
+
+func toTimestamp(t time.Time) pgtype.Timestamp {
+	return pgtype.Timestamp{Time: t.UTC(), Valid: true}
+}
+
+// GetFriends retrieves friendships changed since the given time
+func (r *SyncRepository) GetFriends(ctx context.Context, userID int32, since time.Time, limit, offset int32) ([]repo.Friend, error) {
+	return r.queries.SyncFriends(ctx, repo.SyncFriendsParams{
+		UserID:    userID,
+		UpdatedAt: toTimestamp(since),
+		Limit:     limit,
+		Offset:    offset,
+	})
+}
+
+// GetPendingFriendRequests retrieves pending friend requests changed since the given time
+func (r *SyncRepository) GetPendingFriendRequests(ctx context.Context, userID int32, since time.Time) ([]repo.Friend, error) {
+	return r.queries.SyncPendingFriendRequests(ctx, repo.SyncPendingFriendRequestsParams{
+		UserID:    userID,
+		UpdatedAt: toTimestamp(since),
+	})
+}
+
+// GetServers retrieves servers changed since the given time
+func (r *SyncRepository) GetServers(ctx context.Context, userID int32, since time.Time, limit, offset int32) ([]repo.Server, error) {
+	return r.queries.SyncServers(ctx, repo.SyncServersParams{
+		UserID:    userID,
+		UpdatedAt: toTimestamp(since),
+		Limit:     limit,
+		Offset:    offset,
+	})
+}
+
+// GetChannels retrieves channels changed since the given time
+func (r *SyncRepository) GetChannels(ctx context.Context, userID int32, since time.Time, limit, offset int32) ([]repo.Channel, error) {
+	return r.queries.SyncChannels(ctx, repo.SyncChannelsParams{
+		UserID:    userID,
+		UpdatedAt: toTimestamp(since),
+		Limit:     limit,
+		Offset:    offset,
+	})
+}
+
+// GetChannelsByType retrieves channels of a type changed since the given time
+func (r *SyncRepository) GetChannelsByType(ctx context.Context, userID int32, channelType string, since time.Time, limit, offset int32) ([]repo.Channel, error) {
+	return r.queries.SyncChannelsByType(ctx, repo.SyncChannelsByTypeParams{
+		UserID:    userID,
+		Type:      channelType,
+		UpdatedAt: toTimestamp(since),
+		Limit:     limit,
+		Offset:    offset,
+	})
+}
+
+// GetChannelMessages retrieves channel messages changed since the given time
+func (r *SyncRepository) GetChannelMessages(ctx context.Context, userID int32, since time.Time, limit, offset int32) ([]repo.Message, error) {
+	return r.queries.SyncChannelMessages(ctx, repo.SyncChannelMessagesParams{
+		UserID:    userID,
+		UpdatedAt: toTimestamp(since),
+		Limit:     limit,
+		Offset:    offset,
+	})
+}
+
+// GetDirectMessages retrieves direct messages changed since the given time
+func (r *SyncRepository) GetDirectMessages(ctx context.Context, userID int32, since time.Time, limit, offset int32) ([]repo.Message, error) {
+	return r.queries.SyncDirectMessages(ctx, repo.SyncDirectMessagesParams{
+		SenderID:  userID,
+		UpdatedAt: toTimestamp(since),
+		Limit:     limit,
+		Offset:    offset,
+	})
+}
+
+// GetRoles retrieves roles changed since the given time
+func (r *SyncRepository) GetRoles(ctx context.Context, userID int32, since time.Time, limit, offset int32) ([]repo.Role, error) {
+	return r.queries.SyncRoles(ctx, repo.SyncRolesParams{
+		UserID:    userID,
+		UpdatedAt: toTimestamp(since),
+		Limit:     limit,
+		Offset:    offset,
+	})
+}
+
+// GetUserProfile retrieves the user profile if it changed since the given time
+func (r *SyncRepository) GetUserProfile(ctx context.Context, userID int32, since time.Time) (repo.User, error) {
+	return r.queries.SyncUserProfile(ctx, repo.SyncUserProfileParams{
+		ID:        userID,
+		UpdatedAt: toTimestamp(since),
+	})
+}
+
+// GetDeletedIDs retrieves ids of entities of a type deleted since the given time
+// that fall within the user's scopes
+func (r *SyncRepository) GetDeletedIDs(ctx context.Context, userID int32, entityType string, since time.Time) ([]int32, error) {
+	return r.queries.GetUserDeletedEntityIDs(ctx, repo.GetUserDeletedEntityIDsParams{
+		EntityType: entityType,
+		DeletedAt:  toTimestamp(since),
+		UserID:     userID,
+	})
+}
+
+// PruneDeletedEntities removes deletion records older than the given time
+func (r *SyncRepository) PruneDeletedEntities(ctx context.Context, before time.Time) (int64, error) {
+	return r.queries.PruneDeletedEntities(ctx, toTimestamp(before))
+}
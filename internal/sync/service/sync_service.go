@@ -0,0 +1,249 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"discord/gen/repo"
+	commonErrors "discord/internal/common/errors"
+	"discord/internal/common/util"
+	syncRepo "discord/internal/sync/repository"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	DefaultSyncLimit = 100
+	MaxSyncLimit     = 500
+
+	// DeletedEntityRetention is how long deletion records are kept for sync
+	DeletedEntityRetention = 30 * 24 * time.Hour
+)
+
+// Delta is one page of changes for an entity since the client's last sync.
+// DeletedIDs are only filled on the first page (offset 0) so that paging
+// through a large delta does not repeat them.
+type Delta[T any] struct {
+	Items           []T
+	DeletedIDs      []int32
+	HasMore         bool
+	ServerTimestamp int64
+}
+
+// FriendsDelta adds pending friend requests to the friendship delta
+type FriendsDelta struct {
+	Delta[repo.Friend]
+	PendingRequests []repo.Friend
+}
+
+// ProfileDelta holds the user profile when it changed since the last sync
+type ProfileDelta struct {
+	User            *repo.User
+	ServerTimestamp int64
+}
+
+type SyncService struct {
+	syncRepo *syncRepo.SyncRepository
+}
+
+func NewSyncService(syncRepo *syncRepo.SyncRepository) *SyncService {
+	return &SyncService{
+		syncRepo: syncRepo,
+	}
+}
+
+// SyncFriends returns friendships changed after lastUpdatedAt (unix ms)
+func (s *SyncService) SyncFriends(ctx context.Context, userID int32, lastUpdatedAt int64, limit, offset int32) (*FriendsDelta, error) {
+	now := time.Now()
+	since, err := sinceTime(lastUpdatedAt, now)
+	if err != nil {
+		return nil, err
+	}
+	limit, offset = normalizePage(limit, offset)
+
+	friends, err := s.syncRepo.GetFriends(ctx, userID, since, limit+1, offset)
+	if err != nil {
+		return nil, commonErrors.ErrInternalServer
+	}
+
+	delta := &FriendsDelta{}
+	delta.Items, delta.HasMore = page(friends, limit)
+	delta.ServerTimestamp = now.UnixMilli()
+
+	if offset == 0 {
+		delta.PendingRequests, err = s.syncRepo.GetPendingFriendRequests(ctx, userID, since)
+		if err != nil {
+			return nil, commonErrors.ErrInternalServer
+		}
+		delta.DeletedIDs, err = s.syncRepo.GetDeletedIDs(ctx, userID, util.DeletedFriend, since)
+		if err != nil {
+			return nil, commonErrors.ErrInternalServer
+		}
+	}
+
+	return delta, nil
+}
+
+// SyncMessages returns channel messages changed after lastUpdatedAt (unix ms)
+func (s *SyncService) SyncMessages(ctx context.Context, userID int32, lastUpdatedAt int64, limit, offset int32) (*Delta[repo.Message], error) {
+	return syncDelta(ctx, s, userID, util.DeletedMessage, lastUpdatedAt, limit, offset,
+		func(since time.Time, limit, offset int32) ([]repo.Message, error) {
+			return s.syncRepo.GetChannelMessages(ctx, userID, since, limit, offset)
+		},
+	)
+}
+
+// SyncDirectMessages returns direct messages changed after lastUpdatedAt (unix ms)
+func (s *SyncService) SyncDirectMessages(ctx context.Context, userID int32, lastUpdatedAt int64, limit, offset int32) (*Delta[repo.Message], error) {
+	return syncDelta(ctx, s, userID, util.DeletedDirectMessage, lastUpdatedAt, limit, offset,
+		func(since time.Time, limit, offset int32) ([]repo.Message, error) {
+			return s.syncRepo.GetDirectMessages(ctx, userID, since, limit, offset)
+		},
+	)
+}
+
+// SyncServers returns servers changed after lastUpdatedAt (unix ms)
+func (s *SyncService) SyncServers(ctx context.Context, userID int32, lastUpdatedAt int64, limit, offset int32) (*Delta[repo.Server], error) {
+	return syncDelta(ctx, s, userID, util.DeletedServer, lastUpdatedAt, limit, offset,
+		func(since time.Time, limit, offset int32) ([]repo.Server, error) {
+			return s.syncRepo.GetServers(ctx, userID, since, limit, offset)
+		},
+	)
+}
+
+// SyncChannels returns channels of every type changed after lastUpdatedAt (unix ms)
+func (s *SyncService) SyncChannels(ctx context.Context, userID int32, lastUpdatedAt int64, limit, offset int32) (*Delta[repo.Channel], error) {
+	return syncDelta(ctx, s, userID, util.DeletedChannel, lastUpdatedAt, limit, offset,
+		func(since time.Time, limit, offset int32) ([]repo.Channel, error) {
+			return s.syncRepo.GetChannels(ctx, userID, since, limit, offset)
+		},
+	)
+}
+
+// SyncChannelsByType returns channels of one type changed after lastUpdatedAt (unix ms)
+func (s *SyncService) SyncChannelsByType(ctx context.Context, userID int32, channelType string, lastUpdatedAt int64, limit, offset int32) (*Delta[repo.Channel], error) {
+	return syncDelta(ctx, s, userID, util.DeletedChannel, lastUpdatedAt, limit, offset,
+		func(since time.Time, limit, offset int32) ([]repo.Channel, error) {
+			return s.syncRepo.GetChannelsByType(ctx, userID, channelType, since, limit, offset)
+		},
+	)
+}
+
+// SyncRoles returns roles changed after lastUpdatedAt (unix ms)
+func (s *SyncService) SyncRoles(ctx context.Context, userID int32, lastUpdatedAt int64, limit, offset int32) (*Delta[repo.Role], error) {
+	return syncDelta(ctx, s, userID, util.DeletedRole, lastUpdatedAt, limit, offset,
+		func(since time.Time, limit, offset int32) ([]repo.Role, error) {
+			return s.syncRepo.GetRoles(ctx, userID, since, limit, offset)
+		},
+	)
+}
+
+// SyncUserProfile returns the user profile if it changed after lastUpdatedAt (unix ms)
+func (s *SyncService) SyncUserProfile(ctx context.Context, userID int32, lastUpdatedAt int64) (*ProfileDelta, error) {
+	now := time.Now()
+	since := time.Unix(0, 0)
+	if lastUpdatedAt > 0 {
+		since = time.UnixMilli(lastUpdatedAt)
+	}
+	user, err := s.syncRepo.GetUserProfile(ctx, userID, since)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return &ProfileDelta{ServerTimestamp: now.UnixMilli()}, nil
+	}
+	if err != nil {
+		return nil, commonErrors.ErrInternalServer
+	}
+
+	return &ProfileDelta{User: &user, ServerTimestamp: now.UnixMilli()}, nil
+}
+
+// syncDelta fetches one page plus deleted ids. The server timestamp is taken
+// before querying so that rows written during the sync are picked up next time.
+func syncDelta[T any](
+	ctx context.Context,
+	s *SyncService,
+	userID int32,
+	entityType string,
+	lastUpdatedAt int64,
+	limit, offset int32,
+	fetch func(since time.Time, limit, offset int32) ([]T, error),
+) (*Delta[T], error) {
+	now := time.Now()
+	since, err := sinceTime(lastUpdatedAt, now)
+	if err != nil {
+		return nil, err
+	}
+	limit, offset = normalizePage(limit, offset)
+
+	items, err := fetch(since, limit+1, offset)
+	if err != nil {
+		return nil, commonErrors.ErrInternalServer
+	}
+
+	delta := &Delta[T]{ServerTimestamp: now.UnixMilli()}
+	delta.Items, delta.HasMore = page(items, limit)
+
+	if offset == 0 {
+		delta.DeletedIDs, err = s.syncRepo.GetDeletedIDs(ctx, userID, entityType, since)
+		if err != nil {
+			return nil, commonErrors.ErrInternalServer
+		}
+	}
+
+	return delta, nil
+}
+
+// sinceTime converts a client timestamp in unix milliseconds; zero means full
+// sync. Timestamps older than the deletion log retention cannot be answered
+// with a correct delta, so the client has to resync from scratch.
+func sinceTime(lastUpdatedAt int64, now time.Time) (time.Time, error) {
+	if lastUpdatedAt <= 0 {
+		return time.Unix(0, 0), nil
+	}
+	since := time.UnixMilli(lastUpdatedAt)
+	if since.Before(now.Add(-DeletedEntityRetention)) {
+		return time.Time{}, commonErrors.ErrSyncExpired
+	}
+	return since, nil
+}
+
+func normalizePage(limit, offset int32) (int32, int32) {
+	if limit <= 0 {
+		limit = DefaultSyncLimit
+	}
+	if limit > MaxSyncLimit {
+		limit = MaxSyncLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
+
+// page trims the extra row fetched to detect whether more pages exist
+func page[T any](items []T, limit int32) ([]T, bool) {
+	if int32(len(items)) > limit {
+		return items[:limit], true
+	}
+	return items, false
+}
+
+// PruneDeletedEntities drops deletion records past the retention window
+func (s *SyncService) PruneDeletedEntities(ctx context.Context) (int64, error) {
+	return s.syncRepo.PruneDeletedEntities(ctx, time.Now().Add(-DeletedEntityRetention))
+}
+
+// StartDeletedEntityPruner prunes the deletion log every interval until ctx is done
+func (s *SyncService) StartDeletedEntityPruner(ctx context.Context, interval time.Duration) {
+	go util.RunPeriodic(ctx, interval, func(ctx context.Context) {
+		pruned, err := s.PruneDeletedEntities(ctx)
+		if err != nil {
+			log.Printf("failed to prune deleted entities: %v", err)
+			return
+		}
+		if pruned > 0 {
+			log.Printf("pruned %d deleted entity records", pruned)
+		}
+	})
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"discord/config"
+	"discord/gen/repo"
+	syncRepo "discord/internal/sync/repository"
+)
+
+// newTestSync connects to the configured database, skipping the test when
+// there is none
+func newTestSync(t *testing.T) (*SyncService, *repo.Queries) {
+	t.Helper()
+	if err := config.InitDB(); err != nil {
+		t.Skipf("no database: %v", err)
+	}
+	return NewSyncService(syncRepo.NewSyncRepository(config.DB)), repo.New(config.DB)
+}
+
+func createTestUser(t *testing.T, ctx context.Context, q *repo.Queries, name string) int32 {
+	t.Helper()
+	q.DeleteByUsername(ctx, name)
+	user, err := q.CreateUser(ctx, repo.CreateUserParams{Username: name, Email: name + "@example.com", Password: name})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { q.DeleteUserById(context.Background(), user.ID) })
+	return user.ID
+}
+
+// syncedFriendships returns the ids of the friendships and of the pending
+// requests a full sync of the user returns
+func syncedFriendships(t *testing.T, ctx context.Context, s *SyncService, userID int32) (friends, pending []int32) {
+	t.Helper()
+	delta, err := s.SyncFriends(ctx, userID, 0, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range delta.Items {
+		friends = append(friends, f.ID)
+	}
+	for _, f := range delta.PendingRequests {
+		pending = append(pending, f.ID)
+	}
+	return friends, pending
+}
+
+func contains(ids []int32, id int32) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+func TestSyncFriendsBothSides(t *testing.T) {
+	ctx := context.Background()
+	s, q := newTestSync(t)
+	sender := createTestUser(t, ctx, q, "sync_sender")
+	receiver := createTestUser(t, ctx, q, "sync_receiver")
+
+	request, err := q.CreateFriend(ctx, repo.CreateFriendParams{UserID: sender, FriendID: receiver})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		q.HardDeleteFriendship(context.Background(), repo.HardDeleteFriendshipParams{UserID: sender, FriendID: receiver})
+	})
+
+	// The request is outgoing for the sender and incoming for the receiver
+	for _, userID := range []int32{sender, receiver} {
+		friends, pending := syncedFriendships(t, ctx, s, userID)
+		if !contains(pending, request.ID) || contains(friends, request.ID) {
+			t.Errorf("user %d: expected request %d pending only, got friends %v and pending %v", userID, request.ID, friends, pending)
+		}
+	}
+
+	if _, err := q.AcceptFriendRequest(ctx, repo.AcceptFriendRequestParams{UserID: sender, FriendID: receiver}); err != nil {
+		t.Fatal(err)
+	}
+	for _, userID := range []int32{sender, receiver} {
+		friends, pending := syncedFriendships(t, ctx, s, userID)
+		if !contains(friends, request.ID) || contains(pending, request.ID) {
+			t.Errorf("user %d: expected friendship %d, got friends %v and pending %v", userID, request.ID, friends, pending)
+		}
+	}
+
+	if _, err := q.BlockUser(ctx, repo.BlockUserParams{UserID: sender, FriendID: receiver}); err != nil {
+		t.Fatal(err)
+	}
+	for _, userID := range []int32{sender, receiver} {
+		if friends, _ := syncedFriendships(t, ctx, s, userID); contains(friends, request.ID) {
+			t.Errorf("user %d: expected blocked friendship %d to be left out, got %v", userID, request.ID, friends)
+		}
+	}
+}
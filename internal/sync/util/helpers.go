@@ -0,0 +1,171 @@
+package util
+
+import (
+	"strings"
+
+	"discord/gen/proto/schema"
+	"discord/gen/repo"
+	friendUtil "discord/internal/friend/util"
+	messageUtil "discord/internal/message/util"
+)
+
+// ConvertFriends converts repo friends to proto friends
+func ConvertFriends(friends []repo.Friend) []*schema.Friend {
+	pbFriends := make([]*schema.Friend, len(friends))
+	for i, friend := range friends {
+		pbFriends[i] = friendUtil.ConvertFriendToProto(friend)
+	}
+	return pbFriends
+}
+
+// ConvertMessages converts repo messages to proto messages
+func ConvertMessages(messages []repo.Message) []*schema.Message {
+	pbMessages := make([]*schema.Message, len(messages))
+	for i, message := range messages {
+		pbMessages[i] = messageUtil.ConvertMessageToProto(message)
+		pbMessages[i].IsChannel = message.Ischannel.Bool
+		pbMessages[i].ReceiverId = message.ReceiverID.Int32
+	}
+	return pbMessages
+}
+
+// ConvertDirectMessages converts repo messages to proto direct messages
+func ConvertDirectMessages(messages []repo.Message) []*schema.DirectMessage {
+	pbMessages := make([]*schema.DirectMessage, len(messages))
+	for i, message := range messages {
+		pbMessages[i] = &schema.DirectMessage{
+			Id:               message.ID,
+			SenderId:         message.SenderID,
+			RecipientId:      message.ReceiverID.Int32,
+			Content:          message.Content,
+			IsEdited:         message.IsEdited.Bool,
+			ReplyToMessageId: message.ReplyToMessageID.Int32,
+			CreatedAt:        message.CreatedAt.Time.Unix(),
+			UpdatedAt:        message.UpdatedAt.Time.Unix(),
+		}
+	}
+	return pbMessages
+}
+
+// ConvertServers converts repo servers to proto servers
+func ConvertServers(servers []repo.Server) []*schema.Server {
+	pbServers := make([]*schema.Server, len(servers))
+	for i, server := range servers {
+		pbServers[i] = &schema.Server{
+			Id:          server.ID,
+			Name:        server.Name,
+			Icon:        server.Icon.String,
+			Banner:      server.Banner.String,
+			Description: server.Description.String,
+			OwnerId:     server.OwnerID,
+			Region:      server.Region.String,
+			MemberCount: server.MemberCount.Int32,
+			IsVerified:  server.IsVerified.Bool,
+			CreatedAt:   server.CreatedAt.Time.Unix(),
+			UpdatedAt:   server.UpdatedAt.Time.Unix(),
+			IsDeleted:   server.IsDeleted.Bool,
+			VanityUrl:   server.VanityUrl.String,
+		}
+	}
+	return pbServers
+}
+
+// ConvertChannel converts a repo channel to a proto channel
+func ConvertChannel(channel repo.Channel) *schema.Channel {
+	return &schema.Channel{
+		Id:            channel.ID,
+		Name:          channel.Name,
+		Position:      channel.Position.Int32,
+		ServerId:      channel.ServerID,
+		CategoryId:    channel.CategoryID.Int32,
+		Type:          schema.ChannelType(schema.ChannelType_value[strings.ToUpper(channel.Type)]),
+		IsNsfw:        channel.IsNsfw.Bool,
+		SlowmodeDelay: channel.SlowmodeDelay.Int32,
+		Topic:         channel.Topic.String,
+		CreatedAt:     channel.CreatedAt.Time.Unix(),
+		UpdatedAt:     channel.UpdatedAt.Time.Unix(),
+		IsDeleted:     channel.IsDeleted.Bool,
+	}
+}
+
+// ConvertChannels converts repo channels to proto channels
+func ConvertChannels(channels []repo.Channel) []*schema.Channel {
+	pbChannels := make([]*schema.Channel, len(channels))
+	for i, channel := range channels {
+		pbChannels[i] = ConvertChannel(channel)
+	}
+	return pbChannels
+}
+
+// ConvertTextChannels converts text channels to proto text channels
+func ConvertTextChannels(channels []repo.Channel) []*schema.TextChannel {
+	pbChannels := make([]*schema.TextChannel, len(channels))
+	for i, channel := range channels {
+		pbChannels[i] = &schema.TextChannel{
+			Id:            channel.ID,
+			ChannelId:     channel.ID,
+			ServerId:      channel.ServerID,
+			Topic:         channel.Topic.String,
+			IsNsfw:        channel.IsNsfw.Bool,
+			SlowmodeDelay: channel.SlowmodeDelay.Int32,
+			CreatedAt:     channel.CreatedAt.Time.Unix(),
+			UpdatedAt:     channel.UpdatedAt.Time.Unix(),
+		}
+	}
+	return pbChannels
+}
+
+// ConvertVoiceChannels converts voice channels to proto voice channels
+func ConvertVoiceChannels(channels []repo.Channel) []*schema.VoiceChannel {
+	pbChannels := make([]*schema.VoiceChannel, len(channels))
+	for i, channel := range channels {
+		pbChannels[i] = &schema.VoiceChannel{
+			Id:        channel.ID,
+			ChannelId: channel.ID,
+			ServerId:  channel.ServerID,
+			UserLimit: channel.UserLimit.Int32,
+			Bitrate:   channel.Bitrate.Int32 / 1000,
+			CreatedAt: channel.CreatedAt.Time.Unix(),
+			UpdatedAt: channel.UpdatedAt.Time.Unix(),
+		}
+	}
+	return pbChannels
+}
+
+// ConvertRolesToPermissions converts server roles to proto permissions
+func ConvertRolesToPermissions(roles []repo.Role) []*schema.Permission {
+	pbPermissions := make([]*schema.Permission, len(roles))
+	for i, role := range roles {
+		pbPermissions[i] = &schema.Permission{
+			Id:          role.ID,
+			Name:        role.Name,
+			Description: role.Description.String,
+			Permissions: role.Permissions.Int64,
+			CreatedAt:   role.CreatedAt.Time.Unix(),
+			UpdatedAt:   role.UpdatedAt.Time.Unix(),
+		}
+	}
+	return pbPermissions
+}
+
+// ConvertUser converts a repo user to a proto user
+func ConvertUser(user repo.User) *schema.User {
+	return &schema.User{
+		Id:              user.ID,
+		Username:        user.Username,
+		Email:           user.Email,
+		FullName:        user.FullName.String,
+		ProfilePic:      user.ProfilePic.String,
+		Bio:             user.Bio.String,
+		ColorCode:       user.ColorCode.String,
+		BackgroundColor: user.BackgroundColor.String,
+		BackgroundPic:   user.BackgroundPic.String,
+		Status:          user.Status,
+		CustomStatus:    user.CustomStatus.String,
+		IsBot:           user.IsBot.Bool,
+		IsVerified:      user.IsVerified.Bool,
+		IsDeleted:       user.IsDeleted.Bool,
+		CreatedAt:       user.CreatedAt.Time.Unix(),
+		UpdatedAt:       user.UpdatedAt.Time.Unix(),
+	}
+}
@@ -0,0 +1,80 @@
+package controller
+
+import (
+	"context"
+
+	textChannelPb "discord/gen/proto/service/text_channel"
+	commonErrors "discord/internal/common/errors"
+	textChannelService "discord/internal/textchannel/service"
+)
+
+type TextChannelController struct {
+	textChannelPb.UnimplementedTextChannelServiceServer
+	textChannelService *textChannelService.TextChannelService
+}
+
+func NewTextChannelController(textChannelService *textChannelService.TextChannelService) *textChannelPb.TextChannelServiceServer {
+	controller := &TextChannelController{
+		textChannelService: textChannelService,
+	}
+	var grpcController textChannelPb.TextChannelServiceServer = controller
+	return &grpcController
+}
+
+// CreateTextGroup creates a group (category) for text channels
+func (c *TextChannelController) CreateTextGroup(ctx context.Context, req *textChannelPb.CreateTextGroupRequest) (*textChannelPb.CreateTextGroupResponse, error) {
+	userID := ctx.Value("user_id").(int32)
+
+	group, err := c.textChannelService.CreateTextGroup(
+		ctx,
+		req.GetServerId(),
+		userID,
+		req.GetName(),
+		req.GetTopic(),
+		req.GetPosition(),
+	)
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	return &textChannelPb.CreateTextGroupResponse{
+		TextGroup: group,
+		Success:   true,
+	}, nil
+}
+
+// CreateTextChannel creates a text channel inside a group
+func (c *TextChannelController) CreateTextChannel(ctx context.Context, req *textChannelPb.CreateTextChannelRequest) (*textChannelPb.CreateTextChannelResponse, error) {
+	userID := ctx.Value("user_id").(int32)
+
+	channel, err := c.textChannelService.CreateTextChannel(
+		ctx,
+		req.GetGroupId(),
+		userID,
+		req.GetName(),
+		req.GetTopic(),
+		req.GetIsNsfw(),
+		req.GetSlowmodeDelay(),
+	)
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	return &textChannelPb.CreateTextChannelResponse{
+		TextChannel: channel,
+		Success:     true,
+	}, nil
+}
+
+// ArchiveTextChannel archives a text channel
+func (c *TextChannelController) ArchiveTextChannel(ctx context.Context, req *textChannelPb.ArchiveTextChannelRequest) (*textChannelPb.ArchiveTextChannelResponse, error) {
+	userID := ctx.Value("user_id").(int32)
+
+	if err := c.textChannelService.ArchiveTextChannel(ctx, req.GetTextChannelId(), userID); err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	return &textChannelPb.ArchiveTextChannelResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"discord/gen/repo"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type TextChannelRepository struct {
+	queries *repo.Queries
+	db      *pgxpool.Pool
+}
+
+func NewTextChannelRepository(db *pgxpool.Pool) *TextChannelRepository {
+	return &TextChannelRepository{
+		queries: repo.New(db),
+		db:      db,
+	}
+}
+
+// CreateChannel creates a new channel
+func (r *TextChannelRepository) CreateChannel(ctx context.Context, params repo.CreateChannelParams) (*repo.Channel, error) {
+	channel, err := r.queries.CreateChannel(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return &channel, nil
+}
+
+// GetChannelByID retrieves channel by ID
+func (r *TextChannelRepository) GetChannelByID(ctx context.Context, id int32) (*repo.Channel, error) {
+	channel, err := r.queries.GetChannelByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &channel, nil
+}
+
+// GetChannelsByCategory retrieves all channels in a category
+func (r *TextChannelRepository) GetChannelsByCategory(ctx context.Context, categoryID int32) ([]repo.Channel, error) {
+	return r.queries.GetChannelsByCategory(ctx, pgtype.Int4{Int32: categoryID, Valid: true})
+}
+
+// ArchiveChannel marks a channel as archived
+func (r *TextChannelRepository) ArchiveChannel(ctx context.Context, id int32) (*repo.Channel, error) {
+	channel, err := r.queries.ArchiveChannel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &channel, nil
+}
@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	"discord/gen/proto/schema"
+	"discord/gen/repo"
+	auditService "discord/internal/audit/service"
+	auditUtil "discord/internal/audit/util"
+	channelUtil "discord/internal/channel/util"
+	commonErrors "discord/internal/common/errors"
+	gatewayUtil "discord/internal/gateway/util"
+	permissionService "discord/internal/permission/service"
+	textChannelRepo "discord/internal/textchannel/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	channelTypeText     = "text"
+	channelTypeCategory = "category"
+)
+
+type TextChannelService struct {
+	textChannelRepo *textChannelRepo.TextChannelRepository
+	permissions     *permissionService.Resolver
+	audit           *auditService.AuditService
+}
+
+func NewTextChannelService(textChannelRepo *textChannelRepo.TextChannelRepository, permissions *permissionService.Resolver, audit *auditService.AuditService) *TextChannelService {
+	return &TextChannelService{
+		textChannelRepo: textChannelRepo,
+		permissions:     permissions,
+		audit:           audit,
+	}
+}
+
+// CreateTextGroup creates a group of text channels, stored as a category channel
+func (s *TextChannelService) CreateTextGroup(ctx context.Context, serverID, userID int32, name, topic string, position int32) (*schema.TextGroup, error) {
+	if serverID == 0 || name == "" {
+		return nil, commonErrors.ErrInvalidInput
+	}
+
+	if err := s.permissions.RequireServer(ctx, serverID, userID, channelUtil.PermissionManageChannels); err != nil {
+		return nil, err
+	}
+
+	params := repo.CreateChannelParams{
+		ServerID: serverID,
+		Name:     name,
+		Type:     channelTypeCategory,
+		Position: pgtype.Int4{Int32: position, Valid: true},
+	}
+	if topic != "" {
+		params.Topic = pgtype.Text{String: topic, Valid: true}
+	}
+
+	group, err := s.textChannelRepo.CreateChannel(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	publishChannel(*group, gatewayUtil.ChannelCreate)
+
+	s.audit.Record(ctx, auditService.Entry{
+		ServerID:   serverID,
+		UserID:     userID,
+		Action:     auditUtil.ChannelCreate,
+		TargetID:   group.ID,
+		TargetType: auditUtil.TargetChannel,
+		After:      group,
+	})
+
+	return s.toProtoTextGroup(group), nil
+}
+
+// CreateTextChannel creates a text channel inside a group, placed after its existing channels
+func (s *TextChannelService) CreateTextChannel(ctx context.Context, groupID, userID int32, name, topic string, isNSFW bool, slowmodeDelay int32) (*schema.TextChannel, error) {
+	if name == "" || slowmodeDelay < 0 {
+		return nil, commonErrors.ErrInvalidInput
+	}
+
+	group, err := s.textChannelRepo.GetChannelByID(ctx, groupID)
+	if err != nil {
+		return nil, commonErrors.ErrNotFound
+	}
+	if group.Type != channelTypeCategory {
+		return nil, commonErrors.ErrInvalidInput
+	}
+
+	if err := s.permissions.RequireChannel(ctx, groupID, userID, channelUtil.PermissionManageChannels); err != nil {
+		return nil, err
+	}
+
+	siblings, err := s.textChannelRepo.GetChannelsByCategory(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	params := repo.CreateChannelParams{
+		ServerID:      group.ServerID,
+		CategoryID:    pgtype.Int4{Int32: groupID, Valid: true},
+		Name:          name,
+		Type:          channelTypeText,
+		Position:      pgtype.Int4{Int32: int32(len(siblings)), Valid: true},
+		IsNsfw:        pgtype.Bool{Bool: isNSFW, Valid: true},
+		SlowmodeDelay: pgtype.Int4{Int32: slowmodeDelay, Valid: true},
+	}
+	if topic != "" {
+		params.Topic = pgtype.Text{String: topic, Valid: true}
+	}
+
+	channel, err := s.textChannelRepo.CreateChannel(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	publishChannel(*channel, gatewayUtil.ChannelCreate)
+
+	s.audit.Record(ctx, auditService.Entry{
+		ServerID:   channel.ServerID,
+		UserID:     userID,
+		Action:     auditUtil.ChannelCreate,
+		TargetID:   channel.ID,
+		TargetType: auditUtil.TargetChannel,
+		After:      channel,
+	})
+
+	return s.toProtoTextChannel(channel), nil
+}
+
+// ArchiveTextChannel archives a text channel. Archiving twice is a no-op.
+func (s *TextChannelService) ArchiveTextChannel(ctx context.Context, channelID, userID int32) error {
+	channel, err := s.textChannelRepo.GetChannelByID(ctx, channelID)
+	if err != nil {
+		return commonErrors.ErrNotFound
+	}
+	if channel.Type != channelTypeText {
+		return commonErrors.ErrInvalidInput
+	}
+
+	if err := s.permissions.RequireChannel(ctx, channelID, userID, channelUtil.PermissionManageChannels); err != nil {
+		return err
+	}
+	if channel.IsArchived.Bool {
+		return nil
+	}
+
+	archived, err := s.textChannelRepo.ArchiveChannel(ctx, channelID)
+	if err != nil {
+		return err
+	}
+	publishChannel(*archived, gatewayUtil.ChannelUpdate)
+
+	s.audit.Record(ctx, auditService.Entry{
+		ServerID:   channel.ServerID,
+		UserID:     userID,
+		Action:     auditUtil.ChannelUpdate,
+		TargetID:   channelID,
+		TargetType: auditUtil.TargetChannel,
+		Before:     map[string]pgtype.Bool{"is_archived": channel.IsArchived},
+		After:      map[string]pgtype.Bool{"is_archived": archived.IsArchived},
+	})
+	return nil
+}
+
+// Helper functions
+
+func (s *TextChannelService) toProtoTextGroup(channel *repo.Channel) *schema.TextGroup {
+	return &schema.TextGroup{
+		Id:        channel.ID,
+		ChannelId: strconv.Itoa(int(channel.ID)),
+		Name:      channel.Name,
+		Topic:     channel.Topic.String,
+		Position:  channel.Position.Int32,
+		CreatedAt: channel.CreatedAt.Time.Unix(),
+		UpdatedAt: channel.UpdatedAt.Time.Unix(),
+	}
+}
+
+func (s *TextChannelService) toProtoTextChannel(channel *repo.Channel) *schema.TextChannel {
+	textChannel := &schema.TextChannel{
+		Id:            channel.ID,
+		ChannelId:     channel.ID,
+		ServerId:      channel.ServerID,
+		Topic:         channel.Topic.String,
+		IsNsfw:        channel.IsNsfw.Bool,
+		SlowmodeDelay: channel.SlowmodeDelay.Int32,
+		IsArchived:    channel.IsArchived.Bool,
+		CreatedAt:     channel.CreatedAt.Time.Unix(),
+		UpdatedAt:     channel.UpdatedAt.Time.Unix(),
+	}
+	if channel.ArchivedAt.Valid {
+		textChannel.ArchivedAt = channel.ArchivedAt.Time.Unix()
+	}
+	return textChannel
+}
@@ -8,6 +8,8 @@ import (
 	userPb "discord/gen/proto/service/user"
 	commonErrors "discord/internal/common/errors"
 	userService "discord/internal/user/service"
+	userUtil "discord/internal/user/util"
+	"discord/pkg/pubsub"
 )
 
 type UserController struct {
@@ -342,38 +344,34 @@ func (c *UserController) UpdateUserSettings(ctx context.Context, req *userPb.Upd
 	// Get user ID from context
 	userID := ctx.Value("user_id").(int32)
 
-	var showActivity, allowDMs, enableNotif *bool
-	var theme, language *string
-
-	// Handle boolean fields properly
-	if req.GetShowCurrentActivity() {
-		val := req.GetShowCurrentActivity()
-		showActivity = &val
-	}
-	if req.GetAllowDms() {
-		val := req.GetAllowDms()
-		allowDMs = &val
-	}
-	if req.GetEnableNotifications() {
-		val := req.GetEnableNotifications()
-		enableNotif = &val
-	}
-	if req.GetTheme() != "" {
-		t := req.GetTheme()
-		theme = &t
-	}
-	if req.GetLanguage() != "" {
-		l := req.GetLanguage()
-		language = &l
+	update := userService.SettingsUpdate{
+		Version:                  req.GetVersion(),
+		Theme:                    req.Theme,
+		Locale:                   req.Language,
+		ShowCurrentActivity:      req.ShowCurrentActivity,
+		DMPrivacy:                req.DmPrivacy,
+		FriendRequestPrivacy:     req.FriendRequestPrivacy,
+		ExplicitContentFilter:    req.ExplicitContentFilter,
+		EnableNotifications:      req.EnableNotifications,
+		DefaultNotificationLevel: req.DefaultNotificationLevel,
+	}
+	// Older clients only know allow_dms
+	if update.DMPrivacy == nil && req.AllowDms != nil {
+		privacy := userUtil.DMPrivacyFriends
+		if req.GetAllowDms() {
+			privacy = userUtil.DMPrivacyEveryone
+		}
+		update.DMPrivacy = &privacy
 	}
 
-	err := c.userService.UpdateUserSettings(ctx, userID, showActivity, allowDMs, enableNotif, theme, language)
+	settings, err := c.userService.UpdateUserSettings(ctx, userID, update)
 	if err != nil {
 		return nil, commonErrors.ToGRPCError(err)
 	}
 
 	return &userPb.UpdateUserSettingsResponse{
-		Success: true,
+		Success:  true,
+		Settings: userUtil.ConvertSettingsToProto(settings.UserSetting, settings.ServerOverrides),
 	}, nil
 }
 
@@ -389,10 +387,34 @@ func (c *UserController) GetUserSettings(ctx context.Context, req *userPb.GetUse
 
 	return &userPb.GetUserSettingsResponse{
 		ShowCurrentActivity: settings.ShowCurrentActivity,
-		AllowDms:            settings.AllowDMs,
+		AllowDms:            settings.DmPrivacy != userUtil.DMPrivacyFriends,
 		EnableNotifications: settings.EnableNotifications,
 		Theme:               settings.Theme,
-		Language:            settings.Language,
+		Language:            settings.Locale,
+		Settings:            userUtil.ConvertSettingsToProto(settings.UserSetting, settings.ServerOverrides),
+	}, nil
+}
+
+// UpdateServerSettings updates the settings of the user for one server
+func (c *UserController) UpdateServerSettings(ctx context.Context, req *userPb.UpdateServerSettingsRequest) (*userPb.UpdateServerSettingsResponse, error) {
+	userID := ctx.Value("user_id").(int32)
+
+	if req.GetServerId() == 0 {
+		return nil, commonErrors.ToGRPCError(commonErrors.ErrInvalidInput)
+	}
+
+	settings, err := c.userService.UpdateServerSettings(ctx, userID, req.GetServerId(), userService.ServerSettingsUpdate{
+		Version:           req.GetVersion(),
+		Muted:             req.Muted,
+		NotificationLevel: req.NotificationLevel,
+		AllowDMs:          req.AllowDms,
+	})
+	if err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
+	return &userPb.UpdateServerSettingsResponse{
+		Settings: userUtil.ConvertSettingsToProto(settings.UserSetting, settings.ServerOverrides),
 	}, nil
 }
 
@@ -450,7 +472,7 @@ func (c *UserController) GetBlockedUsers(ctx context.Context, req *userPb.GetBlo
 }
 
 func (c *UserController) StreamUserUpdates(req *userPb.StreamUserUpdatesRequest, stream userPb.UserService_StreamUserUpdatesServer) error {
-	ch := userService.StreamUser(req.GetUserId())
+	ch := userService.StreamUser(req.GetUserId(), pubsub.WithPolicy(pubsub.Disconnect))
 	defer ch.Close()
 	for data := range ch.Receive() {
 		user := data.(*schema.User)
@@ -458,11 +480,14 @@ func (c *UserController) StreamUserUpdates(req *userPb.StreamUserUpdatesRequest,
 			return err
 		}
 	}
+	if ch.Err() != nil {
+		return commonErrors.ToGRPCError(commonErrors.ErrSlowConsumer)
+	}
 	return nil
 }
 
 func (c *UserController) StreamUserFriendUpdates(req *userPb.StreamUserUpdatesRequest, stream userPb.UserService_StreamUserFriendUpdatesServer) error {
-	ch := userService.StreamUserFriendUpdates(req.GetUserId())
+	ch := userService.StreamUserFriendUpdates(req.GetUserId(), pubsub.WithPolicy(pubsub.Disconnect))
 	defer ch.Close()
 	for data := range ch.Receive() {
 		user := data.(*schema.User)
@@ -470,6 +495,9 @@ func (c *UserController) StreamUserFriendUpdates(req *userPb.StreamUserUpdatesRe
 			return err
 		}
 	}
+	if ch.Err() != nil {
+		return commonErrors.ToGRPCError(commonErrors.ErrSlowConsumer)
+	}
 	return nil
 }
 
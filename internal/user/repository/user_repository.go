@@ -2,10 +2,12 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"discord/gen/repo"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -186,3 +188,78 @@ func (r *UserRepository) UpdateUserStatus(ctx context.Context, userID int32, sta
 func (r *UserRepository) GetFriends(ctx context.Context, userID int32) ([]repo.User, error) {
 	return r.q.ConnectedUser(ctx, userID)
 }
+
+// User Settings
+func (r *UserRepository) GetUserSettings(ctx context.Context, userID int32) (repo.UserSetting, error) {
+	return r.q.GetUserSettings(ctx, userID)
+}
+
+func (r *UserRepository) GetServerSettings(ctx context.Context, userID int32) ([]repo.UserServerSetting, error) {
+	return r.q.GetUserServerSettings(ctx, userID)
+}
+
+func (r *UserRepository) GetServerSetting(ctx context.Context, userID, serverID int32) (repo.UserServerSetting, error) {
+	return r.q.GetUserServerSetting(ctx, repo.GetUserServerSettingParams{
+		UserID:   userID,
+		ServerID: serverID,
+	})
+}
+
+// SaveUserSettings writes the settings if settings.Version is still the stored
+// version and returns them with the new version. It returns pgx.ErrNoRows when
+// another change was saved in between.
+func (r *UserRepository) SaveUserSettings(ctx context.Context, settings repo.UserSetting) (repo.UserSetting, error) {
+	return r.q.UpsertUserSettings(ctx, repo.UpsertUserSettingsParams{
+		UserID:                   settings.UserID,
+		Theme:                    settings.Theme,
+		Locale:                   settings.Locale,
+		ShowCurrentActivity:      settings.ShowCurrentActivity,
+		DmPrivacy:                settings.DmPrivacy,
+		FriendRequestPrivacy:     settings.FriendRequestPrivacy,
+		ExplicitContentFilter:    settings.ExplicitContentFilter,
+		EnableNotifications:      settings.EnableNotifications,
+		DefaultNotificationLevel: settings.DefaultNotificationLevel,
+		ExpectedVersion:          settings.Version,
+	})
+}
+
+// SaveServerSetting writes a server override and bumps the settings version in
+// one transaction. Like SaveUserSettings it returns pgx.ErrNoRows when the
+// stored version is no longer expectedVersion.
+func (r *UserRepository) SaveServerSetting(ctx context.Context, setting repo.UserServerSetting, expectedVersion int32) (repo.UserSetting, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return repo.UserSetting{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.q.WithTx(tx)
+	settings, err := qtx.BumpUserSettingsVersion(ctx, repo.BumpUserSettingsVersionParams{
+		UserID:          setting.UserID,
+		ExpectedVersion: expectedVersion,
+	})
+	if err != nil {
+		return repo.UserSetting{}, err
+	}
+	if _, err := qtx.UpsertUserServerSettings(ctx, repo.UpsertUserServerSettingsParams{
+		UserID:            setting.UserID,
+		ServerID:          setting.ServerID,
+		Muted:             setting.Muted,
+		NotificationLevel: setting.NotificationLevel,
+		AllowDms:          setting.AllowDms,
+	}); err != nil {
+		return repo.UserSetting{}, err
+	}
+	return settings, tx.Commit(ctx)
+}
+
+func (r *UserRepository) IsServerMember(ctx context.Context, serverID, userID int32) (bool, error) {
+	_, err := r.q.GetServerMember(ctx, repo.GetServerMemberParams{
+		ServerID: serverID,
+		UserID:   userID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	return err == nil, err
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"discord/gen/proto/schema"
+	userUtil "discord/internal/user/util"
 	"discord/pkg/pubsub"
 	"strconv"
 )
@@ -15,6 +16,10 @@ func UserFriendTopic(userId int32) string {
 	return "friend_pr:" + strconv.Itoa(int(userId))
 }
 
+func UserSettingsTopic(userId int32) string {
+	return "user_settings:" + strconv.Itoa(int(userId))
+}
+
 func (s *UserService) publishUser(ctx context.Context, user *schema.User) {
 	topic := UserTopic(user.Id)
 	go s.pubsub.Publish(topic, user)
@@ -34,14 +39,25 @@ func (s *UserService) publishFriendUpdate(ctx context.Context, user *schema.User
 	}()
 }
 
-func StreamUser(id int32) *pubsub.Channel {
+// publishSettings sends changed settings to every session of the user
+func (s *UserService) publishSettings(settings *UserSettings) {
+	s.pubsub.Publish(UserSettingsTopic(settings.UserID), userUtil.ConvertSettingsToProto(settings.UserSetting, settings.ServerOverrides))
+}
+
+func StreamUser(id int32, opts ...pubsub.Option) *pubsub.Channel {
+	ps := pubsub.Get()
+	ch := ps.Subscribe(UserTopic(id), opts...)
+	return ch
+}
+
+func StreamUserFriendUpdates(id int32, opts ...pubsub.Option) *pubsub.Channel {
 	ps := pubsub.Get()
-	ch := ps.Subscribe(UserTopic(id))
+	ch := ps.Subscribe(UserFriendTopic(id), opts...)
 	return ch
 }
 
-func StreamUserFriendUpdates(id int32) *pubsub.Channel {
+func StreamUserSettings(id int32, opts ...pubsub.Option) *pubsub.Channel {
 	ps := pubsub.Get()
-	ch := ps.Subscribe(UserFriendTopic(id))
+	ch := ps.Subscribe(UserSettingsTopic(id), opts...)
 	return ch
 }
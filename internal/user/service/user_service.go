@@ -1,15 +1,23 @@
 package service
 
 import (
+	"cmp"
 	"context"
 	"discord/gen/proto/schema"
 	"discord/gen/repo"
 	commonErrors "discord/internal/common/errors"
 	"discord/internal/common/util"
 	userRepo "discord/internal/user/repository"
+	userUtil "discord/internal/user/util"
 	"discord/pkg/pubsub"
+	"errors"
 	"fmt"
+	"regexp"
+	"slices"
 	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type UserService struct {
@@ -314,16 +322,45 @@ func (s *UserService) GetBlockedUsers(ctx context.Context, userID int32) ([]int3
 	return blockedIDs, nil
 }
 
-// User Settings (simple in-memory for now, can be stored in DB later)
+// User Settings
+var (
+	validThemes               = map[string]bool{"light": true, "dark": true, "system": true}
+	validDMPrivacy            = map[string]bool{userUtil.DMPrivacyEveryone: true, userUtil.DMPrivacyServerMembers: true, userUtil.DMPrivacyFriends: true}
+	validFriendRequestPrivacy = map[string]bool{userUtil.FriendRequestsEveryone: true, userUtil.FriendRequestsFriendsOfFriends: true, userUtil.FriendRequestsServerMembers: true, userUtil.FriendRequestsNone: true}
+	validContentFilters       = map[string]bool{userUtil.ContentFilterDisabled: true, userUtil.ContentFilterNonFriends: true, userUtil.ContentFilterAll: true}
+	validNotificationLevels   = map[string]bool{userUtil.NotifyAll: true, userUtil.NotifyMentions: true, userUtil.NotifyNone: true}
+	localePattern             = regexp.MustCompile(`^[a-z]{2,3}(-[A-Z]{2})?$`)
+)
+
+// UserSettings are the account-wide settings of a user with their per-server
+// overrides
 type UserSettings struct {
-	ShowCurrentActivity bool
-	AllowDMs            bool
-	EnableNotifications bool
-	Theme               string
-	Language            string
+	repo.UserSetting
+	ServerOverrides []repo.UserServerSetting
+}
+
+// SettingsUpdate lists the settings to change; nil fields are left as they are.
+// A non-zero Version must match the stored version.
+type SettingsUpdate struct {
+	Version                  int32
+	Theme                    *string
+	Locale                   *string
+	ShowCurrentActivity      *bool
+	DMPrivacy                *string
+	FriendRequestPrivacy     *string
+	ExplicitContentFilter    *string
+	EnableNotifications      *bool
+	DefaultNotificationLevel *string
 }
 
-var userSettingsCache = make(map[int32]*UserSettings)
+// ServerSettingsUpdate lists the overrides of one server to change. An empty
+// NotificationLevel falls back to the default notification level.
+type ServerSettingsUpdate struct {
+	Version           int32
+	Muted             *bool
+	NotificationLevel *string
+	AllowDMs          *bool
+}
 
 func (s *UserService) GetUserSettings(ctx context.Context, userID int32) (*UserSettings, error) {
 	// Verify user exists
@@ -332,55 +369,161 @@ func (s *UserService) GetUserSettings(ctx context.Context, userID int32) (*UserS
 		return nil, commonErrors.ErrNotFound
 	}
 
-	// Get from cache or return defaults
-	if settings, exists := userSettingsCache[userID]; exists {
-		return settings, nil
-	}
-
-	// Default settings
-	return &UserSettings{
-		ShowCurrentActivity: true,
-		AllowDMs:            true,
-		EnableNotifications: true,
-		Theme:               "dark",
-		Language:            "en",
-	}, nil
+	return s.loadSettings(ctx, userID)
 }
 
-func (s *UserService) UpdateUserSettings(ctx context.Context, userID int32, showCurrentActivity, allowDMs, enableNotifications *bool, theme, language *string) error {
+func (s *UserService) UpdateUserSettings(ctx context.Context, userID int32, update SettingsUpdate) (*UserSettings, error) {
 	// Verify user exists
 	_, err := s.userRepo.GetUser(ctx, userID)
 	if err != nil {
-		return commonErrors.ErrNotFound
+		return nil, commonErrors.ErrNotFound
 	}
 
-	// Get current settings or defaults
-	settings, _ := s.GetUserSettings(ctx, userID)
+	settings, err := s.loadSettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if update.Version != 0 && update.Version != settings.Version {
+		return nil, commonErrors.ErrConflict
+	}
 
-	// Update settings
-	if showCurrentActivity != nil {
-		settings.ShowCurrentActivity = *showCurrentActivity
+	changed := settings.UserSetting
+	if update.Theme != nil {
+		if !validThemes[*update.Theme] {
+			return nil, commonErrors.ErrInvalidInput
+		}
+		changed.Theme = *update.Theme
+	}
+	if update.Locale != nil {
+		if !localePattern.MatchString(*update.Locale) {
+			return nil, commonErrors.ErrInvalidInput
+		}
+		changed.Locale = *update.Locale
+	}
+	if update.ShowCurrentActivity != nil {
+		changed.ShowCurrentActivity = *update.ShowCurrentActivity
 	}
-	if allowDMs != nil {
-		settings.AllowDMs = *allowDMs
+	if update.DMPrivacy != nil {
+		if !validDMPrivacy[*update.DMPrivacy] {
+			return nil, commonErrors.ErrInvalidInput
+		}
+		changed.DmPrivacy = *update.DMPrivacy
 	}
-	if enableNotifications != nil {
-		settings.EnableNotifications = *enableNotifications
+	if update.FriendRequestPrivacy != nil {
+		if !validFriendRequestPrivacy[*update.FriendRequestPrivacy] {
+			return nil, commonErrors.ErrInvalidInput
+		}
+		changed.FriendRequestPrivacy = *update.FriendRequestPrivacy
 	}
-	if theme != nil {
-		if *theme != "light" && *theme != "dark" {
-			return commonErrors.ErrInvalidInput
+	if update.ExplicitContentFilter != nil {
+		if !validContentFilters[*update.ExplicitContentFilter] {
+			return nil, commonErrors.ErrInvalidInput
 		}
-		settings.Theme = *theme
+		changed.ExplicitContentFilter = *update.ExplicitContentFilter
 	}
-	if language != nil {
-		settings.Language = *language
+	if update.EnableNotifications != nil {
+		changed.EnableNotifications = *update.EnableNotifications
+	}
+	if update.DefaultNotificationLevel != nil {
+		if !validNotificationLevels[*update.DefaultNotificationLevel] {
+			return nil, commonErrors.ErrInvalidInput
+		}
+		changed.DefaultNotificationLevel = *update.DefaultNotificationLevel
 	}
 
-	// Store in cache
-	userSettingsCache[userID] = settings
+	// Saving fails when another session changed the settings since they were loaded
+	saved, err := s.userRepo.SaveUserSettings(ctx, changed)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, commonErrors.ErrConflict
+	}
+	if err != nil {
+		return nil, commonErrors.ErrInternalServer
+	}
 
-	return nil
+	settings.UserSetting = saved
+	s.publishSettings(settings)
+	return settings, nil
+}
+
+func (s *UserService) UpdateServerSettings(ctx context.Context, userID, serverID int32, update ServerSettingsUpdate) (*UserSettings, error) {
+	isMember, err := s.userRepo.IsServerMember(ctx, serverID, userID)
+	if err != nil {
+		return nil, commonErrors.ErrInternalServer
+	}
+	if !isMember {
+		return nil, commonErrors.ErrNotFound
+	}
+
+	settings, err := s.loadSettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if update.Version != 0 && update.Version != settings.Version {
+		return nil, commonErrors.ErrConflict
+	}
+
+	index := slices.IndexFunc(settings.ServerOverrides, func(o repo.UserServerSetting) bool {
+		return o.ServerID == serverID
+	})
+	override := userUtil.DefaultServerSetting(userID, serverID)
+	if index >= 0 {
+		override = settings.ServerOverrides[index]
+	}
+
+	if update.Muted != nil {
+		override.Muted = *update.Muted
+	}
+	if update.NotificationLevel != nil {
+		switch {
+		case *update.NotificationLevel == "":
+			override.NotificationLevel = pgtype.Text{}
+		case validNotificationLevels[*update.NotificationLevel]:
+			override.NotificationLevel = pgtype.Text{String: *update.NotificationLevel, Valid: true}
+		default:
+			return nil, commonErrors.ErrInvalidInput
+		}
+	}
+	if update.AllowDMs != nil {
+		override.AllowDms = *update.AllowDMs
+	}
+
+	saved, err := s.userRepo.SaveServerSetting(ctx, override, settings.Version)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, commonErrors.ErrConflict
+	}
+	if err != nil {
+		return nil, commonErrors.ErrInternalServer
+	}
+
+	settings.UserSetting = saved
+	if index >= 0 {
+		settings.ServerOverrides[index] = override
+	} else {
+		settings.ServerOverrides = append(settings.ServerOverrides, override)
+		slices.SortFunc(settings.ServerOverrides, func(a, b repo.UserServerSetting) int {
+			return cmp.Compare(a.ServerID, b.ServerID)
+		})
+	}
+	s.publishSettings(settings)
+	return settings, nil
+}
+
+// loadSettings returns the stored settings of a user, or the defaults at
+// version 0 when the user never changed any
+func (s *UserService) loadSettings(ctx context.Context, userID int32) (*UserSettings, error) {
+	stored, err := s.userRepo.GetUserSettings(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		stored = userUtil.DefaultSettings(userID)
+	} else if err != nil {
+		return nil, commonErrors.ErrInternalServer
+	}
+
+	overrides, err := s.userRepo.GetServerSettings(ctx, userID)
+	if err != nil {
+		return nil, commonErrors.ErrInternalServer
+	}
+
+	return &UserSettings{UserSetting: stored, ServerOverrides: overrides}, nil
 }
 
 func (s *UserService) GetConnectedFriends(ctx context.Context, userID int32) ([]repo.User, error) {
@@ -0,0 +1,88 @@
+package util
+
+import (
+	"discord/gen/proto/schema"
+	"discord/gen/repo"
+)
+
+// Who may open a DM with a user
+const (
+	DMPrivacyEveryone      = "everyone"
+	DMPrivacyServerMembers = "server_members"
+	DMPrivacyFriends       = "friends"
+)
+
+// Who may send a user a friend request
+const (
+	FriendRequestsEveryone         = "everyone"
+	FriendRequestsFriendsOfFriends = "friends_of_friends"
+	FriendRequestsServerMembers    = "server_members"
+	FriendRequestsNone             = "none"
+)
+
+// Which direct messages are scanned for explicit content
+const (
+	ContentFilterDisabled   = "disabled"
+	ContentFilterNonFriends = "non_friends"
+	ContentFilterAll        = "all"
+)
+
+// Which messages notify a user
+const (
+	NotifyAll      = "all"
+	NotifyMentions = "mentions"
+	NotifyNone     = "none"
+)
+
+// DefaultSettings returns the settings of a user who never changed any. They
+// match the column defaults of user_settings and have version 0.
+func DefaultSettings(userID int32) repo.UserSetting {
+	return repo.UserSetting{
+		UserID:                   userID,
+		Theme:                    "dark",
+		Locale:                   "en",
+		ShowCurrentActivity:      true,
+		DmPrivacy:                DMPrivacyEveryone,
+		FriendRequestPrivacy:     FriendRequestsEveryone,
+		ExplicitContentFilter:    ContentFilterNonFriends,
+		EnableNotifications:      true,
+		DefaultNotificationLevel: NotifyAll,
+	}
+}
+
+// DefaultServerSetting returns the override of a server the user never changed
+func DefaultServerSetting(userID, serverID int32) repo.UserServerSetting {
+	return repo.UserServerSetting{
+		UserID:   userID,
+		ServerID: serverID,
+		AllowDms: true,
+	}
+}
+
+// ConvertSettingsToProto converts settings and their server overrides to proto
+func ConvertSettingsToProto(settings repo.UserSetting, overrides []repo.UserServerSetting) *schema.UserSettings {
+	pbSettings := &schema.UserSettings{
+		Version:                  settings.Version,
+		Theme:                    settings.Theme,
+		Locale:                   settings.Locale,
+		ShowCurrentActivity:      settings.ShowCurrentActivity,
+		DmPrivacy:                settings.DmPrivacy,
+		FriendRequestPrivacy:     settings.FriendRequestPrivacy,
+		ExplicitContentFilter:    settings.ExplicitContentFilter,
+		EnableNotifications:      settings.EnableNotifications,
+		DefaultNotificationLevel: settings.DefaultNotificationLevel,
+		ServerOverrides:          make([]*schema.ServerSettingsOverride, len(overrides)),
+	}
+	if settings.UpdatedAt.Valid {
+		pbSettings.UpdatedAt = settings.UpdatedAt.Time.Unix()
+	}
+	for i, override := range overrides {
+		pbSettings.ServerOverrides[i] = &schema.ServerSettingsOverride{
+			ServerId:          override.ServerID,
+			Muted:             override.Muted,
+			NotificationLevel: override.NotificationLevel.String,
+			AllowDms:          override.AllowDms,
+		}
+	}
+	return pbSettings
+}
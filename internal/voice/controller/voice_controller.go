@@ -99,6 +99,10 @@ func (c *VoiceController) SendVoiceChat(ctx context.Context, req *voicePb.SendVo
 		return nil, commonErrors.ToGRPCError(commonErrors.ErrPermissionDenied)
 	}
 
+	if err := c.voiceService.ValidateSpeakPermissions(ctx, userID, req.GetVoiceChannelId()); err != nil {
+		return nil, commonErrors.ToGRPCError(err)
+	}
+
 	// TODO: Implement actual voice chat message handling
 	return &voicePb.SendVoiceChatResponse{
 		VoiceChat: &schema.VoiceChat{
@@ -0,0 +1,24 @@
+package service
+
+import (
+	"discord/gen/repo"
+	gatewayUtil "discord/internal/gateway/util"
+	voiceUtil "discord/internal/voice/util"
+	"discord/pkg/pubsub"
+)
+
+// voiceStateLeave marks a published voice state as the user leaving the channel
+const voiceStateLeave = "leave"
+
+// publishVoiceState sends a VOICE_STATE_UPDATE to everyone subscribed to the channel
+func publishVoiceState(state repo.VoiceState) {
+	pubsub.Get().Publish(gatewayUtil.ChannelTopic(state.ChannelID), gatewayUtil.VoiceStateUpdate(voiceUtil.FormatVoiceState(state)))
+}
+
+// publishVoiceLeave sends a VOICE_STATE_UPDATE telling the channel the user left
+func publishVoiceLeave(state repo.VoiceState) {
+	pbState := voiceUtil.FormatVoiceState(state)
+	operation := voiceStateLeave
+	pbState.Operation = &operation
+	pubsub.Get().Publish(gatewayUtil.ChannelTopic(state.ChannelID), gatewayUtil.VoiceStateUpdate(pbState))
+}
@@ -4,20 +4,27 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/hex"
+	"errors"
 	"fmt"
 
 	"discord/gen/repo"
+	channelUtil "discord/internal/channel/util"
 	commonErrors "discord/internal/common/errors"
+	permissionService "discord/internal/permission/service"
 	voiceRepo "discord/internal/voice/repository"
+
+	"github.com/jackc/pgx/v5"
 )
 
 type VoiceService struct {
-	voiceRepo *voiceRepo.VoiceRepository
+	voiceRepo   *voiceRepo.VoiceRepository
+	permissions *permissionService.Resolver
 }
 
-func NewVoiceService(voiceRepo *voiceRepo.VoiceRepository) *VoiceService {
+func NewVoiceService(voiceRepo *voiceRepo.VoiceRepository, permissions *permissionService.Resolver) *VoiceService {
 	return &VoiceService{
-		voiceRepo: voiceRepo,
+		voiceRepo:   voiceRepo,
+		permissions: permissions,
 	}
 }
 
@@ -32,6 +39,7 @@ func (s *VoiceService) JoinVoiceChannel(ctx context.Context, userID, channelID i
 			if err != nil {
 				return repo.VoiceState{}, commonErrors.ErrInternalServer
 			}
+			publishVoiceLeave(existingState)
 		} else {
 			// Already in this channel
 			return existingState, nil
@@ -50,13 +58,14 @@ func (s *VoiceService) JoinVoiceChannel(ctx context.Context, userID, channelID i
 		return repo.VoiceState{}, commonErrors.ErrInternalServer
 	}
 
+	publishVoiceState(voiceState)
 	return voiceState, nil
 }
 
 // LeaveVoiceChannel allows a user to leave a voice channel
 func (s *VoiceService) LeaveVoiceChannel(ctx context.Context, userID, channelID int32) error {
 	// Verify user is in the channel
-	_, err := s.voiceRepo.GetVoiceState(ctx, userID, channelID)
+	state, err := s.voiceRepo.GetVoiceState(ctx, userID, channelID)
 	if err != nil {
 		return commonErrors.ErrNotFound
 	}
@@ -67,6 +76,7 @@ func (s *VoiceService) LeaveVoiceChannel(ctx context.Context, userID, channelID
 		return commonErrors.ErrInternalServer
 	}
 
+	publishVoiceLeave(state)
 	return nil
 }
 
@@ -97,7 +107,9 @@ func (s *VoiceService) GetChannelVoiceStates(ctx context.Context, channelID int3
 	return states, nil
 }
 
-// UpdateVoiceState updates a user's voice state properties
+// UpdateVoiceState updates a user's voice state properties. Unmuting or
+// starting video or a stream needs the SPEAK permission, which timed out
+// members lose.
 func (s *VoiceService) UpdateVoiceState(ctx context.Context, userID, channelID int32, isMuted, isDeafened, selfMute, selfDeaf, selfVideo, selfStream *bool) (repo.VoiceState, error) {
 	// Verify user is in the channel
 	_, err := s.voiceRepo.GetVoiceState(ctx, userID, channelID)
@@ -105,100 +117,160 @@ func (s *VoiceService) UpdateVoiceState(ctx context.Context, userID, channelID i
 		return repo.VoiceState{}, commonErrors.ErrNotFound
 	}
 
+	if isFalse(isMuted) || isFalse(selfMute) || isTrue(selfVideo) || isTrue(selfStream) {
+		if err := s.ValidateSpeakPermissions(ctx, userID, channelID); err != nil {
+			return repo.VoiceState{}, err
+		}
+	}
+
 	// Update voice state
 	state, err := s.voiceRepo.UpdateVoiceState(ctx, userID, channelID, isMuted, isDeafened, selfMute, selfDeaf, selfVideo, selfStream)
 	if err != nil {
 		return repo.VoiceState{}, commonErrors.ErrInternalServer
 	}
 
+	publishVoiceState(state)
 	return state, nil
 }
 
 // MuteUser server mutes a user (requires permission)
 func (s *VoiceService) MuteUser(ctx context.Context, userID, channelID int32) error {
 	muted := true
-	_, err := s.voiceRepo.UpdateVoiceState(ctx, userID, channelID, &muted, nil, nil, nil, nil, nil)
+	state, err := s.voiceRepo.UpdateVoiceState(ctx, userID, channelID, &muted, nil, nil, nil, nil, nil)
 	if err != nil {
 		return commonErrors.ErrInternalServer
 	}
+	publishVoiceState(state)
 	return nil
 }
 
+// MuteInServer server mutes a user who is in a voice channel of serverID, as
+// when they are timed out
+func (s *VoiceService) MuteInServer(ctx context.Context, userID, serverID int32) error {
+	state, err := s.voiceRepo.GetUserVoiceState(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return commonErrors.ErrInternalServer
+	}
+	if state.ServerID.Int32 != serverID || state.IsMuted.Bool {
+		return nil
+	}
+	return s.MuteUser(ctx, userID, state.ChannelID)
+}
+
 // UnmuteUser server unmutes a user (requires permission)
 func (s *VoiceService) UnmuteUser(ctx context.Context, userID, channelID int32) error {
 	muted := false
-	_, err := s.voiceRepo.UpdateVoiceState(ctx, userID, channelID, &muted, nil, nil, nil, nil, nil)
+	state, err := s.voiceRepo.UpdateVoiceState(ctx, userID, channelID, &muted, nil, nil, nil, nil, nil)
 	if err != nil {
 		return commonErrors.ErrInternalServer
 	}
+	publishVoiceState(state)
 	return nil
 }
 
 // DeafenUser server deafens a user (requires permission)
 func (s *VoiceService) DeafenUser(ctx context.Context, userID, channelID int32) error {
 	deafened := true
-	_, err := s.voiceRepo.UpdateVoiceState(ctx, userID, channelID, nil, &deafened, nil, nil, nil, nil)
+	state, err := s.voiceRepo.UpdateVoiceState(ctx, userID, channelID, nil, &deafened, nil, nil, nil, nil)
 	if err != nil {
 		return commonErrors.ErrInternalServer
 	}
+	publishVoiceState(state)
 	return nil
 }
 
 // UndeafenUser server undeafens a user (requires permission)
 func (s *VoiceService) UndeafenUser(ctx context.Context, userID, channelID int32) error {
 	deafened := false
-	_, err := s.voiceRepo.UpdateVoiceState(ctx, userID, channelID, nil, &deafened, nil, nil, nil, nil)
+	state, err := s.voiceRepo.UpdateVoiceState(ctx, userID, channelID, nil, &deafened, nil, nil, nil, nil)
 	if err != nil {
 		return commonErrors.ErrInternalServer
 	}
+	publishVoiceState(state)
 	return nil
 }
 
 // ToggleSelfMute toggles user's self mute
 func (s *VoiceService) ToggleSelfMute(ctx context.Context, userID, channelID int32, mute bool) error {
-	_, err := s.voiceRepo.UpdateVoiceState(ctx, userID, channelID, nil, nil, &mute, nil, nil, nil)
+	if !mute {
+		if err := s.ValidateSpeakPermissions(ctx, userID, channelID); err != nil {
+			return err
+		}
+	}
+	state, err := s.voiceRepo.UpdateVoiceState(ctx, userID, channelID, nil, nil, &mute, nil, nil, nil)
 	if err != nil {
 		return commonErrors.ErrInternalServer
 	}
+	publishVoiceState(state)
 	return nil
 }
 
 // ToggleSelfDeaf toggles user's self deafen
 func (s *VoiceService) ToggleSelfDeaf(ctx context.Context, userID, channelID int32, deaf bool) error {
-	_, err := s.voiceRepo.UpdateVoiceState(ctx, userID, channelID, nil, nil, nil, &deaf, nil, nil)
+	state, err := s.voiceRepo.UpdateVoiceState(ctx, userID, channelID, nil, nil, nil, &deaf, nil, nil)
 	if err != nil {
 		return commonErrors.ErrInternalServer
 	}
+	publishVoiceState(state)
 	return nil
 }
 
 // ToggleSelfVideo toggles user's video
 func (s *VoiceService) ToggleSelfVideo(ctx context.Context, userID, channelID int32, video bool) error {
-	_, err := s.voiceRepo.UpdateVoiceState(ctx, userID, channelID, nil, nil, nil, nil, &video, nil)
+	if video {
+		if err := s.ValidateSpeakPermissions(ctx, userID, channelID); err != nil {
+			return err
+		}
+	}
+	state, err := s.voiceRepo.UpdateVoiceState(ctx, userID, channelID, nil, nil, nil, nil, &video, nil)
 	if err != nil {
 		return commonErrors.ErrInternalServer
 	}
+	publishVoiceState(state)
 	return nil
 }
 
 // ToggleSelfStream toggles user's screen share
 func (s *VoiceService) ToggleSelfStream(ctx context.Context, userID, channelID int32, stream bool) error {
-	_, err := s.voiceRepo.UpdateVoiceState(ctx, userID, channelID, nil, nil, nil, nil, nil, &stream)
+	if stream {
+		if err := s.ValidateSpeakPermissions(ctx, userID, channelID); err != nil {
+			return err
+		}
+	}
+	state, err := s.voiceRepo.UpdateVoiceState(ctx, userID, channelID, nil, nil, nil, nil, nil, &stream)
 	if err != nil {
 		return commonErrors.ErrInternalServer
 	}
+	publishVoiceState(state)
 	return nil
 }
 
 // DisconnectUser disconnects a user from voice (requires permission)
 func (s *VoiceService) DisconnectUser(ctx context.Context, userID int32) error {
+	state, stateErr := s.voiceRepo.GetUserVoiceState(ctx, userID)
+
 	err := s.voiceRepo.DeleteUserVoiceStates(ctx, userID)
 	if err != nil {
 		return commonErrors.ErrInternalServer
 	}
+
+	if stateErr == nil {
+		publishVoiceLeave(state)
+	}
 	return nil
 }
 
+func isTrue(b *bool) bool {
+	return b != nil && *b
+}
+
+func isFalse(b *bool) bool {
+	return b != nil && !*b
+}
+
 // generateSessionID generates a unique session ID
 func generateSessionID() (string, error) {
 	bytes := make([]byte, 16)
@@ -236,6 +308,7 @@ func (s *VoiceService) MoveUser(ctx context.Context, userID, fromChannelID, toCh
 	if err != nil {
 		return commonErrors.ErrInternalServer
 	}
+	publishVoiceLeave(currentState)
 
 	// Create new state with same properties
 	selfMute := currentState.SelfMute.Valid && currentState.SelfMute.Bool
@@ -243,19 +316,23 @@ func (s *VoiceService) MoveUser(ctx context.Context, userID, fromChannelID, toCh
 	isMuted := currentState.IsMuted.Valid && currentState.IsMuted.Bool
 	isDeafened := currentState.IsDeafened.Valid && currentState.IsDeafened.Bool
 
-	_, err = s.voiceRepo.CreateVoiceState(ctx, userID, toChannelID, serverID, currentState.SessionID, isMuted, isDeafened, selfMute, selfDeaf)
+	newState, err := s.voiceRepo.CreateVoiceState(ctx, userID, toChannelID, serverID, currentState.SessionID, isMuted, isDeafened, selfMute, selfDeaf)
 	if err != nil {
 		return commonErrors.ErrInternalServer
 	}
 
+	publishVoiceState(newState)
 	return nil
 }
 
 // ValidateVoicePermissions checks if user has permission to join voice channel
 func (s *VoiceService) ValidateVoicePermissions(ctx context.Context, userID, channelID int32) error {
-	// TODO: Implement permission checks with server/channel permissions
-	// For now, allow all users
-	return nil
+	return s.permissions.RequireChannel(ctx, channelID, userID, channelUtil.PermissionConnect)
+}
+
+// ValidateSpeakPermissions checks if user may speak in a voice channel
+func (s *VoiceService) ValidateSpeakPermissions(ctx context.Context, userID, channelID int32) error {
+	return s.permissions.RequireChannel(ctx, channelID, userID, channelUtil.PermissionSpeak)
 }
 
 // GetVoiceChannelStats returns statistics for a voice channel
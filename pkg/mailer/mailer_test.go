@@ -0,0 +1,114 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderEveryTemplate(t *testing.T) {
+	templates, err := LoadTemplates()
+	require.NoError(t, err)
+
+	data := Data{
+		Username:  "alice",
+		Link:      "https://example.com/verify?token=abc",
+		Device:    "Firefox on Linux",
+		IPAddress: "203.0.113.7",
+		Time:      time.Date(2025, 1, 2, 3, 4, 0, 0, time.UTC),
+	}
+	names := []string{TemplateVerifyEmail, TemplatePasswordReset, TemplateNewLogin, Template2FAEnabled, Template2FADisabled, TemplateAccountLocked}
+	for _, locale := range []string{"en", "de"} {
+		for _, name := range names {
+			msg, err := templates.Render(name, locale, data)
+			require.NoError(t, err, "%s/%s", locale, name)
+			assert.NotEmpty(t, msg.Subject, "%s/%s", locale, name)
+			assert.Contains(t, msg.Text, "alice", "%s/%s", locale, name)
+			assert.Contains(t, msg.HTML, "alice", "%s/%s", locale, name)
+			key, _ := templates.resolve(name, locale)
+			assert.Equal(t, locale+"/"+name, key)
+		}
+	}
+}
+
+func TestRenderLocaleFallback(t *testing.T) {
+	templates, err := LoadTemplates()
+	require.NoError(t, err)
+
+	german, err := templates.Render(TemplateVerifyEmail, "de-AT", Data{Username: "alice"})
+	require.NoError(t, err)
+	assert.Equal(t, "Bestätige deine E-Mail-Adresse", german.Subject)
+
+	fallback, err := templates.Render(TemplateVerifyEmail, "pt_BR", Data{Username: "alice"})
+	require.NoError(t, err)
+	assert.Equal(t, "Verify your email address", fallback.Subject)
+
+	_, err = templates.Render("unknown", "en", Data{})
+	assert.Error(t, err)
+}
+
+func TestRenderEscapesHTML(t *testing.T) {
+	templates, err := LoadTemplates()
+	require.NoError(t, err)
+
+	msg, err := templates.Render(Template2FAEnabled, "en", Data{Username: "<b>alice</b>"})
+	require.NoError(t, err)
+	assert.Contains(t, msg.Text, "<b>alice</b>")
+	assert.Contains(t, msg.HTML, "&lt;b&gt;alice&lt;/b&gt;")
+}
+
+func TestMessageBytes(t *testing.T) {
+	msg := Message{
+		To:      "alice@example.com",
+		Subject: "Bestätige",
+		Text:    "plain body",
+		HTML:    "<p>html body</p>",
+	}
+	raw, err := msg.Bytes("Discord <noreply@example.com>", time.Now())
+	require.NoError(t, err)
+
+	parsed, err := mail.ReadMessage(bytes.NewReader(raw))
+	require.NoError(t, err)
+	assert.Equal(t, "alice@example.com", parsed.Header.Get("To"))
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "Bestätige", subject)
+	assert.True(t, strings.HasSuffix(parsed.Header.Get("Message-ID"), "@example.com>"))
+
+	_, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	reader := multipart.NewReader(parsed.Body, params["boundary"])
+
+	var bodies []string
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		body, err := io.ReadAll(part)
+		require.NoError(t, err)
+		bodies = append(bodies, string(body))
+	}
+	assert.Equal(t, []string{"plain body", "<p>html body</p>"}, bodies)
+}
+
+func TestMemoryMailer(t *testing.T) {
+	m := NewMemoryMailer()
+	require.NoError(t, m.Send(context.Background(), Message{To: "a@example.com"}))
+	require.NoError(t, m.Send(context.Background(), Message{To: "b@example.com"}))
+
+	sent := m.Sent()
+	require.Len(t, sent, 2)
+	assert.Equal(t, "a@example.com", sent[0].To)
+	assert.Equal(t, "b@example.com", sent[1].To)
+}
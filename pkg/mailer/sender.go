@@ -0,0 +1,38 @@
+package mailer
+
+import (
+	"context"
+	"net/url"
+	"strings"
+)
+
+// Sender renders emails from the templates and delivers them with a Mailer
+type Sender struct {
+	mailer    Mailer
+	templates *Templates
+	baseURL   string
+}
+
+// NewSender creates a Sender. Links in emails point to pages of baseURL.
+func NewSender(mailer Mailer, templates *Templates, baseURL string) *Sender {
+	return &Sender{
+		mailer:    mailer,
+		templates: templates,
+		baseURL:   strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+// Send renders the template name in locale and mails it to to
+func (s *Sender) Send(ctx context.Context, to, locale, name string, data Data) error {
+	msg, err := s.templates.Render(name, locale, data)
+	if err != nil {
+		return err
+	}
+	msg.To = to
+	return s.mailer.Send(ctx, msg)
+}
+
+// Link returns the link to a page of the web app carrying a token
+func (s *Sender) Link(page, token string) string {
+	return s.baseURL + "/" + page + "?token=" + url.QueryEscape(token)
+}
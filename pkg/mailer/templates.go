@@ -0,0 +1,141 @@
+package mailer
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"path"
+	"strings"
+	texttemplate "text/template"
+	"time"
+)
+
+//go:embed templates
+var embeddedTemplates embed.FS
+
+// DefaultLocale is rendered when an email has no template for the locale of
+// the recipient
+const DefaultLocale = "en"
+
+// Emails the application sends
+const (
+	TemplateVerifyEmail   = "verify_email"
+	TemplatePasswordReset = "password_reset"
+	TemplateNewLogin      = "new_login"
+	Template2FAEnabled    = "2fa_enabled"
+	Template2FADisabled   = "2fa_disabled"
+	TemplateAccountLocked = "account_locked"
+)
+
+// Data is what the templates are rendered with. Every email has Username,
+// the rest depends on the email.
+type Data struct {
+	Username  string
+	Link      string // Verification or password reset link
+	Device    string // New login alerts only
+	IPAddress string // New login and lockout alerts only
+	Time      time.Time
+}
+
+// Templates renders localised emails. Every template is a file
+// <locale>/<name>.tmpl defining "subject", "text" and optionally "html".
+type Templates struct {
+	text map[string]*texttemplate.Template
+	html map[string]*htmltemplate.Template
+}
+
+// LoadTemplates parses the templates embedded in the binary
+func LoadTemplates() (*Templates, error) {
+	sub, err := fs.Sub(embeddedTemplates, "templates")
+	if err != nil {
+		return nil, err
+	}
+	return ParseTemplates(sub)
+}
+
+// ParseTemplates parses every <locale>/<name>.tmpl file of fsys
+func ParseTemplates(fsys fs.FS) (*Templates, error) {
+	files, err := fs.Glob(fsys, "*/*.tmpl")
+	if err != nil {
+		return nil, err
+	}
+
+	t := &Templates{
+		text: make(map[string]*texttemplate.Template, len(files)),
+		html: make(map[string]*htmltemplate.Template),
+	}
+	for _, file := range files {
+		data, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, err
+		}
+		key := strings.TrimSuffix(file, ".tmpl")
+
+		text, err := texttemplate.New(key).Parse(string(data))
+		if err != nil {
+			return nil, err
+		}
+		if text.Lookup("subject") == nil || text.Lookup("text") == nil {
+			return nil, fmt.Errorf("template %s must define subject and text", file)
+		}
+		t.text[key] = text
+
+		html, err := htmltemplate.New(key).Parse(string(data))
+		if err != nil {
+			return nil, err
+		}
+		if html.Lookup("html") != nil {
+			t.html[key] = html
+		}
+	}
+	return t, nil
+}
+
+// Render renders an email in locale. A regional locale such as "de-AT" falls
+// back to its language, and then to DefaultLocale.
+func (t *Templates) Render(name, locale string, data Data) (Message, error) {
+	key, ok := t.resolve(name, locale)
+	if !ok {
+		return Message{}, fmt.Errorf("no template %s", name)
+	}
+
+	var subject, text bytes.Buffer
+	if err := t.text[key].ExecuteTemplate(&subject, "subject", data); err != nil {
+		return Message{}, err
+	}
+	if err := t.text[key].ExecuteTemplate(&text, "text", data); err != nil {
+		return Message{}, err
+	}
+	msg := Message{
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    strings.TrimSpace(text.String()) + "\n",
+	}
+
+	if html, ok := t.html[key]; ok {
+		var b bytes.Buffer
+		if err := html.ExecuteTemplate(&b, "html", data); err != nil {
+			return Message{}, err
+		}
+		msg.HTML = strings.TrimSpace(b.String()) + "\n"
+	}
+	return msg, nil
+}
+
+func (t *Templates) resolve(name, locale string) (string, bool) {
+	locale = strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+	candidates := []string{locale}
+	if lang, _, ok := strings.Cut(locale, "-"); ok {
+		candidates = append(candidates, lang)
+	}
+	candidates = append(candidates, DefaultLocale)
+
+	for _, candidate := range candidates {
+		key := path.Join(candidate, name)
+		if _, ok := t.text[key]; ok {
+			return key, true
+		}
+	}
+	return "", false
+}
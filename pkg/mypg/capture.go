@@ -0,0 +1,219 @@
+package mypg
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	leadingComments = regexp.MustCompile(`^(\s*(--[^\n]*\n?|/\*(.|\n)*?\*/))*\s*`)
+	returningClause = regexp.MustCompile(`(?i)\breturning\b`)
+
+	// Rows are decoded after their connection went back to the pool, so they
+	// cannot use its type map
+	typeMaps = sync.Pool{New: func() any { return pgtype.NewMap() }}
+)
+
+// Classify reports the type (INSERT, UPDATE or DELETE) and table of a
+// statement that changes rows. It reads only the leading keywords, so it
+// accepts any Postgres syntax after them.
+func Classify(sql string) (string, string, bool) {
+	words := strings.Fields(leadingComments.ReplaceAllString(sql, ""))
+	next := func(skip ...string) string {
+		for len(words) > 0 && contains(skip, strings.ToLower(words[0])) {
+			words = words[1:]
+		}
+		if len(words) == 0 {
+			return ""
+		}
+		word := words[0]
+		words = words[1:]
+		return word
+	}
+
+	var typ string
+	switch strings.ToLower(next()) {
+	case "insert":
+		typ = "INSERT"
+		if strings.ToLower(next()) != "into" {
+			return "", "", false
+		}
+	case "update":
+		typ = "UPDATE"
+	case "delete":
+		typ = "DELETE"
+		if strings.ToLower(next()) != "from" {
+			return "", "", false
+		}
+	default:
+		return "", "", false
+	}
+
+	table := next("only")
+	if i := strings.IndexByte(table, '('); i >= 0 {
+		table = table[:i]
+	}
+	if i := strings.LastIndexByte(table, '.'); i >= 0 {
+		table = table[i+1:]
+	}
+	table = strings.Trim(table, `"`)
+	if table == "" {
+		return "", "", false
+	}
+	return typ, table, true
+}
+
+func contains(words []string, word string) bool {
+	for _, w := range words {
+		if w == word {
+			return true
+		}
+	}
+	return false
+}
+
+// withReturning makes a change statement return every column of its rows
+func withReturning(sql string) string {
+	if returningClause.MatchString(sql) {
+		return sql
+	}
+	return strings.TrimRight(strings.TrimSpace(sql), ";") + " RETURNING *"
+}
+
+// Row is one row returned by a captured statement. Columns are looked up by
+// name, so handlers do not depend on the column order of a RETURNING clause.
+type Row struct {
+	fields []pgconn.FieldDescription
+	values [][]byte
+}
+
+// NewRow builds a row from wire-format values, as read from pgx.Rows.RawValues
+func NewRow(fields []pgconn.FieldDescription, values [][]byte) *Row {
+	return &Row{fields: fields, values: values}
+}
+
+// Has reports whether the row contains the column
+func (r *Row) Has(column string) bool {
+	return r.index(column) >= 0
+}
+
+// Scan decodes a column into dest
+func (r *Row) Scan(column string, dest any) error {
+	i := r.index(column)
+	if i < 0 {
+		return fmt.Errorf("mypg: no column %q", column)
+	}
+	return r.scan(i, dest)
+}
+
+// ScanStruct decodes every column whose name matches the json tag of a field
+// of the struct dest points to, such as the generated repo models. Fields
+// without a column keep their value.
+func (r *Row) ScanStruct(dest any) error {
+	value := reflect.ValueOf(dest)
+	if value.Kind() != reflect.Pointer || value.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("mypg: ScanStruct needs a struct pointer, got %T", dest)
+	}
+	value = value.Elem()
+	for i := 0; i < value.NumField(); i++ {
+		column, _, _ := strings.Cut(value.Type().Field(i).Tag.Get("json"), ",")
+		idx := r.index(column)
+		if column == "" || idx < 0 {
+			continue
+		}
+		if err := r.scan(idx, value.Field(i).Addr().Interface()); err != nil {
+			return fmt.Errorf("mypg: column %q: %w", column, err)
+		}
+	}
+	return nil
+}
+
+func (r *Row) index(column string) int {
+	for i, field := range r.fields {
+		if field.Name == column {
+			return i
+		}
+	}
+	return -1
+}
+
+func (r *Row) scan(i int, dest any) error {
+	typeMap := typeMaps.Get().(*pgtype.Map)
+	defer typeMaps.Put(typeMap)
+	field := r.fields[i]
+	return typeMap.Scan(field.DataTypeOID, field.Format, r.values[i], dest)
+}
+
+// captureRows records every row the caller reads and hands them to done once
+// the rows are exhausted or closed without error
+type captureRows struct {
+	pgx.Rows
+	captured []*Row
+	done     func([]*Row)
+	once     sync.Once
+}
+
+func newCaptureRows(rows pgx.Rows, done func([]*Row)) *captureRows {
+	return &captureRows{Rows: rows, done: done}
+}
+
+func (r *captureRows) Next() bool {
+	if !r.Rows.Next() {
+		r.finish()
+		return false
+	}
+	raw := r.Rows.RawValues()
+	values := make([][]byte, len(raw))
+	for i, value := range raw {
+		if value != nil {
+			values[i] = append([]byte{}, value...)
+		}
+	}
+	r.captured = append(r.captured, NewRow(r.Rows.FieldDescriptions(), values))
+	return true
+}
+
+func (r *captureRows) Close() {
+	r.Rows.Close()
+	r.finish()
+}
+
+func (r *captureRows) finish() {
+	r.once.Do(func() {
+		if r.Rows.Err() == nil {
+			r.done(r.captured)
+		}
+	})
+}
+
+// captureRow reads the first row of captured rows, like pgx does for QueryRow
+type captureRow struct {
+	rows pgx.Rows
+	err  error
+}
+
+func (r *captureRow) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+	defer r.rows.Close()
+
+	if !r.rows.Next() {
+		if err := r.rows.Err(); err != nil {
+			return err
+		}
+		return pgx.ErrNoRows
+	}
+	if err := r.rows.Scan(dest...); err != nil {
+		return err
+	}
+	r.rows.Close()
+	return r.rows.Err()
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/jackc/pgx/v5"
 	"github.com/xwb1989/sqlparser"
 )
 
@@ -14,7 +13,7 @@ type QueryData struct {
 	Columns    map[string]string // SET or INSERT columns
 	WherePairs map[string]string // WHERE condition as key:value
 	SelectCols []string
-	Data       pgx.Row
+	Rows       []*Row // Rows returned by a captured change
 }
 
 func ParseWhere(expr *sqlparser.Where) map[string]string {
@@ -50,85 +49,29 @@ func ParseWhere(expr *sqlparser.Where) map[string]string {
 	return result
 }
 
-func ReactiveToPublish(sql string, data pgx.Row, args ...interface{}) (*QueryData, error) {
-	q := Interpolate(sql, args...)
-	stmt, err := sqlparser.Parse(q)
-	if err != nil {
-		return nil, err
+// ReactiveToPublish describes a captured change: its type and table, the
+// rows it returned and, when the statement is simple enough for the parser,
+// its SET/INSERT columns and WHERE pairs
+func ReactiveToPublish(sql string, rows []*Row, args ...interface{}) (*QueryData, error) {
+	typ, table, ok := Classify(sql)
+	if !ok {
+		return nil, fmt.Errorf("unsupported query")
 	}
 
 	res := &QueryData{
+		Type:       typ,
+		Table:      table,
 		Columns:    map[string]string{},
 		WherePairs: map[string]string{},
-		Data:       data,
+		Rows:       rows,
 	}
-
-	switch s := stmt.(type) {
-
-	case *sqlparser.Insert:
-		res.Type = "INSERT"
-		res.Table = s.Table.Name.String()
-
-		// columns
-		cols := []string{}
-		for _, col := range s.Columns {
-			cols = append(cols, col.String())
-		}
-
-		// values
-		values := s.Rows.(sqlparser.Values)[0]
-		for i, val := range values {
-			res.Columns[cols[i]] = sqlparser.String(val)
-		}
-
-	// ----------------------------------------
-	// UPDATE
-	// ----------------------------------------
-	case *sqlparser.Update:
-		res.Type = "UPDATE"
-		table := s.TableExprs[0].(*sqlparser.AliasedTableExpr)
-		res.Table = sqlparser.String(table.Expr)
-
-		// SET column = value
-		for _, expr := range s.Exprs {
-			res.Columns[expr.Name.Name.String()] = sqlparser.String(expr.Expr)
-		}
-
-		// WHERE
-		res.WherePairs = ParseWhere(s.Where)
-
-	// ----------------------------------------
-	// DELETE
-	// ----------------------------------------
-	case *sqlparser.Delete:
-		res.Type = "DELETE"
-		table := s.TableExprs[0].(*sqlparser.AliasedTableExpr)
-		res.Table = sqlparser.String(table.Expr)
-
-		res.WherePairs = ParseWhere(s.Where)
-
-	// ----------------------------------------
-	// SELECT
-	// ----------------------------------------
-	case *sqlparser.Select:
-		res.Type = "SELECT"
-		table := s.From[0].(*sqlparser.AliasedTableExpr)
-		res.Table = sqlparser.String(table.Expr)
-
-		for _, col := range s.SelectExprs {
-			if c, ok := col.(*sqlparser.AliasedExpr); ok {
-				res.SelectCols = append(res.SelectCols, sqlparser.String(c.Expr))
-			}
-		}
-
-		res.WherePairs = ParseWhere(s.Where)
-
-	default:
-		return nil, fmt.Errorf("unsupported query")
+	if parsed, err := Parser(sql, args...); err == nil {
+		res.Columns = parsed.Columns
+		res.WherePairs = parsed.WherePairs
 	}
-
 	return res, nil
 }
+
 func Parser(sql string, args ...interface{}) (*QueryData, error) {
 	q := Interpolate(sql, args...)
 	stmt, err := sqlparser.Parse(q)
@@ -209,8 +152,9 @@ func Parser(sql string, args ...interface{}) (*QueryData, error) {
 
 }
 func Interpolate(sql string, args ...interface{}) string {
-
-	for i, arg := range args {
+	// Highest placeholder first, so that $1 does not match the start of $10
+	for i := len(args) - 1; i >= 0; i-- {
+		arg := args[i]
 		placeholder := fmt.Sprintf("$%d", i+1)
 
 		switch v := arg.(type) {
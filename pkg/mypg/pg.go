@@ -2,54 +2,108 @@ package mypg
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// DBTX is the part of a pool PostgresDB wraps. It matches repo.DBTX, so a
+// PostgresDB can back the generated queries, and lets tests use a stub pool.
+type DBTX interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+// Handler receives every change captured by a PostgresDB
+type Handler func(QueryData)
+
+// PostgresDB captures the changes made through it. Every INSERT, UPDATE and
+// DELETE is handed to the handler together with the rows it returned once the
+// caller is done reading them. Statements run in transactions begun on the
+// underlying pool are not captured.
 type PostgresDB struct {
-	*pgxpool.Pool
+	db      DBTX
+	pool    *pgxpool.Pool
+	handler Handler
+}
+
+func NewPostgresDB(db *pgxpool.Pool, handler Handler) *PostgresDB {
+	return &PostgresDB{
+		db:      db,
+		pool:    db,
+		handler: handler,
+	}
 }
 
-func NewPostgresDB(db *pgxpool.Pool) *PostgresDB {
+// New wraps any DBTX, such as a stub pool in tests
+func New(db DBTX, handler Handler) *PostgresDB {
 	return &PostgresDB{
-		Pool: db,
+		db:      db,
+		handler: handler,
 	}
 }
 
 func (p *PostgresDB) CloseDB() {
-	p.Close()
+	if p.pool != nil {
+		p.pool.Close()
+	}
 }
 
 func (p *PostgresDB) GetPool() *pgxpool.Pool {
-	return p.Pool
+	return p.pool
 }
 
 func (p *PostgresDB) Ping(ctx context.Context) error {
-	return p.Pool.Ping(ctx)
+	return p.pool.Ping(ctx)
 }
 
+// Exec runs changes as a query returning every column of the affected rows,
+// so that they can be captured like the result of a RETURNING clause
 func (p *PostgresDB) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
-	return p.Pool.Exec(ctx, sql, args...)
+	if _, _, ok := Classify(sql); !ok {
+		return p.db.Exec(ctx, sql, args...)
+	}
 
+	rows, err := p.Query(ctx, withReturning(sql), args...)
+	if err != nil {
+		return pgconn.CommandTag{}, err
+	}
+	for rows.Next() {
+	}
+	rows.Close()
+	return rows.CommandTag(), rows.Err()
 }
 
 func (p *PostgresDB) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
-	v, err := p.Pool.Query(ctx, sql, args...)
+	rows, err := p.db.Query(ctx, sql, args...)
 	if err != nil {
-		return v, err
+		return rows, err
 	}
-	fmt.Println("query:", sql, args)
-	go ReactiveToPublish(sql, v, args...)
-	return v, err
-
+	if _, _, ok := Classify(sql); !ok {
+		return rows, nil
+	}
+	return newCaptureRows(rows, func(captured []*Row) {
+		p.publish(sql, captured, args...)
+	}), nil
 }
 
 func (p *PostgresDB) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
-	v := p.Pool.QueryRow(ctx, sql, args...)
-	fmt.Println("query:", sql, args)
-	go ReactiveToPublish(sql, v, args...)
-	return v
+	if _, _, ok := Classify(sql); !ok {
+		return p.db.QueryRow(ctx, sql, args...)
+	}
+	rows, err := p.Query(ctx, sql, args...)
+	return &captureRow{rows: rows, err: err}
+}
+
+func (p *PostgresDB) publish(sql string, rows []*Row, args ...interface{}) {
+	if p.handler == nil {
+		return
+	}
+	data, err := ReactiveToPublish(sql, rows, args...)
+	if err != nil {
+		return
+	}
+	p.handler(*data)
 }
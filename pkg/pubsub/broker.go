@@ -0,0 +1,92 @@
+package pubsub
+
+import (
+	"errors"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ErrUnsupportedPayload is returned for payloads a broker cannot serialise.
+// Such messages still reach the subscribers of the local instance.
+var ErrUnsupportedPayload = errors.New("pubsub: payload is not a proto message")
+
+// Message is a publication travelling between PubSub instances
+type Message struct {
+	Origin string // ID of the publishing instance
+	Topic  string
+	Data   interface{}
+}
+
+// Broker carries messages between the PubSub instances of several replicas.
+// Every attached instance receives every message, including its own.
+type Broker interface {
+	Publish(msg Message) error
+	Attach(handler func(Message))
+	Close() error
+}
+
+// EncodePayload serialises a proto payload together with its type
+func EncodePayload(data interface{}) ([]byte, error) {
+	message, ok := data.(proto.Message)
+	if !ok {
+		return nil, ErrUnsupportedPayload
+	}
+	packed, err := anypb.New(message)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(packed)
+}
+
+// DecodePayload restores a payload serialised by EncodePayload
+func DecodePayload(payload []byte) (interface{}, error) {
+	var packed anypb.Any
+	if err := proto.Unmarshal(payload, &packed); err != nil {
+		return nil, err
+	}
+	return packed.UnmarshalNew()
+}
+
+// MemoryBroker connects PubSub instances of one process. Payloads go through
+// the same serialisation as with a distributed broker.
+type MemoryBroker struct {
+	mu       sync.RWMutex
+	handlers []func(Message)
+}
+
+func NewMemoryBroker() *MemoryBroker {
+	return &MemoryBroker{}
+}
+
+func (b *MemoryBroker) Publish(msg Message) error {
+	payload, err := EncodePayload(msg.Data)
+	if err != nil {
+		return err
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for _, handler := range b.handlers {
+		data, err := DecodePayload(payload)
+		if err != nil {
+			return err
+		}
+		handler(Message{Origin: msg.Origin, Topic: msg.Topic, Data: data})
+	}
+	return nil
+}
+
+func (b *MemoryBroker) Attach(handler func(Message)) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers = append(b.handlers, handler)
+}
+
+func (b *MemoryBroker) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers = nil
+	return nil
+}
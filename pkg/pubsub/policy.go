@@ -0,0 +1,97 @@
+package pubsub
+
+import (
+	"errors"
+	"sync/atomic"
+	"time"
+)
+
+// Policy decides what Publish does when a subscriber's buffer is full
+type Policy int
+
+const (
+	// DropNewest discards the message being published
+	DropNewest Policy = iota
+	// DropOldest discards the oldest buffered message to make room
+	DropOldest
+	// Block waits up to the subscription timeout for room, then drops
+	Block
+	// Disconnect closes the subscription; Channel.Err reports ErrSlowConsumer
+	Disconnect
+)
+
+const (
+	DefaultBufferSize   = 100
+	DefaultBlockTimeout = time.Second
+)
+
+// ErrSlowConsumer is reported by a subscription closed by the Disconnect policy
+var ErrSlowConsumer = errors.New("pubsub: subscriber too slow, disconnected")
+
+type options struct {
+	policy     Policy
+	bufferSize int
+	timeout    time.Duration
+}
+
+// Option configures a subscription
+type Option func(*options)
+
+// WithPolicy sets the backpressure policy of a subscription
+func WithPolicy(policy Policy) Option {
+	return func(o *options) {
+		o.policy = policy
+	}
+}
+
+// WithBufferSize sets how many messages a subscription buffers
+func WithBufferSize(size int) Option {
+	return func(o *options) {
+		if size > 0 {
+			o.bufferSize = size
+		}
+	}
+}
+
+// WithTimeout sets how long the Block policy waits for room
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		if timeout > 0 {
+			o.timeout = timeout
+		}
+	}
+}
+
+func newOptions(opts []Option) options {
+	o := options{
+		policy:     DropNewest,
+		bufferSize: DefaultBufferSize,
+		timeout:    DefaultBlockTimeout,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// TopicStats counts the messages of one topic. Published counts Publish calls,
+// Delivered and Dropped count per subscriber.
+type TopicStats struct {
+	Published uint64
+	Delivered uint64
+	Dropped   uint64
+}
+
+type topicCounters struct {
+	published atomic.Uint64
+	delivered atomic.Uint64
+	dropped   atomic.Uint64
+}
+
+func (c *topicCounters) snapshot() TopicStats {
+	return TopicStats{
+		Published: c.published.Load(),
+		Delivered: c.delivered.Load(),
+		Dropped:   c.dropped.Load(),
+	}
+}
@@ -1,6 +1,13 @@
 package pubsub
 
-import "sync"
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"log"
+	"sync"
+	"time"
+)
 
 type Channel struct {
 	ch           chan interface{}
@@ -9,15 +16,27 @@ type Channel struct {
 	isBulk       bool
 	bulkTopics   []string
 	bulkChannels []chan interface{}
+	subs         []*subscription
+	done         chan struct{}
 	closeOnce    sync.Once
+	mu           sync.Mutex
+	err          error
 }
 
 func (c *Channel) Receive() <-chan interface{} {
 	return c.ch
 }
 
+// Err reports why the subscription was closed by the publisher side, if it was
+func (c *Channel) Err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.err
+}
+
 func (c *Channel) Close() {
 	c.closeOnce.Do(func() {
+		close(c.done)
 		if c.isBulk {
 			c.pubsub.cleanupBulkSubscription(c)
 		} else {
@@ -26,9 +45,110 @@ func (c *Channel) Close() {
 	})
 }
 
+func (c *Channel) disconnect() {
+	c.mu.Lock()
+	c.err = ErrSlowConsumer
+	c.mu.Unlock()
+	c.Close()
+}
+
+// subscription is one buffered receiver of a topic with its backpressure policy
+type subscription struct {
+	ch        chan interface{}
+	opts      options
+	owner     *Channel
+	done      chan struct{}
+	mu        sync.RWMutex
+	closed    bool
+	closeOnce sync.Once
+}
+
+func newSubscription(opts options) *subscription {
+	return &subscription{
+		ch:   make(chan interface{}, opts.bufferSize),
+		opts: opts,
+		done: make(chan struct{}),
+	}
+}
+
+// deliver hands data to the subscriber according to its policy and reports
+// whether the subscriber has to be disconnected
+func (s *subscription) deliver(data interface{}, counters *topicCounters) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.closed {
+		return false
+	}
+
+	select {
+	case s.ch <- data:
+		counters.delivered.Add(1)
+		return false
+	default:
+	}
+
+	switch s.opts.policy {
+	case DropOldest:
+		select {
+		case <-s.ch:
+			counters.dropped.Add(1)
+		default:
+		}
+		select {
+		case s.ch <- data:
+			counters.delivered.Add(1)
+		default:
+			counters.dropped.Add(1)
+		}
+	case Block:
+		timer := time.NewTimer(s.opts.timeout)
+		defer timer.Stop()
+		select {
+		case s.ch <- data:
+			counters.delivered.Add(1)
+		case <-s.done:
+			counters.dropped.Add(1)
+		case <-timer.C:
+			counters.dropped.Add(1)
+		}
+	case Disconnect:
+		counters.dropped.Add(1)
+		return true
+	default:
+		counters.dropped.Add(1)
+	}
+	return false
+}
+
+// close closes the subscriber's channel. Publishers blocked on it give up
+// first, so that close never waits for a Block timeout.
+func (s *subscription) close() {
+	s.closeOnce.Do(func() {
+		close(s.done)
+		s.mu.Lock()
+		s.closed = true
+		close(s.ch)
+		s.mu.Unlock()
+	})
+}
+
+// RemoteQueueSize is how many broker messages a topic queues for its local
+// subscribers before further ones are dropped
+const RemoteQueueSize = 1000
+
 type PubSub struct {
-	topics map[string][]chan interface{}
-	mu     sync.RWMutex
+	id       string
+	topics   map[string][]*subscription
+	stats    sync.Map // topic -> *topicCounters
+	broker   Broker
+	remote   map[string]*remoteQueue
+	remoteMu sync.Mutex
+	mu       sync.RWMutex
+}
+
+// remoteQueue holds the broker messages of a topic waiting for delivery
+type remoteQueue struct {
+	data []interface{}
 }
 
 var (
@@ -36,38 +156,113 @@ var (
 	once     sync.Once
 )
 
+// Get returns the PubSub of the process
 func Get() *PubSub {
 	once.Do(func() {
-		instance = &PubSub{
-			topics: make(map[string][]chan interface{}),
-		}
+		instance = New()
 	})
 	return instance
 }
 
+// New creates a PubSub that only delivers locally until a broker is attached
+func New() *PubSub {
+	id := make([]byte, 8)
+	_, _ = rand.Read(id)
+	return &PubSub{
+		id:     hex.EncodeToString(id),
+		topics: make(map[string][]*subscription),
+		remote: make(map[string]*remoteQueue),
+	}
+}
+
+// UseBroker connects the PubSub to the other instances attached to broker.
+// From then on Publish also reaches their subscribers. Messages from the
+// broker are queued per topic, so a subscriber blocking delivery only holds
+// up its own topic and never the broker.
+func (ps *PubSub) UseBroker(broker Broker) {
+	ps.mu.Lock()
+	ps.broker = broker
+	ps.mu.Unlock()
+
+	broker.Attach(func(msg Message) {
+		// Local subscribers already got the instance's own messages
+		if msg.Origin != ps.id {
+			ps.enqueueRemote(msg.Topic, msg.Data)
+		}
+	})
+}
+
+// enqueueRemote queues a broker message for the local subscribers of its
+// topic, starting the topic's delivery goroutine if it is not running
+func (ps *PubSub) enqueueRemote(topic string, data interface{}) {
+	ps.remoteMu.Lock()
+	defer ps.remoteMu.Unlock()
+
+	queue, ok := ps.remote[topic]
+	if !ok {
+		queue = &remoteQueue{}
+		ps.remote[topic] = queue
+		go ps.deliverRemote(topic, queue)
+	}
+	if len(queue.data) >= RemoteQueueSize {
+		ps.counters(topic).dropped.Add(1)
+		return
+	}
+	queue.data = append(queue.data, data)
+}
+
+// deliverRemote publishes the queued messages of a topic in order and stops
+// once the queue is empty
+func (ps *PubSub) deliverRemote(topic string, queue *remoteQueue) {
+	for {
+		ps.remoteMu.Lock()
+		if len(queue.data) == 0 {
+			delete(ps.remote, topic)
+			ps.remoteMu.Unlock()
+			return
+		}
+		data := queue.data[0]
+		queue.data[0] = nil
+		queue.data = queue.data[1:]
+		ps.remoteMu.Unlock()
+
+		ps.publishLocal(topic, data)
+	}
+}
+
 func (ps *PubSub) ListTopic() map[string][]chan interface{} {
 	ps.mu.RLock()
 	defer ps.mu.RUnlock()
 	copy := make(map[string][]chan interface{})
-	for topic, channels := range ps.topics {
-		copy[topic] = append([]chan interface{}{}, channels...)
+	for topic, subs := range ps.topics {
+		channels := make([]chan interface{}, len(subs))
+		for i, sub := range subs {
+			channels[i] = sub.ch
+		}
+		copy[topic] = channels
 	}
 	return copy
 }
 
-func (ps *PubSub) Subscribe(topic string) *Channel {
+// Subscribe subscribes to a topic. Without options a subscription buffers
+// DefaultBufferSize messages and drops new ones once full.
+func (ps *PubSub) Subscribe(topic string, opts ...Option) *Channel {
 	ps.mu.Lock()
 	defer ps.mu.Unlock()
 
-	ch := make(chan interface{}, 100)
-	ps.topics[topic] = append(ps.topics[topic], ch)
+	sub := newSubscription(newOptions(opts))
+	ps.topics[topic] = append(ps.topics[topic], sub)
 
-	return &Channel{
-		ch:     ch,
+	c := &Channel{
+		ch:     sub.ch,
 		topic:  topic,
 		pubsub: ps,
 		isBulk: false,
+		subs:   []*subscription{sub},
+		done:   make(chan struct{}),
 	}
+	sub.owner = c
+	return c
 }
 
 func (ps *PubSub) Exists(topic string) bool {
@@ -81,11 +276,16 @@ func (ps *PubSub) Unsubscribe(topic string, ch chan interface{}) {
 	ps.mu.Lock()
 	defer ps.mu.Unlock()
 
-	channels := ps.topics[topic]
-	for i, channel := range channels {
-		if channel == ch {
-			close(channel)
-			ps.topics[topic] = append(channels[:i], channels[i+1:]...)
+	ps.removeLocked(topic, ch)
+}
+
+// removeLocked removes and closes the subscription of a topic reading ch
+func (ps *PubSub) removeLocked(topic string, ch chan interface{}) {
+	subs := ps.topics[topic]
+	for i, sub := range subs {
+		if sub.ch == ch {
+			sub.close()
+			ps.topics[topic] = append(subs[:i], subs[i+1:]...)
 
 			if len(ps.topics[topic]) == 0 {
 				delete(ps.topics, topic)
@@ -95,25 +295,71 @@ func (ps *PubSub) Unsubscribe(topic string, ch chan interface{}) {
 	}
 }
 
+// Publish delivers data to every subscriber of a topic, on this instance and
+// through the broker on all others, applying each subscription's policy to
+// subscribers whose buffer is full
 func (ps *PubSub) Publish(topic string, data interface{}) {
+	ps.publishLocal(topic, data)
+
 	ps.mu.RLock()
-	defer ps.mu.RUnlock()
+	broker := ps.broker
+	ps.mu.RUnlock()
+	if broker == nil {
+		return
+	}
+	err := broker.Publish(Message{Origin: ps.id, Topic: topic, Data: data})
+	if err != nil && !errors.Is(err, ErrUnsupportedPayload) {
+		log.Printf("pubsub: failed to publish %s to broker: %v", topic, err)
+	}
+}
 
-	for _, ch := range ps.topics[topic] {
-		select {
-		case ch <- data:
-		default:
-			// Channel full, skip
+func (ps *PubSub) publishLocal(topic string, data interface{}) {
+	counters := ps.counters(topic)
+	counters.published.Add(1)
+
+	ps.mu.RLock()
+	subs := append([]*subscription(nil), ps.topics[topic]...)
+	ps.mu.RUnlock()
+
+	for _, sub := range subs {
+		if sub.deliver(data, counters) {
+			sub.owner.disconnect()
 		}
 	}
 }
 
+// Stats returns the message counters of a topic
+func (ps *PubSub) Stats(topic string) TopicStats {
+	if counters, ok := ps.stats.Load(topic); ok {
+		return counters.(*topicCounters).snapshot()
+	}
+	return TopicStats{}
+}
+
+// AllStats returns the message counters of every topic published to so far
+func (ps *PubSub) AllStats() map[string]TopicStats {
+	stats := make(map[string]TopicStats)
+	ps.stats.Range(func(topic, counters any) bool {
+		stats[topic.(string)] = counters.(*topicCounters).snapshot()
+		return true
+	})
+	return stats
+}
+
+func (ps *PubSub) counters(topic string) *topicCounters {
+	if counters, ok := ps.stats.Load(topic); ok {
+		return counters.(*topicCounters)
+	}
+	counters, _ := ps.stats.LoadOrStore(topic, &topicCounters{})
+	return counters.(*topicCounters)
+}
+
 func (ps *PubSub) Close(topic string) {
 	ps.mu.Lock()
 	defer ps.mu.Unlock()
 
-	for _, ch := range ps.topics[topic] {
-		close(ch)
+	for _, sub := range ps.topics[topic] {
+		sub.close()
 	}
 	delete(ps.topics, topic)
 }
@@ -122,25 +368,39 @@ func (ps *PubSub) CloseAll() {
 	ps.mu.Lock()
 	defer ps.mu.Unlock()
 
-	for topic, channels := range ps.topics {
-		for _, ch := range channels {
-			close(ch)
+	for topic, subs := range ps.topics {
+		for _, sub := range subs {
+			sub.close()
 		}
 		delete(ps.topics, topic)
 	}
 }
 
-func (ps *PubSub) BulkSubscribe(topics []string) *Channel {
+// BulkSubscribe subscribes to several topics at once and merges them into one
+// channel. The options apply to the subscription of every topic.
+func (ps *PubSub) BulkSubscribe(topics []string, opts ...Option) *Channel {
+	o := newOptions(opts)
+	c := &Channel{
+		topic:      "BULK",
+		pubsub:     ps,
+		isBulk:     true,
+		bulkTopics: topics,
+		done:       make(chan struct{}),
+	}
+
 	ps.mu.Lock()
 
-	mergedCh := make(chan interface{}, 100)
+	mergedCh := make(chan interface{}, o.bufferSize)
 	internalChannels := make([]chan interface{}, len(topics))
+	subs := make([]*subscription, len(topics))
 
 	// Create internal channels and subscribe to each topic
 	for i, topic := range topics {
-		ch := make(chan interface{}, 100)
-		ps.topics[topic] = append(ps.topics[topic], ch)
-		internalChannels[i] = ch
+		sub := newSubscription(o)
+		sub.owner = c
+		ps.topics[topic] = append(ps.topics[topic], sub)
+		internalChannels[i] = sub.ch
+		subs[i] = sub
 	}
 
 	ps.mu.Unlock()
@@ -152,7 +412,10 @@ func (ps *PubSub) BulkSubscribe(topics []string) *Channel {
 		go func(internalCh chan interface{}) {
 			defer wg.Done()
 			for msg := range internalCh {
-				mergedCh <- msg
+				select {
+				case mergedCh <- msg:
+				case <-c.done:
+				}
 			}
 		}(ch)
 	}
@@ -162,14 +425,10 @@ func (ps *PubSub) BulkSubscribe(topics []string) *Channel {
 		close(mergedCh)
 	}()
 
-	return &Channel{
-		ch:           mergedCh,
-		topic:        "BULK",
-		pubsub:       ps,
-		isBulk:       true,
-		bulkTopics:   topics,
-		bulkChannels: internalChannels,
-	}
+	c.ch = mergedCh
+	c.bulkChannels = internalChannels
+	c.subs = subs
+	return c
 }
 
 func (ps *PubSub) cleanupBulkSubscription(c *Channel) {
@@ -177,19 +436,6 @@ func (ps *PubSub) cleanupBulkSubscription(c *Channel) {
 	defer ps.mu.Unlock()
 
 	for i, topic := range c.bulkTopics {
-		internalCh := c.bulkChannels[i]
-		channels := ps.topics[topic]
-
-		for j, ch := range channels {
-			if ch == internalCh {
-				close(ch)
-				ps.topics[topic] = append(channels[:j], channels[j+1:]...)
-
-				if len(ps.topics[topic]) == 0 {
-					delete(ps.topics, topic)
-				}
-				break
-			}
-		}
+		ps.removeLocked(topic, c.bulkChannels[i])
 	}
 }
@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func TestPubSub(t *testing.T) {
@@ -380,6 +382,187 @@ func TestBulkSubscribeMixedPublish(t *testing.T) {
 	}
 }
 
+func TestDropOldestPolicy(t *testing.T) {
+	ps := Get()
+	defer ps.CloseAll()
+
+	ch := ps.Subscribe("drop-oldest", WithPolicy(DropOldest), WithBufferSize(2))
+	defer ch.Close()
+
+	for i := 0; i < 5; i++ {
+		ps.Publish("drop-oldest", i)
+	}
+
+	// Only the newest messages should be left
+	assert.Equal(t, 3, <-ch.Receive())
+	assert.Equal(t, 4, <-ch.Receive())
+
+	stats := ps.Stats("drop-oldest")
+	assert.Equal(t, uint64(5), stats.Published)
+	assert.Equal(t, uint64(3), stats.Dropped)
+}
+
+func TestBlockPolicy(t *testing.T) {
+	ps := Get()
+	defer ps.CloseAll()
+
+	ch := ps.Subscribe("block", WithPolicy(Block), WithBufferSize(1), WithTimeout(time.Second))
+	defer ch.Close()
+
+	ps.Publish("block", "first")
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		<-ch.Receive()
+	}()
+
+	// Waits for the reader instead of dropping
+	ps.Publish("block", "second")
+	assert.Equal(t, "second", <-ch.Receive())
+	assert.Equal(t, uint64(0), ps.Stats("block").Dropped)
+
+	// Gives up after the timeout
+	ps.Publish("block", "third")
+	start := time.Now()
+	ps.Publish("block", "fourth")
+	assert.GreaterOrEqual(t, time.Since(start), time.Second)
+	assert.Equal(t, uint64(1), ps.Stats("block").Dropped)
+}
+
+func TestDisconnectPolicy(t *testing.T) {
+	ps := Get()
+	defer ps.CloseAll()
+
+	ch := ps.Subscribe("disconnect", WithPolicy(Disconnect), WithBufferSize(1))
+
+	ps.Publish("disconnect", "first")
+	ps.Publish("disconnect", "second")
+
+	// The buffered message is still readable, then the channel is closed
+	assert.Equal(t, "first", <-ch.Receive())
+	_, ok := <-ch.Receive()
+	assert.False(t, ok)
+	assert.ErrorIs(t, ch.Err(), ErrSlowConsumer)
+	assert.False(t, ps.Exists("disconnect"))
+}
+
+func TestStats(t *testing.T) {
+	ps := Get()
+	defer ps.CloseAll()
+
+	ch1 := ps.Subscribe("stats")
+	ch2 := ps.Subscribe("stats", WithBufferSize(1))
+	defer ch1.Close()
+	defer ch2.Close()
+
+	ps.Publish("stats", 1)
+	ps.Publish("stats", 2)
+
+	stats := ps.Stats("stats")
+	assert.Equal(t, uint64(2), stats.Published)
+	assert.Equal(t, uint64(3), stats.Delivered)
+	assert.Equal(t, uint64(1), stats.Dropped)
+	assert.Equal(t, stats, ps.AllStats()["stats"])
+}
+
+func TestBrokerAcrossInstances(t *testing.T) {
+	broker := NewMemoryBroker()
+	defer broker.Close()
+
+	replicaA := New()
+	replicaB := New()
+	replicaA.UseBroker(broker)
+	replicaB.UseBroker(broker)
+	defer replicaA.CloseAll()
+	defer replicaB.CloseAll()
+
+	chA := replicaA.Subscribe("broker")
+	chB := replicaB.Subscribe("broker")
+
+	replicaA.Publish("broker", wrapperspb.String("hello"))
+
+	for _, ch := range []*Channel{chA, chB} {
+		select {
+		case msg := <-ch.Receive():
+			assert.True(t, proto.Equal(wrapperspb.String("hello"), msg.(proto.Message)))
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("Should receive message on both replicas")
+		}
+	}
+
+	// The publishing replica must not get its own message twice
+	select {
+	case msg := <-chA.Receive():
+		t.Errorf("Unexpected duplicate %v", msg)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestBrokerSlowSubscriber(t *testing.T) {
+	broker := NewMemoryBroker()
+	defer broker.Close()
+
+	replicaA := New()
+	replicaB := New()
+	replicaA.UseBroker(broker)
+	replicaB.UseBroker(broker)
+	defer replicaA.CloseAll()
+	defer replicaB.CloseAll()
+
+	slow := replicaB.Subscribe("slow", WithPolicy(Block), WithBufferSize(1), WithTimeout(time.Second))
+	fast := replicaB.Subscribe("fast")
+	defer slow.Close()
+	defer fast.Close()
+
+	// A full blocking subscriber holds up neither the publisher nor other topics
+	start := time.Now()
+	replicaA.Publish("slow", wrapperspb.String("first"))
+	replicaA.Publish("slow", wrapperspb.String("second"))
+	replicaA.Publish("fast", wrapperspb.String("hello"))
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+
+	select {
+	case msg := <-fast.Receive():
+		assert.True(t, proto.Equal(wrapperspb.String("hello"), msg.(proto.Message)))
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("Should receive message while another topic blocks")
+	}
+
+	// The blocked topic still delivers in order once read
+	for _, want := range []string{"first", "second"} {
+		select {
+		case msg := <-slow.Receive():
+			assert.True(t, proto.Equal(wrapperspb.String(want), msg.(proto.Message)))
+		case <-time.After(time.Second):
+			t.Fatalf("Should receive %s", want)
+		}
+	}
+}
+
+func TestBrokerUnsupportedPayload(t *testing.T) {
+	broker := NewMemoryBroker()
+	defer broker.Close()
+
+	replicaA := New()
+	replicaB := New()
+	replicaA.UseBroker(broker)
+	replicaB.UseBroker(broker)
+	defer replicaA.CloseAll()
+	defer replicaB.CloseAll()
+
+	chA := replicaA.Subscribe("unsupported")
+	chB := replicaB.Subscribe("unsupported")
+
+	// Payloads that cannot be serialised stay on the local instance
+	replicaA.Publish("unsupported", "plain string")
+
+	assert.Equal(t, "plain string", <-chA.Receive())
+	select {
+	case msg := <-chB.Receive():
+		t.Errorf("Unexpected message %v on other replica", msg)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
 func TestCh(t *testing.T) {
 	ch := make(chan any, 100)
 
@@ -0,0 +1,100 @@
+package pubsub
+
+import (
+	"context"
+	"log"
+
+	"discord/pkg/redpanda"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+const originHeader = "origin"
+
+// RedpandaBroker fans every pubsub topic into one Redpanda topic, keyed by
+// the pubsub topic. Each replica consumes all partitions without a consumer
+// group, so that every replica sees every message.
+type RedpandaBroker struct {
+	client *kgo.Client
+	topic  string
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func NewRedpandaBroker(brokers []string, topic string) *RedpandaBroker {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &RedpandaBroker{
+		client: redpanda.New(redpanda.Config{
+			Brokers:      brokers,
+			Topics:       []string{topic},
+			AutoCreate:   true,
+			OffsetLatest: true,
+		}),
+		topic:  topic,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Publish produces the message asynchronously; delivery errors are logged
+func (b *RedpandaBroker) Publish(msg Message) error {
+	payload, err := EncodePayload(msg.Data)
+	if err != nil {
+		return err
+	}
+
+	record := &kgo.Record{
+		Topic:   b.topic,
+		Key:     []byte(msg.Topic),
+		Value:   payload,
+		Headers: []kgo.RecordHeader{{Key: originHeader, Value: []byte(msg.Origin)}},
+	}
+	b.client.Produce(b.ctx, record, func(_ *kgo.Record, err error) {
+		if err != nil && b.ctx.Err() == nil {
+			log.Printf("pubsub: failed to produce to %s: %v", msg.Topic, err)
+		}
+	})
+	return nil
+}
+
+// Attach starts consuming. A RedpandaBroker serves a single PubSub instance.
+func (b *RedpandaBroker) Attach(handler func(Message)) {
+	go func() {
+		for {
+			fetches := b.client.PollFetches(b.ctx)
+			if b.ctx.Err() != nil {
+				return
+			}
+			fetches.EachError(func(topic string, partition int32, err error) {
+				log.Printf("pubsub: failed to fetch %s/%d: %v", topic, partition, err)
+			})
+			fetches.EachRecord(func(record *kgo.Record) {
+				data, err := DecodePayload(record.Value)
+				if err != nil {
+					log.Printf("pubsub: failed to decode message on %s: %v", record.Key, err)
+					return
+				}
+				handler(Message{
+					Origin: origin(record),
+					Topic:  string(record.Key),
+					Data:   data,
+				})
+			})
+		}
+	}()
+}
+
+func (b *RedpandaBroker) Close() error {
+	b.cancel()
+	b.client.Close()
+	return nil
+}
+
+func origin(record *kgo.Record) string {
+	for _, header := range record.Headers {
+		if header.Key == originHeader {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
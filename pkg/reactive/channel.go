@@ -6,16 +6,20 @@ import (
 	"discord/pkg/mypg"
 	"discord/pkg/pubsub"
 	"strconv"
-	"strings"
 )
 
 type ChannelReactive struct{}
 
 func (s ChannelReactive) ReactChannel(pg mypg.QueryData) {
-	pgtype := strings.ToLower(pg.Type)
-	switch pgtype {
-	case "insert", "update", "delete":
-		channel := s.convertToChannel(pg)
+	op := operation(pg)
+	if op == "" {
+		return
+	}
+	for _, row := range pg.Rows {
+		channel, err := s.convertToChannel(op, row)
+		if err != nil {
+			continue
+		}
 		s.publish(channel)
 	}
 }
@@ -26,36 +30,24 @@ func (s ChannelReactive) publish(data *schema.Channel) {
 	pub.Publish(topic, data)
 }
 
-func (s ChannelReactive) convertToChannel(pg mypg.QueryData) *schema.Channel {
+func (s ChannelReactive) convertToChannel(op string, row *mypg.Row) (*schema.Channel, error) {
 	var value repo.Channel
-	pg.Data.Scan(
-		&value.ID,
-		&value.ServerID,
-		&value.CategoryID,
-		&value.Name,
-		&value.Type,
-		&value.Position,
-		&value.Topic,
-		&value.IsNsfw,
-		&value.SlowmodeDelay,
-		&value.UserLimit,
-		&value.Bitrate,
-		&value.IsPrivate,
-		&value.IsDeleted,
-		&value.CreatedAt,
-		&value.UpdatedAt,
-	)
-	return &schema.Channel{
-		Id:          value.ID,
-		ServerId:    value.ServerID,
-		CategoryId:  value.CategoryID.Int32,
-		Name:        value.Name,
-		Position:    value.Position.Int32,
-		Description: value.Topic.String,
-		IsNsfw:      value.IsNsfw.Bool,
-		IsDeleted:   value.IsDeleted.Bool,
-		CreatedAt:   value.CreatedAt.Time.Unix(),
-		UpdatedAt:   value.UpdatedAt.Time.Unix(),
-		Operation:   &pg.Type,
+	if err := row.ScanStruct(&value); err != nil {
+		return nil, err
 	}
+	return &schema.Channel{
+		Id:            value.ID,
+		ServerId:      value.ServerID,
+		CategoryId:    value.CategoryID.Int32,
+		Name:          value.Name,
+		Position:      value.Position.Int32,
+		Description:   value.Topic.String,
+		Topic:         value.Topic.String,
+		IsNsfw:        value.IsNsfw.Bool,
+		SlowmodeDelay: value.SlowmodeDelay.Int32,
+		IsDeleted:     value.IsDeleted.Bool,
+		CreatedAt:     value.CreatedAt.Time.Unix(),
+		UpdatedAt:     value.UpdatedAt.Time.Unix(),
+		Operation:     &op,
+	}, nil
 }
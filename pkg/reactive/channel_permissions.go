@@ -10,8 +10,16 @@ import (
 type ChannelPermissionReactive struct{}
 
 func (s ChannelPermissionReactive) ReactChannelPermission(pg mypg.QueryData) {
-	permission := s.convertToChannelPermission(pg)
-	s.publish(permission)
+	if operation(pg) == "" {
+		return
+	}
+	for _, row := range pg.Rows {
+		permission, err := s.convertToChannelPermission(row)
+		if err != nil {
+			continue
+		}
+		s.publish(permission)
+	}
 }
 
 func (s ChannelPermissionReactive) publish(data *repo.ChannelPermission) {
@@ -20,17 +28,10 @@ func (s ChannelPermissionReactive) publish(data *repo.ChannelPermission) {
 	pub.Publish(topic, data)
 }
 
-func (s ChannelPermissionReactive) convertToChannelPermission(pg mypg.QueryData) *repo.ChannelPermission {
+func (s ChannelPermissionReactive) convertToChannelPermission(row *mypg.Row) (*repo.ChannelPermission, error) {
 	var value repo.ChannelPermission
-	pg.Data.Scan(
-		&value.ID,
-		&value.ChannelID,
-		&value.RoleID,
-		&value.UserID,
-		&value.AllowPermissions,
-		&value.DenyPermissions,
-		&value.CreatedAt,
-		&value.UpdatedAt,
-	)
-	return &value
+	if err := row.ScanStruct(&value); err != nil {
+		return nil, err
+	}
+	return &value, nil
 }
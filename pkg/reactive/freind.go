@@ -6,23 +6,21 @@ import (
 	"discord/pkg/mypg"
 	"discord/pkg/pubsub"
 	"strconv"
-	"strings"
 )
 
 type FriendReactive struct{}
 
 func (s FriendReactive) ReactFriend(pg mypg.QueryData) {
-	pgtype := strings.ToLower(pg.Table)
-	switch pgtype {
-	case "insert":
-		user := s.convertToUser(pg)
-		s.publish(user)
-	case "update":
-		user := s.convertToUser(pg)
-		s.publish(user)
-	case "delete":
-		user := s.convertToUser(pg)
-		s.publish(user)
+	op := operation(pg)
+	if op == "" {
+		return
+	}
+	for _, row := range pg.Rows {
+		friend, err := s.convertToFriend(op, row)
+		if err != nil {
+			continue
+		}
+		s.publish(friend)
 	}
 }
 
@@ -34,22 +32,11 @@ func (s FriendReactive) publish(data *schema.Friend) {
 	pub.Publish(topc, data)
 }
 
-func (s FriendReactive) convertToUser(pg mypg.QueryData) *schema.Friend {
+func (s FriendReactive) convertToFriend(op string, row *mypg.Row) (*schema.Friend, error) {
 	var value repo.Friend
-	pg.Data.Scan(
-		&value.ID,
-		&value.UserID,
-		&value.FriendID,
-		&value.AliasName,
-		&value.IsDeleted,
-		&value.IsFavorite,
-		&value.IsBlocked,
-		&value.CreatedAt,
-		&value.UpdatedAt,
-		&value.IsPending,
-		&value.IsAccepted,
-		&value.IsMuted,
-	)
+	if err := row.ScanStruct(&value); err != nil {
+		return nil, err
+	}
 	return &schema.Friend{
 		Id:         value.ID,
 		UserId:     value.UserID,
@@ -63,7 +50,6 @@ func (s FriendReactive) convertToUser(pg mypg.QueryData) *schema.Friend {
 		IsPending:  value.IsPending.Bool,
 		IsAccepted: value.IsAccepted.Bool,
 		IsMuted:    value.IsMuted.Bool,
-		Operation:  &pg.Type,
-	}
-
+		Operation:  &op,
+	}, nil
 }
@@ -10,8 +10,16 @@ import (
 type MemberRoleReactive struct{}
 
 func (s MemberRoleReactive) ReactMemberRole(pg mypg.QueryData) {
-	memberRole := s.convertToMemberRole(pg)
-	s.publish(memberRole)
+	if operation(pg) == "" {
+		return
+	}
+	for _, row := range pg.Rows {
+		memberRole, err := s.convertToMemberRole(row)
+		if err != nil {
+			continue
+		}
+		s.publish(memberRole)
+	}
 }
 
 func (s MemberRoleReactive) publish(data *repo.MemberRole) {
@@ -20,14 +28,10 @@ func (s MemberRoleReactive) publish(data *repo.MemberRole) {
 	pub.Publish(topic, data)
 }
 
-func (s MemberRoleReactive) convertToMemberRole(pg mypg.QueryData) *repo.MemberRole {
+func (s MemberRoleReactive) convertToMemberRole(row *mypg.Row) (*repo.MemberRole, error) {
 	var value repo.MemberRole
-	pg.Data.Scan(
-		&value.ID,
-		&value.MemberID,
-		&value.RoleID,
-		&value.AssignedAt,
-		&value.UpdatedAt,
-	)
-	return &value
+	if err := row.ScanStruct(&value); err != nil {
+		return nil, err
+	}
+	return &value, nil
 }
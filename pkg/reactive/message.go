@@ -6,50 +6,47 @@ import (
 	"discord/pkg/mypg"
 	"discord/pkg/pubsub"
 	"strconv"
-	"strings"
 )
 
 type MessageRective struct{}
 
 func (s MessageRective) ReactMessage(pg mypg.QueryData) {
-	pgtype := strings.ToLower(pg.Table)
-	switch pgtype {
-	case "insert":
-		user := s.convertToUser(pg)
-		s.publish(user)
-	case "update":
-		user := s.convertToUser(pg)
-		s.publish(user)
-	case "delete":
-		user := s.convertToUser(pg)
-		s.publish(user)
+	op := operation(pg)
+	if op == "" {
+		return
+	}
+	for _, row := range pg.Rows {
+		message, err := s.convertToMessage(op, row)
+		if err != nil {
+			continue
+		}
+		s.publish(message)
 	}
 }
 
+// publish sends channel messages to the channel and direct messages to both
+// participants
 func (s MessageRective) publish(data *schema.Message) {
 	pub := pubsub.Get()
-	topic := "message:" + strconv.Itoa(int(data.ChannelId))
-	pub.Publish(topic, data)
-	// topc := "message:" + strconv.Itoa(int(data.UserId))
-	// pub.Publish(topc, data)
+	if data.IsChannel {
+		topic := "message:" + strconv.Itoa(int(data.ChannelId))
+		pub.Publish(topic, data)
+		return
+	}
+	pub.Publish("dm:"+strconv.Itoa(int(data.ReceiverId)), data)
+	pub.Publish("dm:"+strconv.Itoa(int(data.SenderId)), data)
 }
 
-func (s MessageRective) convertToUser(pg mypg.QueryData) *schema.Message {
+func (s MessageRective) convertToMessage(op string, row *mypg.Row) (*schema.Message, error) {
 	var value repo.Message
-	pg.Data.Scan(
-		&value.ID,
-		&value.ReceiverID,
-		&value.SenderID,
-		&value.Content,
-		&value.MessageType,
-		&value.ReplyToMessageID,
-		&value.MentionEveryone,
-		&value.CreatedAt,
-		&value.UpdatedAt,
-	)
+	if err := row.ScanStruct(&value); err != nil {
+		return nil, err
+	}
 	return &schema.Message{
-		ChannelId:  value.ID,
+		Id:         value.ID,
+		ChannelId:  value.ChannelID.Int32,
 		ReceiverId: value.ReceiverID.Int32,
+		IsChannel:  value.Ischannel.Bool,
 		SenderId:   value.SenderID,
 		Content:    value.Content,
 		ReplyToMessageId: func() int32 {
@@ -58,10 +55,13 @@ func (s MessageRective) convertToUser(pg mypg.QueryData) *schema.Message {
 			}
 			return -1
 		}(),
+		IsEdited:        value.IsEdited.Bool,
+		IsPinned:        value.IsPinned.Bool,
+		IsDeleted:       value.IsDeleted.Bool,
 		MentionEveryone: value.MentionEveryone.Bool,
 		CreatedAt:       value.CreatedAt.Time.Unix(),
 		UpdatedAt:       value.UpdatedAt.Time.Unix(),
-		Operation:       &pg.Type,
-	}
-
+		EditedAt:        value.EditedAt.Time.Unix(),
+		Operation:       &op,
+	}, nil
 }
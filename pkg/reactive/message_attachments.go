@@ -11,8 +11,17 @@ import (
 type MessageAttachmentReactive struct{}
 
 func (s MessageAttachmentReactive) ReactMessageAttachment(pg mypg.QueryData) {
-	attachment := s.convertToMessageAttachment(pg)
-	s.publish(attachment)
+	op := operation(pg)
+	if op == "" {
+		return
+	}
+	for _, row := range pg.Rows {
+		attachment, err := s.convertToMessageAttachment(op, row)
+		if err != nil {
+			continue
+		}
+		s.publish(attachment)
+	}
 }
 
 func (s MessageAttachmentReactive) publish(data *schema.MessageAttachment) {
@@ -21,20 +30,11 @@ func (s MessageAttachmentReactive) publish(data *schema.MessageAttachment) {
 	pub.Publish(topic, data)
 }
 
-func (s MessageAttachmentReactive) convertToMessageAttachment(pg mypg.QueryData) *schema.MessageAttachment {
+func (s MessageAttachmentReactive) convertToMessageAttachment(op string, row *mypg.Row) (*schema.MessageAttachment, error) {
 	var value repo.MessageAttachment
-	pg.Data.Scan(
-		&value.ID,
-		&value.MessageID,
-		&value.FileUrl,
-		&value.FileName,
-		&value.FileType,
-		&value.FileSize,
-		&value.Width,
-		&value.Height,
-		&value.IsDeleted,
-		&value.CreatedAt,
-	)
+	if err := row.ScanStruct(&value); err != nil {
+		return nil, err
+	}
 	return &schema.MessageAttachment{
 		Id:        value.ID,
 		MessageId: value.MessageID,
@@ -46,6 +46,6 @@ func (s MessageAttachmentReactive) convertToMessageAttachment(pg mypg.QueryData)
 		Height:    value.Height.Int32,
 		IsDeleted: value.IsDeleted.Bool,
 		CreatedAt: value.CreatedAt.Time.Unix(),
-		Operation: &pg.Type,
-	}
+		Operation: &op,
+	}, nil
 }
@@ -11,8 +11,17 @@ import (
 type MessageReactionReactive struct{}
 
 func (s MessageReactionReactive) ReactMessageReaction(pg mypg.QueryData) {
-	reaction := s.convertToMessageReaction(pg)
-	s.publish(reaction)
+	op := operation(pg)
+	if op == "" {
+		return
+	}
+	for _, row := range pg.Rows {
+		reaction, err := s.convertToMessageReaction(op, row)
+		if err != nil {
+			continue
+		}
+		s.publish(reaction)
+	}
 }
 
 func (s MessageReactionReactive) publish(data *schema.MessageReaction) {
@@ -21,16 +30,11 @@ func (s MessageReactionReactive) publish(data *schema.MessageReaction) {
 	pub.Publish(topic, data)
 }
 
-func (s MessageReactionReactive) convertToMessageReaction(pg mypg.QueryData) *schema.MessageReaction {
+func (s MessageReactionReactive) convertToMessageReaction(op string, row *mypg.Row) (*schema.MessageReaction, error) {
 	var value repo.MessageReaction
-	pg.Data.Scan(
-		&value.ID,
-		&value.MessageID,
-		&value.UserID,
-		&value.Emoji,
-		&value.EmojiID,
-		&value.CreatedAt,
-	)
+	if err := row.ScanStruct(&value); err != nil {
+		return nil, err
+	}
 	return &schema.MessageReaction{
 		Id:        value.ID,
 		MessageId: value.MessageID,
@@ -38,6 +42,6 @@ func (s MessageReactionReactive) convertToMessageReaction(pg mypg.QueryData) *sc
 		Emoji:     value.Emoji,
 		EmojiId:   value.EmojiID.String,
 		CreatedAt: value.CreatedAt.Time.Unix(),
-		Operation: &pg.Type,
-	}
+		Operation: &op,
+	}, nil
 }
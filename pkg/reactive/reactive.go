@@ -5,6 +5,8 @@ import (
 	"strings"
 )
 
+// ReactiveEvents is the mypg.Handler that publishes captured changes. It
+// dispatches on the table; the table handlers dispatch on the operation.
 func ReactiveEvents(pg mypg.QueryData) {
 	pgtype := strings.ToLower(pg.Table)
 	switch pgtype {
@@ -34,3 +36,14 @@ func ReactiveEvents(pg mypg.QueryData) {
 		// handle unknown table changes
 	}
 }
+
+// operation returns the lower-case operation of a change (insert, update or
+// delete), or "" for anything else
+func operation(pg mypg.QueryData) string {
+	switch op := strings.ToLower(pg.Type); op {
+	case "insert", "update", "delete":
+		return op
+	default:
+		return ""
+	}
+}
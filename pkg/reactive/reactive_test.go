@@ -0,0 +1,148 @@
+package reactive
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"discord/gen/proto/schema"
+	"discord/pkg/mypg"
+	"discord/pkg/pubsub"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// stubPool answers every query with the same text-format rows
+type stubPool struct {
+	fields  []pgconn.FieldDescription
+	values  [][][]byte
+	queries []string
+}
+
+func (p *stubPool) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	p.queries = append(p.queries, sql)
+	return pgconn.NewCommandTag("SELECT 0"), nil
+}
+
+func (p *stubPool) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	p.queries = append(p.queries, sql)
+	return &stubRows{fields: p.fields, values: p.values, i: -1}, nil
+}
+
+func (p *stubPool) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	rows, _ := p.Query(ctx, sql, args...)
+	return rows
+}
+
+type stubRows struct {
+	fields []pgconn.FieldDescription
+	values [][][]byte
+	i      int
+}
+
+func (r *stubRows) Close()                                       {}
+func (r *stubRows) Err() error                                   { return nil }
+func (r *stubRows) CommandTag() pgconn.CommandTag                { return pgconn.NewCommandTag("UPDATE 1") }
+func (r *stubRows) FieldDescriptions() []pgconn.FieldDescription { return r.fields }
+func (r *stubRows) RawValues() [][]byte                          { return r.values[r.i] }
+func (r *stubRows) Conn() *pgx.Conn                              { return nil }
+func (r *stubRows) Values() ([]any, error)                       { return nil, nil }
+
+func (r *stubRows) Next() bool {
+	r.i++
+	return r.i < len(r.values)
+}
+
+func (r *stubRows) Scan(dest ...any) error {
+	return mypg.NewRow(r.fields, r.values[r.i]).Scan(r.fields[0].Name, dest[0])
+}
+
+func userPool() *stubPool {
+	return &stubPool{
+		fields: []pgconn.FieldDescription{
+			{Name: "id", DataTypeOID: pgtype.Int4OID},
+			{Name: "username", DataTypeOID: pgtype.TextOID},
+			{Name: "email", DataTypeOID: pgtype.TextOID},
+			{Name: "status", DataTypeOID: pgtype.TextOID},
+			{Name: "is_bot", DataTypeOID: pgtype.BoolOID},
+		},
+		values: [][][]byte{
+			{[]byte("7"), []byte("ana"), []byte("ana@example.com"), []byte("online"), []byte("f")},
+		},
+	}
+}
+
+func receiveUser(t *testing.T, ch *pubsub.Channel) *schema.User {
+	t.Helper()
+	select {
+	case data := <-ch.Receive():
+		user, ok := data.(*schema.User)
+		if !ok {
+			t.Fatalf("expected *schema.User, got %T", data)
+		}
+		return user
+	case <-time.After(time.Second):
+		t.Fatal("no change published")
+		return nil
+	}
+}
+
+func TestCaptureExecPublishes(t *testing.T) {
+	ch := pubsub.Get().Subscribe("user:7")
+	defer ch.Close()
+
+	pool := userPool()
+	db := mypg.New(pool, ReactiveEvents)
+	if _, err := db.Exec(context.Background(), "UPDATE users SET status = $1 WHERE id = $2", "online", 7); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(pool.queries) != 1 || !strings.HasSuffix(pool.queries[0], "RETURNING *") {
+		t.Fatalf("expected the update to return its rows, got %v", pool.queries)
+	}
+	user := receiveUser(t, ch)
+	if user.Id != 7 || user.Username != "ana" || user.Status != "online" || user.Operation != "update" {
+		t.Fatalf("unexpected user %+v", user)
+	}
+}
+
+func TestCaptureQueryRowPublishes(t *testing.T) {
+	ch := pubsub.Get().Subscribe("user:7")
+	defer ch.Close()
+
+	db := mypg.New(userPool(), ReactiveEvents)
+	var id int32
+	err := db.QueryRow(context.Background(), "INSERT INTO users (username, email) VALUES ($1, $2) RETURNING *", "ana", "ana@example.com").Scan(&id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != 7 {
+		t.Fatalf("expected id 7, got %d", id)
+	}
+
+	if user := receiveUser(t, ch); user.Operation != "insert" {
+		t.Fatalf("expected an insert, got %q", user.Operation)
+	}
+}
+
+func TestCaptureIgnoresSelect(t *testing.T) {
+	var captured []mypg.QueryData
+	db := mypg.New(userPool(), func(data mypg.QueryData) {
+		captured = append(captured, data)
+	})
+
+	rows, err := db.Query(context.Background(), "SELECT * FROM users WHERE id = $1", 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for rows.Next() {
+	}
+	rows.Close()
+
+	if len(captured) != 0 {
+		t.Fatalf("expected no captured changes, got %v", captured)
+	}
+}
@@ -11,8 +11,17 @@ import (
 type RoleReactive struct{}
 
 func (s RoleReactive) ReactRole(pg mypg.QueryData) {
-	role := s.convertToRole(pg)
-	s.publish(role)
+	op := operation(pg)
+	if op == "" {
+		return
+	}
+	for _, row := range pg.Rows {
+		role, err := s.convertToRole(op, row)
+		if err != nil {
+			continue
+		}
+		s.publish(role)
+	}
 }
 
 func (s RoleReactive) publish(data *schema.Role) {
@@ -21,24 +30,11 @@ func (s RoleReactive) publish(data *schema.Role) {
 	pub.Publish(topic, data)
 }
 
-func (s RoleReactive) convertToRole(pg mypg.QueryData) *schema.Role {
+func (s RoleReactive) convertToRole(op string, row *mypg.Row) (*schema.Role, error) {
 	var value repo.Role
-	pg.Data.Scan(
-		&value.ID,
-		&value.ServerID,
-		&value.Name,
-		&value.Color,
-		&value.Hoist,
-		&value.Position,
-		&value.Permissions,
-		&value.Mentionable,
-		&value.Icon,
-		&value.Description,
-		&value.IsDefault,
-		&value.IsDeleted,
-		&value.CreatedAt,
-		&value.UpdatedAt,
-	)
+	if err := row.ScanStruct(&value); err != nil {
+		return nil, err
+	}
 	return &schema.Role{
 		Id:          value.ID,
 		ServerId:    value.ServerID,
@@ -53,6 +49,6 @@ func (s RoleReactive) convertToRole(pg mypg.QueryData) *schema.Role {
 		IsDeleted:   value.IsDeleted.Bool,
 		CreatedAt:   value.CreatedAt.Time.Unix(),
 		UpdatedAt:   value.UpdatedAt.Time.Unix(),
-		Operation:   &pg.Type,
-	}
+		Operation:   &op,
+	}, nil
 }
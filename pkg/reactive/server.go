@@ -6,16 +6,20 @@ import (
 	"discord/pkg/mypg"
 	"discord/pkg/pubsub"
 	"strconv"
-	"strings"
 )
 
 type ServerReactive struct{}
 
 func (s ServerReactive) ReactServer(pg mypg.QueryData) {
-	pgtype := strings.ToLower(pg.Type)
-	switch pgtype {
-	case "insert", "update", "delete":
-		server := s.convertToServer(pg)
+	op := operation(pg)
+	if op == "" {
+		return
+	}
+	for _, row := range pg.Rows {
+		server, err := s.convertToServer(op, row)
+		if err != nil {
+			continue
+		}
 		s.publish(server)
 	}
 }
@@ -26,23 +30,11 @@ func (s ServerReactive) publish(data *schema.Server) {
 	pub.Publish(topic, data)
 }
 
-func (s ServerReactive) convertToServer(pg mypg.QueryData) *schema.Server {
+func (s ServerReactive) convertToServer(op string, row *mypg.Row) (*schema.Server, error) {
 	var value repo.Server
-	pg.Data.Scan(
-		&value.ID,
-		&value.Name,
-		&value.Icon,
-		&value.Banner,
-		&value.Description,
-		&value.OwnerID,
-		&value.Region,
-		&value.MemberCount,
-		&value.IsVerified,
-		&value.VanityUrl,
-		&value.IsDeleted,
-		&value.CreatedAt,
-		&value.UpdatedAt,
-	)
+	if err := row.ScanStruct(&value); err != nil {
+		return nil, err
+	}
 	return &schema.Server{
 		Id:          value.ID,
 		Name:        value.Name,
@@ -56,6 +48,6 @@ func (s ServerReactive) convertToServer(pg mypg.QueryData) *schema.Server {
 		IsDeleted:   value.IsDeleted.Bool,
 		CreatedAt:   value.CreatedAt.Time.Unix(),
 		UpdatedAt:   value.UpdatedAt.Time.Unix(),
-		Operation:   &pg.Type,
-	}
+		Operation:   &op,
+	}, nil
 }
@@ -11,8 +11,16 @@ import (
 type ServerMemberReactive struct{}
 
 func (s ServerMemberReactive) ReactServerMember(pg mypg.QueryData) {
-	member := s.convertToServerMember(pg)
-	s.publish(member)
+	if operation(pg) == "" {
+		return
+	}
+	for _, row := range pg.Rows {
+		member, err := s.convertToServerMember(row)
+		if err != nil {
+			continue
+		}
+		s.publish(member)
+	}
 }
 
 func (s ServerMemberReactive) publish(data *schema.ServerMember) {
@@ -21,18 +29,11 @@ func (s ServerMemberReactive) publish(data *schema.ServerMember) {
 	pub.Publish(topic, data)
 }
 
-func (s ServerMemberReactive) convertToServerMember(pg mypg.QueryData) *schema.ServerMember {
+func (s ServerMemberReactive) convertToServerMember(row *mypg.Row) (*schema.ServerMember, error) {
 	var value repo.ServerMember
-	pg.Data.Scan(
-		&value.ID,
-		&value.ServerID,
-		&value.UserID,
-		&value.Nickname,
-		&value.JoinedAt,
-		&value.IsMuted,
-		&value.IsDeafened,
-		&value.UpdatedAt,
-	)
+	if err := row.ScanStruct(&value); err != nil {
+		return nil, err
+	}
 	return &schema.ServerMember{
 		Id:         value.ID,
 		ServerId:   value.ServerID,
@@ -41,5 +42,5 @@ func (s ServerMemberReactive) convertToServerMember(pg mypg.QueryData) *schema.S
 		JoinedAt:   value.JoinedAt.Time.Unix(),
 		IsMuted:    value.IsMuted.Bool,
 		IsDeafened: value.IsDeafened.Bool,
-	}
+	}, nil
 }
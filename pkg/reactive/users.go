@@ -6,38 +6,35 @@ import (
 	"discord/pkg/mypg"
 	"discord/pkg/pubsub"
 	"strconv"
-	"strings"
 )
 
 type UserReactive struct{}
 
 func (s UserReactive) ReactUser(pg mypg.QueryData) {
-	s.publish(s.convertToUser(pg))
+	op := operation(pg)
+	if op == "" {
+		return
+	}
+	for _, row := range pg.Rows {
+		user, err := s.convertToUser(op, row)
+		if err != nil {
+			continue
+		}
+		s.publish(user)
+	}
 }
 
 func (s UserReactive) publish(user *schema.User) {
 	pub := pubsub.Get()
 	topic := "user:" + strconv.Itoa(int(user.Id))
-	pub.Publish(topic, &user)
+	pub.Publish(topic, user)
 }
 
-func (s UserReactive) convertToUser(pg mypg.QueryData) *schema.User {
+func (s UserReactive) convertToUser(op string, row *mypg.Row) (*schema.User, error) {
 	var value repo.User
-	pg.Data.Scan(
-		&value.ID,
-		&value.Username,
-		&value.Email,
-		&value.FullName,
-		&value.Status,
-		&value.CustomStatus,
-		&value.ProfilePic,
-		&value.BackgroundColor,
-		&value.ColorCode,
-		&value.Bio,
-		&value.IsBot,
-		&value.IsVerified,
-		&value.CreatedAt,
-	)
+	if err := row.ScanStruct(&value); err != nil {
+		return nil, err
+	}
 	return &schema.User{
 		Id:              value.ID,
 		Username:        value.Username,
@@ -51,7 +48,9 @@ func (s UserReactive) convertToUser(pg mypg.QueryData) *schema.User {
 		Bio:             value.Bio.String,
 		IsBot:           value.IsBot.Bool,
 		IsVerified:      value.IsVerified.Bool,
+		IsDeleted:       value.IsDeleted.Bool,
 		CreatedAt:       value.CreatedAt.Time.Unix(),
-		Operation:       strings.ToLower(pg.Type),
-	}
+		UpdatedAt:       value.UpdatedAt.Time.Unix(),
+		Operation:       op,
+	}, nil
 }
@@ -0,0 +1,69 @@
+package throttle
+
+import (
+	"context"
+	"log"
+	"sync/atomic"
+	"time"
+)
+
+// FallbackStore uses a shared store and falls back to a local one while the
+// shared store fails, so an outage weakens the limits instead of lifting them
+type FallbackStore struct {
+	primary  Store
+	fallback Store
+	failing  atomic.Bool
+}
+
+func NewFallbackStore(primary, fallback Store) *FallbackStore {
+	return &FallbackStore{primary: primary, fallback: fallback}
+}
+
+func (s *FallbackStore) Get(ctx context.Context, key string) (State, error) {
+	state, err := s.primary.Get(ctx, key)
+	if s.failed(err) {
+		return s.fallback.Get(ctx, key)
+	}
+	return state, err
+}
+
+func (s *FallbackStore) Reserve(ctx context.Context, key string, now time.Time, ttl time.Duration) (State, error) {
+	state, err := s.primary.Reserve(ctx, key, now, ttl)
+	if s.failed(err) {
+		return s.fallback.Reserve(ctx, key, now, ttl)
+	}
+	return state, err
+}
+
+func (s *FallbackStore) Release(ctx context.Context, key string) error {
+	err := s.primary.Release(ctx, key)
+	if s.failed(err) {
+		return s.fallback.Release(ctx, key)
+	}
+	return err
+}
+
+func (s *FallbackStore) Reset(ctx context.Context, key string) error {
+	err := s.primary.Reset(ctx, key)
+	// Reset both, the local store may hold counts from an earlier outage
+	fallbackErr := s.fallback.Reset(ctx, key)
+	if s.failed(err) {
+		return fallbackErr
+	}
+	return err
+}
+
+// failed reports whether err means the primary store failed, logging when
+// the store starts and stops failing
+func (s *FallbackStore) failed(err error) bool {
+	if err != nil {
+		if !s.failing.Swap(true) {
+			log.Printf("throttle store failed, falling back to local counters: %v", err)
+		}
+		return true
+	}
+	if s.failing.Swap(false) {
+		log.Println("throttle store recovered")
+	}
+	return false
+}
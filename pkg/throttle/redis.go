@@ -0,0 +1,83 @@
+package throttle
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisStore keeps failure counters in Redis so every replica sees the same
+// counts. A counter is a hash of its failure count and the unix milliseconds
+// of the last reserved attempt.
+type RedisStore struct {
+	client redis.UniversalClient
+}
+
+func NewRedisStore(client redis.UniversalClient) *RedisStore {
+	return &RedisStore{client: client}
+}
+
+func (s *RedisStore) Get(ctx context.Context, key string) (State, error) {
+	values, err := s.client.HMGet(ctx, key, "failures", "last").Result()
+	if err != nil {
+		return State{}, err
+	}
+	return parseRedisState(values[0], values[1])
+}
+
+func (s *RedisStore) Reserve(ctx context.Context, key string, now time.Time, ttl time.Duration) (State, error) {
+	var before *redis.SliceCmd
+	_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		before = pipe.HMGet(ctx, key, "failures", "last")
+		pipe.HIncrBy(ctx, key, "failures", 1)
+		pipe.HSet(ctx, key, "last", now.UnixMilli())
+		pipe.PExpire(ctx, key, ttl)
+		return nil
+	})
+	if err != nil {
+		return State{}, err
+	}
+	values := before.Val()
+	return parseRedisState(values[0], values[1])
+}
+
+// releaseScript takes back one attempt and drops the counter once none is
+// left, so that releasing an expired counter does not leave one behind
+var releaseScript = redis.NewScript(`
+if redis.call("HINCRBY", KEYS[1], "failures", -1) <= 0 then
+	redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+func (s *RedisStore) Release(ctx context.Context, key string) error {
+	return releaseScript.Run(ctx, s.client, []string{key}).Err()
+}
+
+func (s *RedisStore) Reset(ctx context.Context, key string) error {
+	return s.client.Del(ctx, key).Err()
+}
+
+func parseRedisState(failures, last interface{}) (State, error) {
+	if failures == nil {
+		return State{}, nil
+	}
+
+	var state State
+	count, err := strconv.Atoi(failures.(string))
+	if err != nil {
+		return State{}, err
+	}
+	state.Failures = count
+
+	if last != nil {
+		millis, err := strconv.ParseInt(last.(string), 10, 64)
+		if err != nil {
+			return State{}, err
+		}
+		state.LastFailure = time.UnixMilli(millis)
+	}
+	return state, nil
+}
@@ -0,0 +1,134 @@
+package throttle
+
+import (
+	"context"
+	"time"
+)
+
+// State is the failure history of a key
+type State struct {
+	Failures    int
+	LastFailure time.Time
+}
+
+// Store keeps failure counters, shared by every replica using the same store.
+// Attempts are counted as failures when they are reserved, before they are
+// made. A counter is forgotten ttl after its last reservation.
+type Store interface {
+	Get(ctx context.Context, key string) (State, error)
+	// Reserve atomically counts an attempt and returns the state before it
+	Reserve(ctx context.Context, key string, now time.Time, ttl time.Duration) (State, error)
+	// Release takes back one reserved attempt
+	Release(ctx context.Context, key string) error
+	Reset(ctx context.Context, key string) error
+}
+
+// Policy decides how long a key waits after failing. The first FreeFailures
+// failures cost nothing, every further one doubles the delay starting at
+// BaseDelay up to MaxDelay. LockoutFailures failures lock the key for
+// LockoutDuration. Failures older than Window are forgotten.
+type Policy struct {
+	FreeFailures    int
+	BaseDelay       time.Duration
+	MaxDelay        time.Duration
+	LockoutFailures int
+	LockoutDuration time.Duration
+	Window          time.Duration
+}
+
+// Delay returns how long after the last of failures the next attempt waits
+func (p Policy) Delay(failures int) time.Duration {
+	if p.LockoutFailures > 0 && failures >= p.LockoutFailures {
+		return p.LockoutDuration
+	}
+	if failures <= p.FreeFailures {
+		return 0
+	}
+
+	delay := p.BaseDelay
+	for i := p.FreeFailures + 1; i < failures && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, p.MaxDelay)
+}
+
+// Limiter applies a Policy to the keys of a Store
+type Limiter struct {
+	store  Store
+	prefix string
+	policy Policy
+	now    func() time.Time
+}
+
+// NewLimiter creates a Limiter. prefix separates its keys from those of
+// other limiters sharing the store.
+func NewLimiter(store Store, prefix string, policy Policy) *Limiter {
+	return &Limiter{
+		store:  store,
+		prefix: prefix,
+		policy: policy,
+		now:    time.Now,
+	}
+}
+
+// Reservation is an attempt counted by Reserve. Wait is how long the key has
+// to wait when the attempt was refused. Locked reports whether the attempt
+// locks the key out unless it is released.
+type Reservation struct {
+	Wait   time.Duration
+	Locked bool
+}
+
+// Wait returns how long key has to wait before its next attempt
+func (l *Limiter) Wait(ctx context.Context, key string) (time.Duration, error) {
+	state, err := l.store.Get(ctx, l.prefix+key)
+	if err != nil {
+		return 0, err
+	}
+	return l.wait(state), nil
+}
+
+// Reserve counts an attempt of key as failed before it is made, so that
+// concurrent attempts cannot all get in before the first failure is recorded.
+// Attempts refused because key has to wait are not counted. An attempt that
+// did not fail is released again.
+func (l *Limiter) Reserve(ctx context.Context, key string) (Reservation, error) {
+	// Refuse waiting keys without touching their counter
+	if wait, err := l.Wait(ctx, key); err != nil || wait > 0 {
+		return Reservation{Wait: wait}, err
+	}
+
+	before, err := l.store.Reserve(ctx, l.prefix+key, l.now(), l.policy.Window)
+	if err != nil {
+		return Reservation{}, err
+	}
+	// Another attempt reserved first and made this one wait
+	if wait := l.wait(before); wait > 0 {
+		if err := l.store.Release(ctx, l.prefix+key); err != nil {
+			return Reservation{}, err
+		}
+		return Reservation{Wait: wait}, nil
+	}
+
+	failures := before.Failures + 1
+	return Reservation{Locked: l.policy.LockoutFailures > 0 && failures == l.policy.LockoutFailures}, nil
+}
+
+// Release takes back a reserved attempt of key that did not fail
+func (l *Limiter) Release(ctx context.Context, key string) error {
+	return l.store.Release(ctx, l.prefix+key)
+}
+
+// Reset forgets the failures of key
+func (l *Limiter) Reset(ctx context.Context, key string) error {
+	return l.store.Reset(ctx, l.prefix+key)
+}
+
+// wait returns how long a key in state has to wait before its next attempt
+func (l *Limiter) wait(state State) time.Duration {
+	if state.Failures <= 0 {
+		return 0
+	}
+	wait := state.LastFailure.Add(l.policy.Delay(state.Failures)).Sub(l.now())
+	return max(wait, 0)
+}
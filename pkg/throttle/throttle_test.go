@@ -0,0 +1,199 @@
+package throttle
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testPolicy = Policy{
+	FreeFailures:    2,
+	BaseDelay:       time.Second,
+	MaxDelay:        10 * time.Second,
+	LockoutFailures: 8,
+	LockoutDuration: time.Hour,
+	Window:          2 * time.Hour,
+}
+
+func TestPolicyDelay(t *testing.T) {
+	tests := []struct {
+		failures int
+		delay    time.Duration
+	}{
+		{0, 0},
+		{1, 0},
+		{2, 0},
+		{3, time.Second},
+		{4, 2 * time.Second},
+		{5, 4 * time.Second},
+		{6, 8 * time.Second},
+		{7, 10 * time.Second},
+		{8, time.Hour},
+		{20, time.Hour},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.delay, testPolicy.Delay(tt.failures), "%d failures", tt.failures)
+	}
+}
+
+// fail reserves an attempt of key that then fails
+func fail(t *testing.T, limiter *Limiter, key string) Reservation {
+	t.Helper()
+	r, err := limiter.Reserve(context.Background(), key)
+	require.NoError(t, err)
+	require.Zero(t, r.Wait, "the attempt was refused")
+	return r
+}
+
+func TestLimiter(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	limiter := NewLimiter(NewMemoryStore(), "account:", testPolicy)
+	limiter.now = func() time.Time { return now }
+
+	for i := 1; i <= 3; i++ {
+		assert.False(t, fail(t, limiter, "alice").Locked)
+	}
+	wait, err := limiter.Wait(ctx, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, time.Second, wait)
+
+	// Refused attempts are not counted
+	r, err := limiter.Reserve(ctx, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, time.Second, r.Wait)
+	state, err := limiter.store.Get(ctx, "account:alice")
+	require.NoError(t, err)
+	assert.Equal(t, 3, state.Failures)
+
+	now = now.Add(time.Second)
+	wait, err = limiter.Wait(ctx, "alice")
+	require.NoError(t, err)
+	assert.Zero(t, wait)
+
+	var locked bool
+	for i := 4; i <= 8; i++ {
+		locked = fail(t, limiter, "alice").Locked
+		now = now.Add(10 * time.Second)
+	}
+	assert.True(t, locked, "the 8th failure locks the key")
+	wait, err = limiter.Wait(ctx, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour-10*time.Second, wait)
+
+	wait, err = limiter.Wait(ctx, "bob")
+	require.NoError(t, err)
+	assert.Zero(t, wait, "other keys are not affected")
+
+	require.NoError(t, limiter.Reset(ctx, "alice"))
+	wait, err = limiter.Wait(ctx, "alice")
+	require.NoError(t, err)
+	assert.Zero(t, wait)
+}
+
+func TestLimiterRelease(t *testing.T) {
+	ctx := context.Background()
+	limiter := NewLimiter(NewMemoryStore(), "ip:", testPolicy)
+
+	for i := 0; i < 10; i++ {
+		fail(t, limiter, "10.0.0.1")
+		require.NoError(t, limiter.Release(ctx, "10.0.0.1"))
+	}
+	state, err := limiter.store.Get(ctx, "ip:10.0.0.1")
+	require.NoError(t, err)
+	assert.Zero(t, state.Failures, "released attempts do not count")
+
+	// Releasing a forgotten counter leaves nothing behind
+	require.NoError(t, limiter.Release(ctx, "10.0.0.2"))
+	state, err = limiter.store.Get(ctx, "ip:10.0.0.2")
+	require.NoError(t, err)
+	assert.Zero(t, state.Failures)
+}
+
+func TestLimiterConcurrentAttempts(t *testing.T) {
+	ctx := context.Background()
+	limiter := NewLimiter(NewMemoryStore(), "account:", testPolicy)
+
+	// Attempts made at once get in only as far as the free failures reach
+	var wg sync.WaitGroup
+	var allowed atomic.Int32
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r, err := limiter.Reserve(ctx, "alice")
+			assert.NoError(t, err)
+			if r.Wait == 0 {
+				allowed.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(testPolicy.FreeFailures+1), allowed.Load())
+
+	state, err := limiter.store.Get(ctx, "account:alice")
+	require.NoError(t, err)
+	assert.Equal(t, testPolicy.FreeFailures+1, state.Failures)
+}
+
+func TestMemoryStoreExpires(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	past := time.Now().Add(-time.Hour)
+
+	_, err := store.Reserve(ctx, "k", past, time.Minute)
+	require.NoError(t, err)
+	state, err := store.Get(ctx, "k")
+	require.NoError(t, err)
+	assert.Zero(t, state.Failures)
+
+	before, err := store.Reserve(ctx, "k", time.Now(), time.Minute)
+	require.NoError(t, err)
+	assert.Zero(t, before.Failures, "an expired counter starts over")
+}
+
+type failingStore struct{}
+
+var errStoreDown = errors.New("store down")
+
+func (failingStore) Get(context.Context, string) (State, error) { return State{}, errStoreDown }
+func (failingStore) Reserve(context.Context, string, time.Time, time.Duration) (State, error) {
+	return State{}, errStoreDown
+}
+func (failingStore) Release(context.Context, string) error { return errStoreDown }
+func (failingStore) Reset(context.Context, string) error   { return errStoreDown }
+
+func TestFallbackStore(t *testing.T) {
+	ctx := context.Background()
+	store := NewFallbackStore(failingStore{}, NewMemoryStore())
+
+	before, err := store.Reserve(ctx, "k", time.Now(), time.Minute)
+	require.NoError(t, err)
+	assert.Zero(t, before.Failures)
+
+	state, err := store.Get(ctx, "k")
+	require.NoError(t, err)
+	assert.Equal(t, 1, state.Failures, "failures are counted locally while the store is down")
+
+	require.NoError(t, store.Reset(ctx, "k"))
+	state, err = store.Get(ctx, "k")
+	require.NoError(t, err)
+	assert.Zero(t, state.Failures)
+}
+
+func TestParseRedisState(t *testing.T) {
+	state, err := parseRedisState(nil, nil)
+	require.NoError(t, err)
+	assert.Zero(t, state.Failures)
+
+	state, err = parseRedisState("3", "1700000000000")
+	require.NoError(t, err)
+	assert.Equal(t, 3, state.Failures)
+	assert.Equal(t, time.UnixMilli(1700000000000), state.LastFailure)
+}
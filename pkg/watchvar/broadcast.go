@@ -9,6 +9,7 @@ type BroadCast[T any] struct {
 	in          chan T
 	subscribers unsafe.Pointer
 	started     int64
+	dropped     uint64
 }
 
 func NewBroadCast[T any]() *BroadCast[T] {
@@ -39,6 +40,8 @@ func (b *BroadCast[T]) start() {
 							}
 							writeIdx++
 						default:
+							// Slow subscribers miss the value and are dropped
+							atomic.AddUint64(&b.dropped, 1)
 						}
 					}()
 				}
@@ -55,9 +58,15 @@ func (b *BroadCast[T]) Send(data T) {
 	select {
 	case b.in <- data:
 	default:
+		atomic.AddUint64(&b.dropped, 1)
 	}
 }
 
+// Dropped counts values lost to a full input or to a slow subscriber
+func (b *BroadCast[T]) Dropped() uint64 {
+	return atomic.LoadUint64(&b.dropped)
+}
+
 func (b *BroadCast[T]) Subscribe() <-chan T {
 	ch := make(chan T, 1)
 	for {
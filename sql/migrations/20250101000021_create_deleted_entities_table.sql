@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS deleted_entities (
+    id SERIAL PRIMARY KEY,
+    entity_type VARCHAR(30) NOT NULL CHECK (entity_type IN ('message', 'direct_message', 'friend', 'server', 'channel', 'role')),
+    entity_id INTEGER NOT NULL,
+    scope_type VARCHAR(20) NOT NULL CHECK (scope_type IN ('user', 'server', 'channel')),
+    scope_id INTEGER NOT NULL,
+    deleted_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+-- Create indexes
+CREATE INDEX idx_deleted_entities_scope ON deleted_entities(scope_type, scope_id, entity_type, deleted_at);
+CREATE INDEX idx_deleted_entities_deleted_at ON deleted_entities(deleted_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_deleted_entities_deleted_at;
+DROP INDEX IF EXISTS idx_deleted_entities_scope;
+DROP TABLE IF EXISTS deleted_entities;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE channels
+    ADD COLUMN IF NOT EXISTS is_archived BOOLEAN DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS archived_at TIMESTAMP;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE channels
+    DROP COLUMN IF EXISTS archived_at,
+    DROP COLUMN IF EXISTS is_archived;
+-- +goose StatementEnd
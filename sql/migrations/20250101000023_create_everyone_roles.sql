@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Every server has exactly one @everyone role that all members implicitly hold
+INSERT INTO roles (server_id, name, position, permissions, is_default)
+SELECT s.id, '@everyone', 0, 104320577, TRUE
+FROM servers s
+WHERE NOT EXISTS (
+    SELECT 1 FROM roles r WHERE r.server_id = s.id AND r.is_default = TRUE
+)
+ON CONFLICT (server_id, name) DO UPDATE SET is_default = TRUE;
+
+CREATE UNIQUE INDEX idx_roles_server_default ON roles(server_id) WHERE is_default = TRUE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_roles_server_default;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Channel messages were inserted without ischannel and defaulted to FALSE
+UPDATE messages SET ischannel = TRUE WHERE channel_id IS NOT NULL AND ischannel = FALSE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 1;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Full-text index used by SearchMessages
+CREATE INDEX IF NOT EXISTS idx_messages_content_search ON messages USING GIN (to_tsvector('english', content))
+WHERE
+    is_deleted = FALSE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_messages_content_search;
+-- +goose StatementEnd
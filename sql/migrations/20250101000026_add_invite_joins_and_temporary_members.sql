@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Which invite a member joined through, and until when a temporary member
+-- may stay before the sweeper removes them
+ALTER TABLE server_members
+ADD COLUMN IF NOT EXISTS invite_id INTEGER REFERENCES invites (id) ON DELETE SET NULL,
+ADD COLUMN IF NOT EXISTS temporary_until TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_server_members_invite_id ON server_members (invite_id);
+
+CREATE INDEX IF NOT EXISTS idx_server_members_temporary_until ON server_members (temporary_until)
+WHERE
+    temporary_until IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_server_members_temporary_until;
+DROP INDEX IF EXISTS idx_server_members_invite_id;
+ALTER TABLE server_members
+DROP COLUMN IF EXISTS temporary_until,
+DROP COLUMN IF EXISTS invite_id;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Members cannot send messages, react, type or speak until the timeout passes
+ALTER TABLE server_members
+ADD COLUMN IF NOT EXISTS communication_disabled_until TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_server_members_communication_disabled_until ON server_members (communication_disabled_until)
+WHERE
+    communication_disabled_until IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_server_members_communication_disabled_until;
+ALTER TABLE server_members
+DROP COLUMN IF EXISTS communication_disabled_until;
+-- +goose StatementEnd
@@ -0,0 +1,60 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Account-wide settings, one row per user created on first change. version
+-- increases on every change so clients can detect stale copies.
+CREATE TABLE IF NOT EXISTS user_settings (
+    user_id INTEGER PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    version INTEGER NOT NULL DEFAULT 1,
+    theme VARCHAR(16) NOT NULL DEFAULT 'dark' CHECK (
+        theme IN ('light', 'dark', 'system')
+    ),
+    locale VARCHAR(16) NOT NULL DEFAULT 'en',
+    show_current_activity BOOLEAN NOT NULL DEFAULT TRUE,
+    dm_privacy VARCHAR(20) NOT NULL DEFAULT 'everyone' CHECK (
+        dm_privacy IN (
+            'everyone',
+            'server_members',
+            'friends'
+        )
+    ),
+    friend_request_privacy VARCHAR(20) NOT NULL DEFAULT 'everyone' CHECK (
+        friend_request_privacy IN (
+            'everyone',
+            'friends_of_friends',
+            'server_members',
+            'none'
+        )
+    ),
+    explicit_content_filter VARCHAR(20) NOT NULL DEFAULT 'non_friends' CHECK (
+        explicit_content_filter IN ('disabled', 'non_friends', 'all')
+    ),
+    enable_notifications BOOLEAN NOT NULL DEFAULT TRUE,
+    default_notification_level VARCHAR(20) NOT NULL DEFAULT 'all' CHECK (
+        default_notification_level IN ('all', 'mentions', 'none')
+    ),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+-- Per-server overrides of the account-wide settings. A NULL
+-- notification_level falls back to default_notification_level.
+CREATE TABLE IF NOT EXISTS user_server_settings (
+    user_id INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    server_id INTEGER NOT NULL REFERENCES servers (id) ON DELETE CASCADE,
+    muted BOOLEAN NOT NULL DEFAULT FALSE,
+    notification_level VARCHAR(20) CHECK (
+        notification_level IN ('all', 'mentions', 'none')
+    ),
+    allow_dms BOOLEAN NOT NULL DEFAULT TRUE,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, server_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_server_settings_server_id ON user_server_settings (server_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_server_settings;
+DROP TABLE IF EXISTS user_settings;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+-- DMs from users who are not friends wait here until the recipient accepts
+-- or ignores them. Accepted requests let both users message each other.
+CREATE TABLE IF NOT EXISTS dm_message_requests (
+    id SERIAL PRIMARY KEY,
+    sender_id INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    recipient_id INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (
+        status IN (
+            'pending',
+            'accepted',
+            'ignored'
+        )
+    ),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    UNIQUE (sender_id, recipient_id),
+    CHECK (sender_id != recipient_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_dm_message_requests_recipient ON dm_message_requests (recipient_id, status);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_dm_message_requests_recipient;
+DROP TABLE IF EXISTS dm_message_requests;
+-- +goose StatementEnd
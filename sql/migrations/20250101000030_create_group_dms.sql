@@ -0,0 +1,51 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Group DMs are channels outside of any server. Every participant keeps the
+-- id of the last message they read, unread counts are derived from it.
+CREATE TABLE IF NOT EXISTS dm_channels (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100),
+    icon VARCHAR(255),
+    owner_id INTEGER REFERENCES users (id) ON DELETE SET NULL,
+    is_group BOOLEAN DEFAULT FALSE,
+    last_message_id INTEGER,
+    last_message_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_dm_channels_owner_id ON dm_channels (owner_id);
+
+CREATE INDEX IF NOT EXISTS idx_dm_channels_last_message_at ON dm_channels (last_message_at DESC);
+
+CREATE TABLE IF NOT EXISTS dm_participants (
+    id SERIAL PRIMARY KEY,
+    dm_channel_id INTEGER NOT NULL REFERENCES dm_channels (id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    last_read_message_id INTEGER,
+    joined_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    UNIQUE (dm_channel_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_dm_participants_dm_channel_id ON dm_participants (dm_channel_id);
+
+CREATE INDEX IF NOT EXISTS idx_dm_participants_user_id ON dm_participants (user_id);
+
+ALTER TABLE messages
+ADD COLUMN IF NOT EXISTS dm_channel_id INTEGER REFERENCES dm_channels (id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_messages_dm_channel_id ON messages (dm_channel_id, id DESC)
+WHERE
+    dm_channel_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_messages_dm_channel_id;
+ALTER TABLE messages DROP COLUMN IF EXISTS dm_channel_id;
+DROP INDEX IF EXISTS idx_dm_participants_user_id;
+DROP INDEX IF EXISTS idx_dm_participants_dm_channel_id;
+DROP TABLE IF EXISTS dm_participants;
+DROP INDEX IF EXISTS idx_dm_channels_last_message_at;
+DROP INDEX IF EXISTS idx_dm_channels_owner_id;
+DROP TABLE IF EXISTS dm_channels;
+-- +goose StatementEnd
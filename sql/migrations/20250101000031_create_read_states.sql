@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The last message each user read in each server channel. Unread and mention
+-- counts are derived from it, channels without a row count as unread from the
+-- moment the user joined the server.
+CREATE TABLE IF NOT EXISTS read_states (
+    user_id INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    channel_id INTEGER NOT NULL REFERENCES channels (id) ON DELETE CASCADE,
+    last_read_message_id INTEGER NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, channel_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_read_states_channel_id ON read_states (channel_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_read_states_channel_id;
+DROP TABLE IF EXISTS read_states;
+-- +goose StatementEnd
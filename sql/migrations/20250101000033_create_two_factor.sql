@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+-- TOTP secrets are encrypted with the server's TOTP key. An enrolment only
+-- becomes active once confirmed with a valid code. last_used_step keeps every
+-- code from being accepted twice.
+CREATE TABLE IF NOT EXISTS user_totp (
+    user_id INTEGER PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    secret_ciphertext TEXT NOT NULL,
+    confirmed_at TIMESTAMP,
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+-- Single use backup codes, stored as SHA-256 hashes
+CREATE TABLE IF NOT EXISTS backup_codes (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    code_hash VARCHAR(64) NOT NULL,
+    used_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    UNIQUE (user_id, code_hash)
+);
+
+-- When the user last proved who they are in a session: at login and when
+-- re-verifying before sensitive actions
+ALTER TABLE sessions
+ADD COLUMN IF NOT EXISTS verified_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE sessions DROP COLUMN IF EXISTS verified_at;
+DROP TABLE IF EXISTS backup_codes;
+DROP TABLE IF EXISTS user_totp;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Single use tokens mailed to a user to verify an address or reset the
+-- password. Only the hash is stored; email is the address the token was sent
+-- to, so a verification token stops counting once the address changes.
+CREATE TABLE IF NOT EXISTS email_tokens (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    purpose VARCHAR(20) NOT NULL CHECK (
+        purpose IN ('verify_email', 'password_reset')
+    ),
+    email VARCHAR(255) NOT NULL,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_email_tokens_user_id ON email_tokens (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS email_tokens;
+-- +goose StatementEnd
@@ -45,5 +45,30 @@ LIMIT $3
 OFFSET
     $4;
 
--- name: DeleteOldAuditLogs :one
-DELETE FROM audit_logs WHERE created_at < $1 RETURNING *;
+-- name: DeleteOldAuditLogs :execrows
+DELETE FROM audit_logs WHERE created_at < $1;
+
+-- name: ListAuditLogs :many
+-- Newest entries first; filters left NULL match every entry
+SELECT *
+FROM audit_logs
+WHERE
+    server_id = sqlc.arg ('server_id')
+    AND (
+        sqlc.narg ('user_id')::int IS NULL
+        OR user_id = sqlc.narg ('user_id')
+    )
+    AND (
+        sqlc.narg ('action')::text IS NULL
+        OR action = sqlc.narg ('action')
+    )
+    AND (
+        sqlc.narg ('target_id')::int IS NULL
+        OR target_id = sqlc.narg ('target_id')
+    )
+    AND (
+        sqlc.narg ('before_id')::int IS NULL
+        OR id < sqlc.narg ('before_id')
+    )
+ORDER BY id DESC
+LIMIT sqlc.arg ('result_limit');
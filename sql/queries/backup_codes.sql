@@ -0,0 +1,16 @@
+-- name: CreateBackupCodes :exec
+INSERT INTO
+    backup_codes (user_id, code_hash)
+SELECT sqlc.arg ('user_id')::int, unnest(sqlc.arg ('code_hashes')::text[]);
+
+-- name: DeleteUserBackupCodes :exec
+DELETE FROM backup_codes WHERE user_id = $1;
+
+-- name: UseBackupCode :execrows
+UPDATE backup_codes
+SET
+    used_at = CURRENT_TIMESTAMP
+WHERE
+    user_id = $1
+    AND code_hash = $2
+    AND used_at IS NULL;
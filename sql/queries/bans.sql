@@ -8,6 +8,14 @@ INSERT INTO
         expires_at
     )
 VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (server_id, user_id) DO
+UPDATE
+SET
+    moderator_id = EXCLUDED.moderator_id,
+    reason = EXCLUDED.reason,
+    expires_at = EXCLUDED.expires_at,
+    is_deleted = FALSE,
+    created_at = CURRENT_TIMESTAMP
 RETURNING
     *;
 
@@ -31,8 +39,7 @@ ORDER BY created_at DESC;
 -- name: SoftDeleteBan :one
 UPDATE bans
 SET
-    is_deleted = TRUE,
-    updated_at = CURRENT_TIMESTAMP
+    is_deleted = TRUE
 WHERE
     server_id = $1
     AND user_id = $2
@@ -45,19 +52,17 @@ DELETE FROM bans WHERE server_id = $1 AND user_id = $2 RETURNING *;
 -- name: RestoreBan :one
 UPDATE bans
 SET
-    is_deleted = FALSE,
-    updated_at = CURRENT_TIMESTAMP
+    is_deleted = FALSE
 WHERE
     server_id = $1
     AND user_id = $2
 RETURNING
     *;
 
--- name: SoftDeleteExpiredBans :one
+-- name: SoftDeleteExpiredBans :many
 UPDATE bans
 SET
-    is_deleted = TRUE,
-    updated_at = CURRENT_TIMESTAMP
+    is_deleted = TRUE
 WHERE
     expires_at IS NOT NULL
     AND expires_at < CURRENT_TIMESTAMP
@@ -108,3 +108,15 @@ WHERE
     AND type = $2
     AND is_deleted = FALSE
 ORDER BY position ASC;
+
+-- name: ArchiveChannel :one
+UPDATE channels
+SET
+    is_archived = TRUE,
+    archived_at = CURRENT_TIMESTAMP,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    id = $1
+    AND is_deleted = FALSE
+RETURNING
+    *;
@@ -0,0 +1,101 @@
+-- name: CreateDeletedEntity :one
+INSERT INTO
+    deleted_entities (
+        entity_type,
+        entity_id,
+        scope_type,
+        scope_id
+    )
+VALUES ($1, $2, $3, $4)
+RETURNING
+    *;
+
+-- name: CreateFriendshipDeletedEntities :execrows
+-- Records both directions of a friendship, each scoped to its owner
+INSERT INTO
+    deleted_entities (
+        entity_type,
+        entity_id,
+        scope_type,
+        scope_id
+    )
+SELECT 'friend', id, 'user', user_id
+FROM friends
+WHERE ((
+            user_id = $1
+            AND friend_id = $2
+        )
+        OR (
+            user_id = $2
+            AND friend_id = $1
+        ))
+    AND is_deleted = FALSE;
+
+-- name: CreateMessageDeletedEntities :execrows
+-- Channel messages are scoped to their channel, direct messages to both participants
+INSERT INTO
+    deleted_entities (
+        entity_type,
+        entity_id,
+        scope_type,
+        scope_id
+    )
+SELECT 'message', id, 'channel', channel_id
+FROM messages
+WHERE
+    id = ANY ($1::int[])
+    AND ischannel = TRUE
+    AND channel_id IS NOT NULL
+    AND is_deleted = FALSE
+UNION ALL
+SELECT 'direct_message', id, 'user', sender_id
+FROM messages
+WHERE
+    id = ANY ($1::int[])
+    AND ischannel = FALSE
+    AND is_deleted = FALSE
+UNION ALL
+SELECT 'direct_message', id, 'user', receiver_id
+FROM messages
+WHERE
+    id = ANY ($1::int[])
+    AND ischannel = FALSE
+    AND receiver_id IS NOT NULL
+    AND is_deleted = FALSE;
+
+-- name: GetUserDeletedEntityIDs :many
+-- Ids of entities of one type deleted after a timestamp that the user can see
+SELECT DISTINCT
+    entity_id
+FROM deleted_entities
+WHERE
+    entity_type = sqlc.arg ('entity_type')
+    AND deleted_at > sqlc.arg ('deleted_at')
+    AND (
+        (
+            scope_type = 'user'
+            AND scope_id = sqlc.arg ('user_id')
+        )
+        OR (
+            scope_type = 'server'
+            AND scope_id IN (
+                SELECT server_id
+                FROM server_members
+                WHERE
+                    user_id = sqlc.arg ('user_id')
+            )
+        )
+        OR (
+            scope_type = 'channel'
+            AND scope_id IN (
+                SELECT c.id
+                FROM channels c
+                    INNER JOIN server_members sm ON c.server_id = sm.server_id
+                WHERE
+                    sm.user_id = sqlc.arg ('user_id')
+            )
+        )
+    );
+
+-- name: PruneDeletedEntities :execrows
+DELETE FROM deleted_entities WHERE deleted_at < $1;
@@ -0,0 +1,135 @@
+-- name: AckDMChannel :one
+-- Moves the read marker of a participant forward, never backwards
+UPDATE dm_participants
+SET
+    last_read_message_id = GREATEST(
+        COALESCE(last_read_message_id, 0),
+        sqlc.arg ('message_id')::int
+    )
+WHERE
+    dm_channel_id = sqlc.arg ('dm_channel_id')
+    AND user_id = sqlc.arg ('user_id')
+RETURNING
+    *;
+
+-- name: AddDMParticipant :one
+-- Returns no row when the user already is a participant
+INSERT INTO
+    dm_participants (
+        dm_channel_id,
+        user_id,
+        last_read_message_id
+    )
+VALUES ($1, $2, $3)
+ON CONFLICT (dm_channel_id, user_id) DO NOTHING
+RETURNING
+    *;
+
+-- name: CreateDMChannel :one
+INSERT INTO
+    dm_channels (name, icon, owner_id, is_group)
+VALUES ($1, $2, $3, TRUE)
+RETURNING
+    *;
+
+-- name: CreateDMChannelMessage :one
+INSERT INTO
+    messages (
+        dm_channel_id,
+        sender_id,
+        content,
+        message_type,
+        reply_to_message_id,
+        ischannel
+    )
+VALUES ($1, $2, $3, $4, $5, FALSE)
+RETURNING
+    *;
+
+-- name: DeleteDMChannel :exec
+DELETE FROM dm_channels WHERE id = $1;
+
+-- name: GetDMChannel :one
+SELECT * FROM dm_channels WHERE id = $1 LIMIT 1;
+
+-- name: GetDMChannelMessages :many
+-- Newest messages first, optionally before a message id
+SELECT *
+FROM messages
+WHERE
+    dm_channel_id = sqlc.arg ('dm_channel_id')
+    AND is_deleted = FALSE
+    AND (
+        sqlc.narg ('before_id')::int IS NULL
+        OR id < sqlc.narg ('before_id')
+    )
+ORDER BY id DESC
+LIMIT sqlc.arg ('result_limit');
+
+-- name: GetDMParticipant :one
+SELECT *
+FROM dm_participants
+WHERE
+    dm_channel_id = $1
+    AND user_id = $2
+LIMIT 1;
+
+-- name: GetDMParticipants :many
+SELECT *
+FROM dm_participants
+WHERE
+    dm_channel_id = $1
+ORDER BY joined_at, id;
+
+-- name: ListUserDMChannels :many
+-- DM channels of a user, most recently active first. Unread messages are the
+-- ones from others after the user's read marker.
+SELECT
+    c.id,
+    c.name,
+    c.icon,
+    c.owner_id,
+    c.is_group,
+    c.last_message_id,
+    c.last_message_at,
+    c.created_at,
+    p.last_read_message_id,
+    ARRAY(
+        SELECT dp.user_id
+        FROM dm_participants dp
+        WHERE
+            dp.dm_channel_id = c.id
+        ORDER BY dp.joined_at, dp.id
+    )::int[] AS participant_ids,
+    (
+        SELECT COUNT(*)
+        FROM messages m
+        WHERE
+            m.dm_channel_id = c.id
+            AND m.is_deleted = FALSE
+            AND m.sender_id != p.user_id
+            AND m.id > COALESCE(p.last_read_message_id, 0)
+    )::int AS unread_count
+FROM dm_channels c
+    INNER JOIN dm_participants p ON p.dm_channel_id = c.id
+WHERE
+    p.user_id = $1
+ORDER BY c.last_message_at DESC NULLS LAST, c.id DESC;
+
+-- name: RemoveDMParticipant :execrows
+DELETE FROM dm_participants WHERE dm_channel_id = $1 AND user_id = $2;
+
+-- name: SetDMChannelOwner :one
+UPDATE dm_channels SET owner_id = $2 WHERE id = $1 RETURNING *;
+
+-- name: TouchDMChannel :exec
+-- Records the latest message of a DM channel
+UPDATE dm_channels
+SET
+    last_message_id = $2,
+    last_message_at = $3
+WHERE
+    id = $1;
+
+-- name: UpdateDMChannel :one
+UPDATE dm_channels SET name = $2, icon = $3 WHERE id = $1 RETURNING *;
@@ -0,0 +1,77 @@
+-- name: UpsertMessageRequest :one
+-- Opens a pending request, or returns the existing one unchanged
+INSERT INTO
+    dm_message_requests (sender_id, recipient_id)
+VALUES ($1, $2)
+ON CONFLICT (sender_id, recipient_id) DO
+UPDATE
+SET
+    updated_at = CURRENT_TIMESTAMP
+RETURNING
+    *;
+
+-- name: GetMessageRequest :one
+SELECT *
+FROM dm_message_requests
+WHERE
+    sender_id = $1
+    AND recipient_id = $2
+LIMIT 1;
+
+-- name: HasAcceptedMessageRequest :one
+-- Whether either user accepted a message request from the other
+SELECT EXISTS (
+        SELECT 1
+        FROM dm_message_requests
+        WHERE (
+                (
+                    sender_id = sqlc.arg ('user_a')
+                    AND recipient_id = sqlc.arg ('user_b')
+                )
+                OR (
+                    sender_id = sqlc.arg ('user_b')
+                    AND recipient_id = sqlc.arg ('user_a')
+                )
+            )
+            AND status = 'accepted'
+    ) AS is_accepted;
+
+-- name: ListMessageRequests :many
+-- Pending requests of a recipient with the latest message of each sender
+SELECT
+    r.id,
+    r.sender_id,
+    r.recipient_id,
+    r.status,
+    r.created_at,
+    r.updated_at,
+    m.id AS message_id,
+    m.content AS message_content,
+    m.created_at AS message_created_at
+FROM
+    dm_message_requests r
+    LEFT JOIN LATERAL (
+        SELECT id, content, created_at
+        FROM messages
+        WHERE
+            sender_id = r.sender_id
+            AND receiver_id = r.recipient_id
+            AND is_deleted = FALSE
+        ORDER BY id DESC
+        LIMIT 1
+    ) m ON TRUE
+WHERE
+    r.recipient_id = $1
+    AND r.status = 'pending'
+ORDER BY r.updated_at DESC;
+
+-- name: SetMessageRequestStatus :one
+UPDATE dm_message_requests
+SET
+    status = $3,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    sender_id = $1
+    AND recipient_id = $2
+RETURNING
+    *;
@@ -0,0 +1,37 @@
+-- name: CreateEmailToken :one
+INSERT INTO
+    email_tokens (
+        user_id,
+        purpose,
+        email,
+        token_hash,
+        expires_at
+    )
+VALUES ($1, $2, $3, $4, $5)
+RETURNING
+    *;
+
+-- name: InvalidateUserEmailTokens :exec
+-- Uses up every pending token of a user for a purpose, so only the latest
+-- mail works
+UPDATE email_tokens
+SET
+    used_at = CURRENT_TIMESTAMP
+WHERE
+    user_id = $1
+    AND purpose = $2
+    AND used_at IS NULL;
+
+-- name: UseEmailToken :one
+-- Uses up a token. Returns no row when the token is unknown, expired, meant
+-- for another purpose or was already used.
+UPDATE email_tokens
+SET
+    used_at = CURRENT_TIMESTAMP
+WHERE
+    token_hash = $1
+    AND purpose = $2
+    AND used_at IS NULL
+    AND expires_at > CURRENT_TIMESTAMP
+RETURNING
+    *;
@@ -166,16 +166,26 @@ RETURNING
     *;
 
 -- name: BlockUser :one
-UPDATE friends
+-- Records the block even when the users never had a relationship
+INSERT INTO
+    friends (
+        user_id,
+        friend_id,
+        is_pending,
+        is_accepted,
+        is_blocked,
+        is_muted
+    )
+VALUES ($1, $2, FALSE, FALSE, TRUE, TRUE)
+ON CONFLICT (user_id, friend_id) DO
+UPDATE
 SET
+    is_pending = FALSE,
     is_blocked = TRUE,
     is_accepted = FALSE,
     is_muted = TRUE,
+    is_deleted = FALSE,
     updated_at = CURRENT_TIMESTAMP
-WHERE
-    user_id = $1
-    AND friend_id = $2
-    AND is_deleted = FALSE
 RETURNING
     *;
 
@@ -291,4 +301,41 @@ FROM friends
 WHERE
     friend_id = $1
     AND is_pending = TRUE
-    AND is_deleted = FALSE;
\ No newline at end of file
+    AND is_deleted = FALSE;
+-- name: IsBlockedBetween :one
+-- Whether either user blocked the other
+SELECT EXISTS (
+        SELECT 1
+        FROM friends
+        WHERE (
+                (
+                    user_id = sqlc.arg ('user_a')
+                    AND friend_id = sqlc.arg ('user_b')
+                )
+                OR (
+                    user_id = sqlc.arg ('user_b')
+                    AND friend_id = sqlc.arg ('user_a')
+                )
+            )
+            AND is_blocked = TRUE
+            AND is_deleted = FALSE
+    ) AS is_blocked;
+
+-- name: AreFriends :one
+SELECT EXISTS (
+        SELECT 1
+        FROM friends
+        WHERE (
+                (
+                    user_id = sqlc.arg ('user_a')
+                    AND friend_id = sqlc.arg ('user_b')
+                )
+                OR (
+                    user_id = sqlc.arg ('user_b')
+                    AND friend_id = sqlc.arg ('user_a')
+                )
+            )
+            AND is_accepted = TRUE
+            AND is_blocked = FALSE
+            AND is_deleted = FALSE
+    ) AS are_friends;
@@ -37,19 +37,37 @@ ORDER BY created_at DESC;
 -- name: IncrementInviteUses :one
 UPDATE invites
 SET
-    uses = uses + 1,
-    updated_at = CURRENT_TIMESTAMP
+    uses = uses + 1
 WHERE
     code = $1
     AND is_deleted = FALSE
 RETURNING
     *;
 
+-- name: RedeemInvite :one
+-- Counts a use only while the invite is live and has uses left. The row lock
+-- taken by the update serialises concurrent redemptions, so max_uses holds.
+UPDATE invites
+SET
+    uses = COALESCE(uses, 0) + 1
+WHERE
+    code = $1
+    AND is_deleted = FALSE
+    AND (
+        expires_at IS NULL
+        OR expires_at > CURRENT_TIMESTAMP
+    )
+    AND (
+        COALESCE(max_uses, 0) = 0
+        OR COALESCE(uses, 0) < max_uses
+    )
+RETURNING
+    *;
+
 -- name: SoftDeleteInvite :one
 UPDATE invites
 SET
-    is_deleted = TRUE,
-    updated_at = CURRENT_TIMESTAMP
+    is_deleted = TRUE
 WHERE
     code = $1
 RETURNING
@@ -61,24 +79,29 @@ DELETE FROM invites WHERE code = $1 RETURNING *;
 -- name: RestoreInvite :one
 UPDATE invites
 SET
-    is_deleted = FALSE,
-    updated_at = CURRENT_TIMESTAMP
+    is_deleted = FALSE
 WHERE
     code = $1
 RETURNING
     *;
 
--- name: SoftDeleteExpiredInvites :one
+-- name: SoftDeleteExpiredInvites :execrows
+-- Expired and used up invites can never be redeemed again
 UPDATE invites
 SET
-    is_deleted = TRUE,
-    updated_at = CURRENT_TIMESTAMP
+    is_deleted = TRUE
 WHERE
-    expires_at IS NOT NULL
-    AND expires_at < CURRENT_TIMESTAMP
-    AND is_deleted = FALSE
-RETURNING
-    *;
+    is_deleted = FALSE
+    AND (
+        (
+            expires_at IS NOT NULL
+            AND expires_at < CURRENT_TIMESTAMP
+        )
+        OR (
+            COALESCE(max_uses, 0) > 0
+            AND COALESCE(uses, 0) >= max_uses
+        )
+    );
 
 -- name: HardDeleteExpiredInvites :one
 DELETE FROM invites
@@ -91,8 +114,7 @@ RETURNING
 -- name: SoftDeleteInvitesByServer :one
 UPDATE invites
 SET
-    is_deleted = TRUE,
-    updated_at = CURRENT_TIMESTAMP
+    is_deleted = TRUE
 WHERE
     server_id = $1
     AND is_deleted = FALSE
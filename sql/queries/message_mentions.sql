@@ -0,0 +1,20 @@
+-- name: CreateRoleMentions :exec
+-- Records the mentioned roles that belong to the server of the message
+INSERT INTO
+    message_mentions (message_id, role_id)
+SELECT m.id, r.id
+FROM messages m
+    INNER JOIN channels c ON c.id = m.channel_id
+    INNER JOIN roles r ON r.server_id = c.server_id
+WHERE
+    m.id = sqlc.arg ('message_id')
+    AND r.id = ANY (sqlc.arg ('role_ids')::int[]);
+
+-- name: CreateUserMentions :exec
+-- Records the mentioned users that exist
+INSERT INTO
+    message_mentions (message_id, user_id)
+SELECT sqlc.arg ('message_id')::int, u.id
+FROM users u
+WHERE
+    u.id = ANY (sqlc.arg ('user_ids')::int[]);
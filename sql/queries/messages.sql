@@ -6,9 +6,10 @@ INSERT INTO
         content,
         message_type,
         reply_to_message_id,
-        mention_everyone
+        mention_everyone,
+        ischannel
     )
-VALUES ($1, $2, $3, $4, $5, $6)
+VALUES ($1, $2, $3, $4, $5, $6, TRUE)
 RETURNING
     *;
 
@@ -33,7 +34,7 @@ WHERE
     channel_id = $1
     AND id < $2
     AND is_deleted = FALSE
-ORDER BY created_at DESC
+ORDER BY id DESC
 LIMIT $3;
 
 -- name: GetMessagesAfter :many
@@ -43,9 +44,31 @@ WHERE
     channel_id = $1
     AND id > $2
     AND is_deleted = FALSE
-ORDER BY created_at ASC
+ORDER BY id ASC
 LIMIT $3;
 
+-- name: HasMessagesFrom :one
+-- Whether the channel has messages with an id of at least the given one
+SELECT EXISTS (
+        SELECT 1
+        FROM messages
+        WHERE
+            channel_id = $1
+            AND id >= $2
+            AND is_deleted = FALSE
+    ) AS has_messages;
+
+-- name: HasMessagesUntil :one
+-- Whether the channel has messages with an id of at most the given one
+SELECT EXISTS (
+        SELECT 1
+        FROM messages
+        WHERE
+            channel_id = $1
+            AND id <= $2
+            AND is_deleted = FALSE
+    ) AS has_messages;
+
 -- name: UpdateMessage :one
 UPDATE messages
 SET
@@ -127,16 +150,109 @@ RETURNING
 DELETE FROM messages WHERE id = ANY ($1::int[]) RETURNING *;
 
 -- name: SearchMessages :many
-SELECT *
-FROM messages
+-- Full-text search over a set of channels, best match first. An empty query
+-- matches every message so that filters can be used on their own.
+SELECT
+    m.id,
+    m.channel_id,
+    m.sender_id,
+    m.content,
+    m.is_pinned,
+    m.created_at,
+    ts_rank(
+        to_tsvector('english', m.content),
+        websearch_to_tsquery('english', sqlc.arg ('query')::text)
+    )::real AS rank,
+    ts_headline(
+        'english',
+        m.content,
+        websearch_to_tsquery('english', sqlc.arg ('query')::text),
+        'StartSel=**, StopSel=**, MaxWords=24, MinWords=8, MaxFragments=2'
+    )::text AS highlight
+FROM messages m
 WHERE
-    channel_id = $1
-    AND content ILIKE '%' || $2 || '%'
-    AND is_deleted = FALSE
-ORDER BY created_at DESC
-LIMIT $3
+    m.channel_id = ANY (sqlc.arg ('channel_ids')::int[])
+    AND m.is_deleted = FALSE
+    AND (
+        sqlc.arg ('query')::text = ''
+        OR to_tsvector('english', m.content) @@ websearch_to_tsquery('english', sqlc.arg ('query')::text)
+    )
+    AND (
+        sqlc.narg ('sender_id')::int IS NULL
+        OR m.sender_id = sqlc.narg ('sender_id')
+    )
+    AND (
+        sqlc.narg ('has_attachment')::bool IS NULL
+        OR EXISTS (
+            SELECT 1
+            FROM message_attachments ma
+            WHERE
+                ma.message_id = m.id
+                AND ma.is_deleted = FALSE
+        ) = sqlc.narg ('has_attachment')
+    )
+    AND (
+        sqlc.narg ('mentions_user_id')::int IS NULL
+        OR m.content ~ ('<@!?' || sqlc.narg ('mentions_user_id')::int || '>')
+    )
+    AND (
+        sqlc.narg ('before')::timestamp IS NULL
+        OR m.created_at < sqlc.narg ('before')
+    )
+    AND (
+        sqlc.narg ('after')::timestamp IS NULL
+        OR m.created_at > sqlc.narg ('after')
+    )
+    AND (
+        sqlc.narg ('pinned')::bool IS NULL
+        OR m.is_pinned = sqlc.narg ('pinned')
+    )
+ORDER BY rank DESC, m.created_at DESC
+LIMIT sqlc.arg ('result_limit')::int
 OFFSET
-    $4;
+    sqlc.arg ('result_offset')::int;
+
+-- name: CountSearchMessages :one
+-- Counts every match of SearchMessages, regardless of the page
+SELECT COUNT(*)::int AS total
+FROM messages m
+WHERE
+    m.channel_id = ANY (sqlc.arg ('channel_ids')::int[])
+    AND m.is_deleted = FALSE
+    AND (
+        sqlc.arg ('query')::text = ''
+        OR to_tsvector('english', m.content) @@ websearch_to_tsquery('english', sqlc.arg ('query')::text)
+    )
+    AND (
+        sqlc.narg ('sender_id')::int IS NULL
+        OR m.sender_id = sqlc.narg ('sender_id')
+    )
+    AND (
+        sqlc.narg ('has_attachment')::bool IS NULL
+        OR EXISTS (
+            SELECT 1
+            FROM message_attachments ma
+            WHERE
+                ma.message_id = m.id
+                AND ma.is_deleted = FALSE
+        ) = sqlc.narg ('has_attachment')
+    )
+    AND (
+        sqlc.narg ('mentions_user_id')::int IS NULL
+        OR m.content ~ ('<@!?' || sqlc.narg ('mentions_user_id')::int || '>')
+    )
+    AND (
+        sqlc.narg ('before')::timestamp IS NULL
+        OR m.created_at < sqlc.narg ('before')
+    )
+    AND (
+        sqlc.narg ('after')::timestamp IS NULL
+        OR m.created_at > sqlc.narg ('after')
+    )
+    AND (
+        sqlc.narg ('pinned')::bool IS NULL
+        OR m.is_pinned = sqlc.narg ('pinned')
+    );
 
 -- name: GetUserMessages :many
 SELECT *
@@ -149,6 +265,18 @@ LIMIT $2
 OFFSET
     $3;
 
+-- name: GetServerMessagesBySender :many
+-- Messages a user sent in any channel of a server since the given time
+SELECT m.id, m.channel_id
+FROM messages m
+    INNER JOIN channels c ON c.id = m.channel_id
+WHERE
+    c.server_id = $1
+    AND m.sender_id = $2
+    AND m.created_at >= $3
+    AND m.ischannel = TRUE
+    AND m.is_deleted = FALSE;
+
 -- name: CreateChatMessage :one
 INSERT INTO
     messages (
@@ -0,0 +1,63 @@
+-- name: CreateEveryoneRole :one
+-- The @everyone role every member of a server implicitly has
+INSERT INTO
+    roles (
+        server_id,
+        name,
+        position,
+        permissions,
+        is_default
+    )
+VALUES ($1, '@everyone', 0, $2, TRUE)
+RETURNING
+    *;
+
+-- name: GetEveryoneRole :one
+SELECT *
+FROM roles
+WHERE
+    server_id = $1
+    AND is_default = TRUE
+    AND is_deleted = FALSE
+LIMIT 1;
+
+-- name: GetMemberRolesByUser :many
+-- Roles assigned to a user in a server, highest first
+SELECT r.*
+FROM
+    roles r
+    INNER JOIN member_roles mr ON r.id = mr.role_id
+    INNER JOIN server_members sm ON mr.member_id = sm.id
+WHERE
+    sm.server_id = $1
+    AND sm.user_id = $2
+    AND r.is_deleted = FALSE
+ORDER BY r.position DESC;
+
+-- name: GetMessagesChannelIDs :many
+-- Distinct channels a set of channel messages belong to
+SELECT DISTINCT
+    channel_id::int AS channel_id
+FROM messages
+WHERE
+    id = ANY ($1::int[])
+    AND ischannel = TRUE
+    AND channel_id IS NOT NULL;
+
+-- name: ShiftRolePositions :exec
+-- Moves every non-default role at or above a position up by one
+UPDATE roles
+SET
+    position = position + 1,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    server_id = $1
+    AND position >= $2
+    AND is_default = FALSE
+    AND is_deleted = FALSE;
+
+-- name: AddMemberRole :exec
+INSERT INTO
+    member_roles (member_id, role_id)
+VALUES ($1, $2)
+ON CONFLICT (member_id, role_id) DO NOTHING;
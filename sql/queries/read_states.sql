@@ -0,0 +1,101 @@
+-- name: GetLatestChannelMessageID :one
+SELECT COALESCE(MAX(id), 0)::int AS last_message_id
+FROM messages
+WHERE
+    channel_id = $1
+    AND is_deleted = FALSE;
+
+-- name: ListChannelReadStates :many
+-- Read marker, unread and mention count of a user in each of the channels.
+-- Messages of the user, and messages sent before they joined a channel they
+-- never read, do not count. Mentions are direct ones, ones of the user's roles
+-- and @everyone.
+SELECT
+    c.id AS channel_id,
+    c.server_id,
+    rs.last_read_message_id,
+    COUNT(m.id)::int AS unread_count,
+    COUNT(m.id) FILTER (
+        WHERE
+            COALESCE(m.mention_everyone, FALSE)
+            OR EXISTS (
+                SELECT 1
+                FROM message_mentions mm
+                WHERE
+                    mm.message_id = m.id
+                    AND (
+                        mm.user_id = sm.user_id
+                        OR mm.role_id IN (
+                            SELECT mr.role_id
+                            FROM member_roles mr
+                            WHERE
+                                mr.member_id = sm.id
+                        )
+                    )
+            )
+    )::int AS mention_count
+FROM
+    channels c
+    INNER JOIN server_members sm ON sm.server_id = c.server_id
+    AND sm.user_id = sqlc.arg ('user_id')
+    LEFT JOIN read_states rs ON rs.channel_id = c.id
+    AND rs.user_id = sqlc.arg ('user_id')
+    LEFT JOIN messages m ON m.channel_id = c.id
+    AND m.is_deleted = FALSE
+    AND m.sender_id != sm.user_id
+    AND m.id > COALESCE(rs.last_read_message_id, 0)
+    AND (
+        rs.last_read_message_id IS NOT NULL
+        OR m.created_at >= sm.joined_at
+    )
+WHERE
+    c.id = ANY (sqlc.arg ('channel_ids')::int[])
+GROUP BY
+    c.id,
+    c.server_id,
+    rs.last_read_message_id
+ORDER BY c.id;
+
+-- name: MarkChannelsRead :execrows
+-- Moves the read markers of a user to the latest message of each channel
+INSERT INTO
+    read_states (
+        user_id,
+        channel_id,
+        last_read_message_id
+    )
+SELECT sqlc.arg ('user_id')::int, m.channel_id, MAX(m.id)
+FROM messages m
+WHERE
+    m.channel_id = ANY (sqlc.arg ('channel_ids')::int[])
+    AND m.is_deleted = FALSE
+GROUP BY
+    m.channel_id
+ON CONFLICT (user_id, channel_id) DO
+UPDATE
+SET
+    last_read_message_id = GREATEST(
+        read_states.last_read_message_id,
+        EXCLUDED.last_read_message_id
+    ),
+    updated_at = CURRENT_TIMESTAMP;
+
+-- name: UpsertReadState :one
+-- Moves the read marker of a user forward, never backwards
+INSERT INTO
+    read_states (
+        user_id,
+        channel_id,
+        last_read_message_id
+    )
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, channel_id) DO
+UPDATE
+SET
+    last_read_message_id = GREATEST(
+        read_states.last_read_message_id,
+        EXCLUDED.last_read_message_id
+    ),
+    updated_at = CURRENT_TIMESTAMP
+RETURNING
+    *;
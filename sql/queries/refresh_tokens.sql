@@ -0,0 +1,25 @@
+-- name: CreateRefreshToken :one
+INSERT INTO
+    refresh_tokens (
+        session_id,
+        token_hash,
+        expires_at
+    )
+VALUES ($1, $2, $3)
+RETURNING
+    *;
+
+-- name: GetRefreshTokenByHash :one
+SELECT * FROM refresh_tokens WHERE token_hash = $1 LIMIT 1;
+
+-- name: UseRefreshToken :one
+-- Uses up a refresh token. Returns no row when the token is unknown or was
+-- already used.
+UPDATE refresh_tokens
+SET
+    used_at = CURRENT_TIMESTAMP
+WHERE
+    token_hash = $1
+    AND used_at IS NULL
+RETURNING
+    *;
@@ -1,7 +1,13 @@
 -- name: AddServerMember :one
 INSERT INTO
-    server_members (server_id, user_id, nickname)
-VALUES ($1, $2, $3)
+    server_members (
+        server_id,
+        user_id,
+        nickname,
+        invite_id,
+        temporary_until
+    )
+VALUES ($1, $2, $3, $4, $5)
 RETURNING
     *;
 
@@ -63,3 +69,72 @@ WHERE
     AND user_id = $2
 RETURNING
     *;
+
+-- name: MakeMemberPermanent :exec
+UPDATE server_members
+SET
+    temporary_until = NULL,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    id = $1
+    AND temporary_until IS NOT NULL;
+
+-- name: RemoveTemporaryMemberships :many
+DELETE FROM server_members
+WHERE
+    user_id = $1
+    AND temporary_until IS NOT NULL
+RETURNING
+    *;
+
+-- name: RemoveExpiredTemporaryMembers :many
+DELETE FROM server_members
+WHERE
+    temporary_until IS NOT NULL
+    AND temporary_until < CURRENT_TIMESTAMP
+RETURNING
+    *;
+
+-- name: GetInviteJoins :many
+-- Members who joined through an invite, including invites deleted since
+SELECT
+    sm.user_id,
+    sm.joined_at,
+    sm.temporary_until,
+    i.code,
+    i.inviter_id
+FROM server_members sm
+    INNER JOIN invites i ON i.id = sm.invite_id
+WHERE
+    sm.server_id = sqlc.arg ('server_id')
+    AND (
+        sqlc.narg ('code')::text IS NULL
+        OR i.code = sqlc.narg ('code')
+    )
+ORDER BY sm.joined_at DESC
+LIMIT sqlc.arg ('result_limit')
+OFFSET
+    sqlc.arg ('result_offset');
+
+-- name: SetMemberTimeout :one
+-- A NULL communication_disabled_until ends the timeout
+UPDATE server_members
+SET
+    communication_disabled_until = sqlc.narg ('communication_disabled_until'),
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    server_id = sqlc.arg ('server_id')
+    AND user_id = sqlc.arg ('user_id')
+RETURNING
+    *;
+
+-- name: ClearExpiredMemberTimeouts :many
+UPDATE server_members
+SET
+    communication_disabled_until = NULL,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    communication_disabled_until IS NOT NULL
+    AND communication_disabled_until <= CURRENT_TIMESTAMP
+RETURNING
+    *;
@@ -15,6 +15,25 @@ RETURNING
 -- name: GetServerByID :one
 SELECT * FROM servers WHERE id = $1 AND is_deleted = FALSE LIMIT 1;
 
+-- name: GetServerByVanityUrl :one
+SELECT *
+FROM servers
+WHERE
+    vanity_url = $1
+    AND is_deleted = FALSE
+LIMIT 1;
+
+-- name: SetServerVanityUrl :one
+UPDATE servers
+SET
+    vanity_url = $2,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    id = $1
+    AND is_deleted = FALSE
+RETURNING
+    *;
+
 -- name: UpdateServer :one
 UPDATE servers
 SET
@@ -0,0 +1,65 @@
+-- name: CreateSession :one
+INSERT INTO
+    sessions (
+        user_id,
+        device_name,
+        ip_address,
+        user_agent
+    )
+VALUES ($1, $2, $3, $4)
+RETURNING
+    *;
+
+-- name: GetSession :one
+SELECT * FROM sessions WHERE id = $1 LIMIT 1;
+
+-- name: ListActiveUserSessions :many
+-- Sessions of a user that were not revoked, most recently used first
+SELECT *
+FROM sessions
+WHERE
+    user_id = $1
+    AND revoked_at IS NULL
+ORDER BY last_used_at DESC, id DESC;
+
+-- name: RevokeOtherUserSessions :execrows
+-- Revokes every active session of a user except the given one
+UPDATE sessions
+SET
+    revoked_at = CURRENT_TIMESTAMP
+WHERE
+    user_id = $1
+    AND id != $2
+    AND revoked_at IS NULL;
+
+-- name: RevokeSession :execrows
+UPDATE sessions
+SET
+    revoked_at = CURRENT_TIMESTAMP
+WHERE
+    id = $1
+    AND user_id = $2
+    AND revoked_at IS NULL;
+
+-- name: TouchSession :exec
+-- Records that a session was used, optionally from a new address
+UPDATE sessions
+SET
+    last_used_at = CURRENT_TIMESTAMP,
+    ip_address = COALESCE(
+        sqlc.narg ('ip_address'),
+        ip_address
+    )
+WHERE
+    id = sqlc.arg ('id');
+
+-- name: MarkSessionVerified :exec
+UPDATE sessions SET verified_at = CURRENT_TIMESTAMP WHERE id = $1;
+
+-- name: RevokeAllUserSessions :exec
+UPDATE sessions
+SET
+    revoked_at = CURRENT_TIMESTAMP
+WHERE
+    user_id = $1
+    AND revoked_at IS NULL;
@@ -0,0 +1,128 @@
+-- name: SyncFriends :many
+-- Friendships the user is on either side of changed after the client's last sync
+SELECT *
+FROM friends
+WHERE (
+        user_id = $1
+        OR friend_id = $1
+    )
+    AND updated_at > $2
+    AND is_pending = FALSE
+    AND is_blocked = FALSE
+    AND is_deleted = FALSE
+ORDER BY updated_at ASC, id ASC
+LIMIT $3
+OFFSET
+    $4;
+
+-- name: SyncPendingFriendRequests :many
+-- Incoming and outgoing friend requests changed after the client's last sync
+SELECT *
+FROM friends
+WHERE (
+        user_id = $1
+        OR friend_id = $1
+    )
+    AND updated_at > $2
+    AND is_pending = TRUE
+    AND is_deleted = FALSE
+ORDER BY updated_at ASC, id ASC;
+
+-- name: SyncServers :many
+-- Servers the user is a member of changed after the client's last sync
+SELECT s.*
+FROM servers s
+    INNER JOIN server_members sm ON s.id = sm.server_id
+WHERE
+    sm.user_id = $1
+    AND s.updated_at > $2
+    AND s.is_deleted = FALSE
+ORDER BY s.updated_at ASC, s.id ASC
+LIMIT $3
+OFFSET
+    $4;
+
+-- name: SyncChannels :many
+-- Channels of the user's servers changed after the client's last sync
+SELECT c.*
+FROM channels c
+    INNER JOIN server_members sm ON c.server_id = sm.server_id
+WHERE
+    sm.user_id = $1
+    AND c.updated_at > $2
+    AND c.is_deleted = FALSE
+ORDER BY c.updated_at ASC, c.id ASC
+LIMIT $3
+OFFSET
+    $4;
+
+-- name: SyncChannelsByType :many
+-- Channels of a given type in the user's servers changed after the client's last sync
+SELECT c.*
+FROM channels c
+    INNER JOIN server_members sm ON c.server_id = sm.server_id
+WHERE
+    sm.user_id = $1
+    AND c.type = $2
+    AND c.updated_at > $3
+    AND c.is_deleted = FALSE
+ORDER BY c.updated_at ASC, c.id ASC
+LIMIT $4
+OFFSET
+    $5;
+
+-- name: SyncChannelMessages :many
+-- Channel messages in the user's servers changed after the client's last sync
+SELECT m.*
+FROM messages m
+    INNER JOIN channels c ON m.channel_id = c.id
+    INNER JOIN server_members sm ON c.server_id = sm.server_id
+WHERE
+    sm.user_id = $1
+    AND m.ischannel = TRUE
+    AND m.updated_at > $2
+    AND m.is_deleted = FALSE
+ORDER BY m.updated_at ASC, m.id ASC
+LIMIT $3
+OFFSET
+    $4;
+
+-- name: SyncDirectMessages :many
+-- Direct messages sent or received by the user changed after the client's last sync
+SELECT *
+FROM messages
+WHERE (
+        sender_id = $1
+        OR receiver_id = $1
+    )
+    AND ischannel = FALSE
+    AND updated_at > $2
+    AND is_deleted = FALSE
+ORDER BY updated_at ASC, id ASC
+LIMIT $3
+OFFSET
+    $4;
+
+-- name: SyncRoles :many
+-- Roles of the user's servers changed after the client's last sync
+SELECT r.*
+FROM roles r
+    INNER JOIN server_members sm ON r.server_id = sm.server_id
+WHERE
+    sm.user_id = $1
+    AND r.updated_at > $2
+    AND r.is_deleted = FALSE
+ORDER BY r.updated_at ASC, r.id ASC
+LIMIT $3
+OFFSET
+    $4;
+
+-- name: SyncUserProfile :one
+-- User profile if it changed after the client's last sync
+SELECT *
+FROM users
+WHERE
+    id = $1
+    AND updated_at > $2
+    AND is_deleted = FALSE
+LIMIT 1;
@@ -0,0 +1,112 @@
+-- name: GetUserSettings :one
+SELECT * FROM user_settings WHERE user_id = $1 LIMIT 1;
+
+-- name: UpsertUserSettings :one
+-- Writes every setting and bumps the version. Returns no row when the stored
+-- version no longer matches expected_version.
+INSERT INTO
+    user_settings (
+        user_id,
+        theme,
+        locale,
+        show_current_activity,
+        dm_privacy,
+        friend_request_privacy,
+        explicit_content_filter,
+        enable_notifications,
+        default_notification_level
+    )
+VALUES (
+        sqlc.arg ('user_id'),
+        sqlc.arg ('theme'),
+        sqlc.arg ('locale'),
+        sqlc.arg ('show_current_activity'),
+        sqlc.arg ('dm_privacy'),
+        sqlc.arg ('friend_request_privacy'),
+        sqlc.arg ('explicit_content_filter'),
+        sqlc.arg ('enable_notifications'),
+        sqlc.arg ('default_notification_level')
+    )
+ON CONFLICT (user_id) DO
+UPDATE
+SET
+    theme = EXCLUDED.theme,
+    locale = EXCLUDED.locale,
+    show_current_activity = EXCLUDED.show_current_activity,
+    dm_privacy = EXCLUDED.dm_privacy,
+    friend_request_privacy = EXCLUDED.friend_request_privacy,
+    explicit_content_filter = EXCLUDED.explicit_content_filter,
+    enable_notifications = EXCLUDED.enable_notifications,
+    default_notification_level = EXCLUDED.default_notification_level,
+    version = user_settings.version + 1,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    user_settings.version = sqlc.arg ('expected_version')
+RETURNING
+    *;
+
+-- name: BumpUserSettingsVersion :one
+-- Creates the default settings row when the user has none yet. Returns no row
+-- when the stored version no longer matches expected_version.
+INSERT INTO
+    user_settings (user_id)
+VALUES (sqlc.arg ('user_id'))
+ON CONFLICT (user_id) DO
+UPDATE
+SET
+    version = user_settings.version + 1,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    user_settings.version = sqlc.arg ('expected_version')
+RETURNING
+    *;
+
+-- name: GetUserServerSettings :many
+SELECT *
+FROM user_server_settings
+WHERE
+    user_id = $1
+ORDER BY server_id;
+
+-- name: GetUserServerSetting :one
+SELECT *
+FROM user_server_settings
+WHERE
+    user_id = $1
+    AND server_id = $2
+LIMIT 1;
+
+-- name: UpsertUserServerSettings :one
+INSERT INTO
+    user_server_settings (
+        user_id,
+        server_id,
+        muted,
+        notification_level,
+        allow_dms
+    )
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (user_id, server_id) DO
+UPDATE
+SET
+    muted = EXCLUDED.muted,
+    notification_level = EXCLUDED.notification_level,
+    allow_dms = EXCLUDED.allow_dms,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING
+    *;
+
+-- name: SharesServerAllowingDMs :one
+-- Whether the users share a server in which the recipient allows DMs
+SELECT EXISTS (
+        SELECT 1
+        FROM
+            server_members sender
+            INNER JOIN server_members recipient ON recipient.server_id = sender.server_id
+            LEFT JOIN user_server_settings uss ON uss.user_id = recipient.user_id
+            AND uss.server_id = recipient.server_id
+        WHERE
+            sender.user_id = sqlc.arg ('sender_id')
+            AND recipient.user_id = sqlc.arg ('recipient_id')
+            AND COALESCE(uss.allow_dms, TRUE)
+    ) AS shares_server;
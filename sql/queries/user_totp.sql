@@ -0,0 +1,39 @@
+-- name: ConfirmUserTOTP :execrows
+UPDATE user_totp
+SET
+    confirmed_at = CURRENT_TIMESTAMP
+WHERE
+    user_id = $1
+    AND confirmed_at IS NULL;
+
+-- name: DeleteUserTOTP :exec
+DELETE FROM user_totp WHERE user_id = $1;
+
+-- name: GetUserTOTP :one
+SELECT * FROM user_totp WHERE user_id = $1 LIMIT 1;
+
+-- name: UpsertUserTOTP :one
+-- Starts or restarts an enrolment. Returns no row when 2FA is already active.
+INSERT INTO
+    user_totp (user_id, secret_ciphertext)
+VALUES ($1, $2)
+ON CONFLICT (user_id) DO
+UPDATE
+SET
+    secret_ciphertext = EXCLUDED.secret_ciphertext,
+    last_used_step = 0,
+    created_at = CURRENT_TIMESTAMP
+WHERE
+    user_totp.confirmed_at IS NULL
+RETURNING
+    *;
+
+-- name: UseTOTPStep :execrows
+-- Records the time step of an accepted code. Affects no row when the step, or
+-- a later one, was already used.
+UPDATE user_totp
+SET
+    last_used_step = sqlc.arg ('step')
+WHERE
+    user_id = sqlc.arg ('user_id')
+    AND last_used_step < sqlc.arg ('step');
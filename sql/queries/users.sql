@@ -79,6 +79,15 @@ WHERE
 RETURNING
     *;
 
+-- name: MarkUserVerified :exec
+UPDATE users
+SET
+    is_verified = TRUE,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    id = $1
+    AND is_deleted = FALSE;
+
 -- name: SoftDeleteUser :one
 UPDATE users
 SET
@@ -30,6 +30,61 @@ CREATE INDEX idx_users_email ON users(email);
 CREATE INDEX idx_users_username ON users(username);
 CREATE INDEX idx_users_status ON users(status);
 
+CREATE TABLE sessions (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    device_name VARCHAR(100),
+    ip_address VARCHAR(45),
+    user_agent TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    last_used_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP,
+    verified_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_sessions_user_id ON sessions(user_id);
+
+CREATE TABLE refresh_tokens (
+    id SERIAL PRIMARY KEY,
+    session_id INTEGER NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP
+);
+
+CREATE INDEX idx_refresh_tokens_session_id ON refresh_tokens(session_id);
+
+CREATE TABLE user_totp (
+    user_id INTEGER PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    secret_ciphertext TEXT NOT NULL,
+    confirmed_at TIMESTAMP,
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE TABLE backup_codes (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    code_hash VARCHAR(64) NOT NULL,
+    used_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    UNIQUE(user_id, code_hash)
+);
+
+CREATE TABLE email_tokens (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    purpose VARCHAR(20) NOT NULL CHECK (purpose IN ('verify_email', 'password_reset')),
+    email VARCHAR(255) NOT NULL,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP
+);
+
+CREATE INDEX idx_email_tokens_user_id ON email_tokens(user_id);
+
 -- ==============================================
 -- SERVERS (GUILDS)
 -- ==============================================
@@ -75,6 +130,7 @@ CREATE TABLE roles (
 
 CREATE INDEX idx_roles_server_id ON roles(server_id);
 CREATE INDEX idx_roles_position ON roles(server_id, position);
+CREATE UNIQUE INDEX idx_roles_server_default ON roles(server_id) WHERE is_default = TRUE;
 
 -- ==============================================
 -- SERVER MEMBERS
@@ -123,6 +179,8 @@ CREATE TABLE channels (
     user_limit INTEGER DEFAULT 0,
     bitrate INTEGER DEFAULT 64000,
     is_private BOOLEAN DEFAULT FALSE,
+    is_archived BOOLEAN DEFAULT FALSE,
+    archived_at TIMESTAMP,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL
 );
@@ -172,6 +230,7 @@ CREATE INDEX idx_messages_sender_id ON messages(sender_id);
 CREATE INDEX idx_messages_created_at ON messages(channel_id, created_at DESC);
 CREATE INDEX idx_messages_pinned ON messages(channel_id, is_pinned) WHERE is_pinned = TRUE;
 CREATE INDEX idx_messages_reply_to ON messages(reply_to_message_id);
+CREATE INDEX idx_messages_content_search ON messages USING GIN (to_tsvector('english', content)) WHERE is_deleted = FALSE;
 
 CREATE TABLE message_attachments (
     id SERIAL PRIMARY KEY,
@@ -213,6 +272,16 @@ CREATE INDEX idx_message_mentions_message_id ON message_mentions(message_id);
 CREATE INDEX idx_message_mentions_user_id ON message_mentions(user_id);
 CREATE INDEX idx_message_mentions_role_id ON message_mentions(role_id);
 
+CREATE TABLE read_states (
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    channel_id INTEGER NOT NULL REFERENCES channels(id) ON DELETE CASCADE,
+    last_read_message_id INTEGER NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, channel_id)
+);
+
+CREATE INDEX idx_read_states_channel_id ON read_states(channel_id);
+
 -- ==============================================
 -- DIRECT MESSAGES
 -- ==============================================
@@ -243,6 +312,24 @@ CREATE TABLE dm_participants (
 CREATE INDEX idx_dm_participants_dm_channel_id ON dm_participants(dm_channel_id);
 CREATE INDEX idx_dm_participants_user_id ON dm_participants(user_id);
 
+-- Messages of group DMs belong to a dm_channel instead of a server channel
+ALTER TABLE messages ADD COLUMN dm_channel_id INTEGER REFERENCES dm_channels(id) ON DELETE CASCADE;
+
+CREATE INDEX idx_messages_dm_channel_id ON messages(dm_channel_id, id DESC) WHERE dm_channel_id IS NOT NULL;
+
+CREATE TABLE dm_message_requests (
+    id SERIAL PRIMARY KEY,
+    sender_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    recipient_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'accepted', 'ignored')),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    UNIQUE(sender_id, recipient_id),
+    CHECK (sender_id != recipient_id)
+);
+
+CREATE INDEX idx_dm_message_requests_recipient ON dm_message_requests(recipient_id, status);
+
 -- ==============================================
 -- FRIENDS
 -- ==============================================
@@ -287,6 +374,21 @@ CREATE INDEX idx_invites_server_id ON invites(server_id);
 CREATE INDEX idx_invites_inviter_id ON invites(inviter_id);
 CREATE INDEX idx_invites_expires_at ON invites(expires_at);
 
+-- Members remember the invite they joined through; temporary members are
+-- removed once temporary_until passes
+ALTER TABLE server_members
+    ADD COLUMN invite_id INTEGER REFERENCES invites(id) ON DELETE SET NULL,
+    ADD COLUMN temporary_until TIMESTAMP;
+
+CREATE INDEX idx_server_members_invite_id ON server_members(invite_id);
+CREATE INDEX idx_server_members_temporary_until ON server_members(temporary_until) WHERE temporary_until IS NOT NULL;
+
+-- Timed out members cannot communicate until communication_disabled_until
+ALTER TABLE server_members
+    ADD COLUMN communication_disabled_until TIMESTAMP;
+
+CREATE INDEX idx_server_members_communication_disabled_until ON server_members(communication_disabled_until) WHERE communication_disabled_until IS NOT NULL;
+
 CREATE TABLE bans (
     id SERIAL PRIMARY KEY,
     server_id INTEGER NOT NULL REFERENCES servers(id) ON DELETE CASCADE,
@@ -368,6 +470,37 @@ CREATE INDEX idx_user_presence_user_id ON user_presence(user_id);
 CREATE INDEX idx_user_presence_status ON user_presence(status);
 CREATE INDEX idx_user_presence_last_seen ON user_presence(last_seen);
 
+-- ==============================================
+-- USER SETTINGS
+-- ==============================================
+
+CREATE TABLE user_settings (
+    user_id INTEGER PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    version INTEGER NOT NULL DEFAULT 1,
+    theme VARCHAR(16) NOT NULL DEFAULT 'dark' CHECK (theme IN ('light', 'dark', 'system')),
+    locale VARCHAR(16) NOT NULL DEFAULT 'en',
+    show_current_activity BOOLEAN NOT NULL DEFAULT TRUE,
+    dm_privacy VARCHAR(20) NOT NULL DEFAULT 'everyone' CHECK (dm_privacy IN ('everyone', 'server_members', 'friends')),
+    friend_request_privacy VARCHAR(20) NOT NULL DEFAULT 'everyone' CHECK (friend_request_privacy IN ('everyone', 'friends_of_friends', 'server_members', 'none')),
+    explicit_content_filter VARCHAR(20) NOT NULL DEFAULT 'non_friends' CHECK (explicit_content_filter IN ('disabled', 'non_friends', 'all')),
+    enable_notifications BOOLEAN NOT NULL DEFAULT TRUE,
+    default_notification_level VARCHAR(20) NOT NULL DEFAULT 'all' CHECK (default_notification_level IN ('all', 'mentions', 'none')),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE TABLE user_server_settings (
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    server_id INTEGER NOT NULL REFERENCES servers(id) ON DELETE CASCADE,
+    muted BOOLEAN NOT NULL DEFAULT FALSE,
+    notification_level VARCHAR(20) CHECK (notification_level IN ('all', 'mentions', 'none')),
+    allow_dms BOOLEAN NOT NULL DEFAULT TRUE,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, server_id)
+);
+
+CREATE INDEX idx_user_server_settings_server_id ON user_server_settings(server_id);
+
 -- ==============================================
 -- AUDIT LOGS
 -- ==============================================
@@ -388,3 +521,19 @@ CREATE INDEX idx_audit_logs_server_id ON audit_logs(server_id);
 CREATE INDEX idx_audit_logs_user_id ON audit_logs(user_id);
 CREATE INDEX idx_audit_logs_action ON audit_logs(action);
 CREATE INDEX idx_audit_logs_created_at ON audit_logs(server_id, created_at DESC);
+
+-- ==============================================
+-- DELETED ENTITIES (SYNC TOMBSTONES)
+-- ==============================================
+
+CREATE TABLE deleted_entities (
+    id SERIAL PRIMARY KEY,
+    entity_type VARCHAR(30) NOT NULL CHECK (entity_type IN ('message', 'direct_message', 'friend', 'server', 'channel', 'role')),
+    entity_id INTEGER NOT NULL,
+    scope_type VARCHAR(20) NOT NULL CHECK (scope_type IN ('user', 'server', 'channel')),
+    scope_id INTEGER NOT NULL,
+    deleted_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_deleted_entities_scope ON deleted_entities(scope_type, scope_id, entity_type, deleted_at);
+CREATE INDEX idx_deleted_entities_deleted_at ON deleted_entities(deleted_at);